@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/DataDog/datadog-process-agent/checks"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// checkViews are the ways to print the results of `-check process`, other
+// than as JSON.
+var checkViews = map[string]func(w io.Writer, msgs []model.MessageBody){
+	"systemd": printSystemdView,
+	"tree":    printTreeView,
+}
+
+func checkViewNames() []string {
+	names := make([]string, 0, len(checkViews))
+	for name := range checkViews {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validateCheckView tells if a view can be used to print the results of a check.
+func validateCheckView(check, view string) error {
+	if view == "" {
+		return nil
+	}
+	if check != checks.Process.Name() {
+		return fmt.Errorf("views are only supported for check '%s'", checks.Process.Name())
+	}
+	if _, ok := checkViews[view]; !ok {
+		return fmt.Errorf("invalid view '%s', choose from: %v", view, checkViewNames())
+	}
+	return nil
+}
+
+type unitUsage struct {
+	unit  string
+	slice string
+	procs int
+	cpu   float32
+	rss   uint64
+}
+
+// printSystemdView prints the resources used by each systemd unit, the ones
+// using the most memory first.
+func printSystemdView(w io.Writer, msgs []model.MessageBody) {
+	byUnit := make(map[string]*unitUsage)
+	for _, p := range collectedProcesses(msgs) {
+		unit, slice := "-", "-"
+		if p.Systemd != nil {
+			if p.Systemd.Unit != "" {
+				unit = p.Systemd.Unit
+			}
+			if p.Systemd.Slice != "" {
+				slice = p.Systemd.Slice
+			}
+		}
+		key := slice + "/" + unit
+		u, ok := byUnit[key]
+		if !ok {
+			u = &unitUsage{unit: unit, slice: slice}
+			byUnit[key] = u
+		}
+		u.procs++
+		if p.Cpu != nil {
+			u.cpu += p.Cpu.TotalPct
+		}
+		if p.Memory != nil {
+			u.rss += p.Memory.Rss
+		}
+	}
+
+	units := make([]*unitUsage, 0, len(byUnit))
+	for _, u := range byUnit {
+		units = append(units, u)
+	}
+	sort.Slice(units, func(i, j int) bool {
+		if units[i].rss != units[j].rss {
+			return units[i].rss > units[j].rss
+		}
+		return units[i].slice+"/"+units[i].unit < units[j].slice+"/"+units[j].unit
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "UNIT\tSLICE\tPROCS\tCPU%\tRSS")
+	for _, u := range units {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%.1f\t%s\n", u.unit, u.slice, u.procs, u.cpu, formatBytes(u.rss))
+	}
+	tw.Flush()
+}
+
+// printTreeView prints the processes as a tree, with the resources used by
+// each process and its descendants.
+func printTreeView(w io.Writer, msgs []model.MessageBody) {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROCS\tCPU%\tRSS\tPID COMMAND")
+	for _, root := range checks.BuildProcessTree(collectedProcesses(msgs)) {
+		printTreeNode(tw, root, 0)
+	}
+	tw.Flush()
+}
+
+func printTreeNode(w io.Writer, n *checks.ProcessNode, depth int) {
+	var flags string
+	if n.ParentMissing {
+		flags += " (parent missing)"
+	}
+	if n.Reparented {
+		flags += " (reparented)"
+	}
+	fmt.Fprintf(w, "%d\t%.1f\t%s\t%s%d %s%s\n", n.SubtreeProcs, n.SubtreeCPUPct, formatBytes(n.SubtreeRss),
+		strings.Repeat("  ", depth), n.Process.Pid, processCommand(n.Process), flags)
+	for _, c := range n.Children {
+		printTreeNode(w, c, depth+1)
+	}
+}
+
+// processCommand returns the command line of a process, shortened to fit on
+// a line.
+func processCommand(p *model.Process) string {
+	const maxLen = 80
+	if p.Command == nil {
+		return ""
+	}
+	cmd := strings.Join(p.Command.Args, " ")
+	if len(cmd) > maxLen {
+		cmd = cmd[:maxLen-3] + "..."
+	}
+	return cmd
+}
+
+// collectedProcesses returns the processes of the messages of a process check.
+func collectedProcesses(msgs []model.MessageBody) []*model.Process {
+	var procs []*model.Process
+	for _, m := range msgs {
+		if c, ok := m.(*model.CollectorProc); ok {
+			procs = append(procs, c.Processes...)
+		}
+	}
+	return procs
+}
+
+func formatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
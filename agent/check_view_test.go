@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestValidateCheckView(t *testing.T) {
+	assert.NoError(t, validateCheckView("connections", ""))
+	assert.NoError(t, validateCheckView("process", "systemd"))
+	assert.NoError(t, validateCheckView("process", "tree"))
+	assert.Error(t, validateCheckView("process", "flat"))
+	assert.Error(t, validateCheckView("connections", "systemd"))
+}
+
+func TestPrintSystemdView(t *testing.T) {
+	proc := func(unit, slice string, cpu float32, rss uint64) *model.Process {
+		p := &model.Process{
+			Cpu:    &model.CPUStat{TotalPct: cpu},
+			Memory: &model.MemoryStat{Rss: rss},
+		}
+		if unit != "" || slice != "" {
+			p.Systemd = &model.SystemdUnit{Unit: unit, Slice: slice}
+		}
+		return p
+	}
+	msgs := []model.MessageBody{
+		&model.CollectorProc{Processes: []*model.Process{
+			proc("nginx.service", "system.slice", 1.5, 100<<20),
+			proc("postgresql.service", "system.slice", 10, 2<<30),
+			proc("nginx.service", "system.slice", 2, 50<<20),
+		}},
+		&model.CollectorProc{Processes: []*model.Process{
+			proc("", "", 0.1, 512),
+		}},
+	}
+
+	var b bytes.Buffer
+	printSystemdView(&b, msgs)
+	assert.Equal(t, `UNIT                SLICE         PROCS  CPU%  RSS
+postgresql.service  system.slice  1      10.0  2.0GiB
+nginx.service       system.slice  2      3.5   150.0MiB
+-                   -             1      0.1   512B
+`, b.String())
+}
+
+func TestPrintTreeView(t *testing.T) {
+	proc := func(pid, ppid int32, args []string, rss uint64) *model.Process {
+		return &model.Process{
+			Pid:     pid,
+			Command: &model.Command{Args: args, Ppid: ppid},
+			Cpu:     &model.CPUStat{TotalPct: 1},
+			Memory:  &model.MemoryStat{Rss: rss},
+		}
+	}
+	msgs := []model.MessageBody{
+		&model.CollectorProc{Processes: []*model.Process{
+			proc(100, 1, []string{"gunicorn: master"}, 100<<20),
+			proc(101, 100, []string{"gunicorn: worker"}, 1<<30),
+			proc(102, 100, []string{"gunicorn: worker"}, 1<<30),
+		}},
+	}
+
+	var b bytes.Buffer
+	printTreeView(&b, msgs)
+	assert.Equal(t, `PROCS  CPU%  RSS     PID COMMAND
+3      3.0   2.1GiB  100 gunicorn: master (parent missing)
+1      1.0   1.0GiB    101 gunicorn: worker
+1      1.0   1.0GiB    102 gunicorn: worker
+`, b.String())
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "0B", formatBytes(0))
+	assert.Equal(t, "1.0KiB", formatBytes(1024))
+	assert.Equal(t, "1.5MiB", formatBytes(3<<19))
+}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"net/http"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -34,6 +35,16 @@ type Collector struct {
 	runCounter    int64
 	enabledChecks []checks.Check
 
+	// One delivery worker per API endpoint, in the same order, none if the
+	// intake is disabled.
+	workers []*endpointWorker
+	// One worker per additional output sink.
+	sinks []*sinkWorker
+
+	// Last status received from each endpoint, nil if its last post failed.
+	statusMu sync.Mutex
+	statuses []*model.CollectorStatus
+
 	// Controls the real-time interval, can change live.
 	realTimeInterval time.Duration
 	// Set to 1 if enabled 0 is not. We're using an integer
@@ -56,6 +67,19 @@ func NewCollector(cfg *config.AgentConfig) (Collector, error) {
 		}
 	}
 
+	var workers []*endpointWorker
+	if !cfg.DisableIntake {
+		if workers, err = newEndpointWorkers(cfg); err != nil {
+			return Collector{}, err
+		}
+	} else if len(cfg.Sinks) == 0 {
+		return Collector{}, fmt.Errorf("the intake is disabled and no sinks are configured")
+	}
+	sinks, err := newSinkWorkers(cfg)
+	if err != nil {
+		return Collector{}, err
+	}
+
 	return Collector{
 		send:          make(chan checkPayload, cfg.QueueSize),
 		rtIntervalCh:  make(chan time.Duration),
@@ -63,6 +87,9 @@ func NewCollector(cfg *config.AgentConfig) (Collector, error) {
 		groupID:       rand.Int31(),
 		httpClient:    http.Client{Timeout: HTTPTimeout, Transport: cfg.Transport},
 		enabledChecks: enabledChecks,
+		workers:       workers,
+		sinks:         sinks,
+		statuses:      make([]*model.CollectorStatus, len(workers)),
 
 		// Defaults for real-time on start
 		realTimeInterval: 2 * time.Second,
@@ -97,31 +124,35 @@ func (l *Collector) runCheck(c checks.Check) {
 }
 
 func (l *Collector) run(exit chan bool) {
-	eps := make([]string, 0, len(l.cfg.APIEndpoints))
-	for _, e := range l.cfg.APIEndpoints {
-		eps = append(eps, e.Endpoint.String())
+	eps := make([]string, 0, len(l.workers))
+	for _, w := range l.workers {
+		eps = append(eps, w.url())
 	}
-	log.Infof("Starting process-agent for host=%s, endpoints=%s, enabled checks=%v", l.cfg.HostName, eps, l.cfg.EnabledChecks)
+	sinks := make([]string, 0, len(l.sinks))
+	for _, s := range l.sinks {
+		sinks = append(sinks, s.sink.Name())
+	}
+	log.Infof("Starting process-agent for host=%s, endpoints=%s, sinks=%s, enabled checks=%v", l.cfg.HostName, eps, sinks, l.cfg.EnabledChecks)
 
 	go handleSignals(exit)
 	heartbeat := time.NewTicker(15 * time.Second)
 	queueSizeTicker := time.NewTicker(10 * time.Second)
+	for _, w := range l.workers {
+		go w.run(l, exit)
+	}
+	for _, s := range l.sinks {
+		go s.run(exit)
+	}
 	go func() {
 		for {
 			select {
 			case payload := <-l.send:
-				if len(l.send) >= l.cfg.QueueSize {
-					log.Info("Expiring payload from in-memory queue.")
-					// Limit number of items kept in memory while we wait.
-					<-l.send
-				}
-				for _, m := range payload.messages {
-					l.postMessage(payload.endpoint, m)
-				}
+				l.dispatch(payload)
 			case <-heartbeat.C:
 				statsd.Client.Gauge("datadog.process.agent", 1, []string{"version:" + Version}, 1)
 			case <-queueSizeTicker.C:
 				updateQueueSize(l.send)
+				updateEndpointQueues(l.workers)
 			case <-exit:
 				return
 			}
@@ -160,55 +191,105 @@ func (l *Collector) run(exit chan bool) {
 	<-exit
 }
 
-func (l *Collector) postMessage(checkPath string, m model.MessageBody) {
+// dispatch hands a payload over to every sink and, encoded once, to every
+// endpoint worker. It never blocks on delivery.
+func (l *Collector) dispatch(payload checkPayload) {
+	for _, s := range l.sinks {
+		s.enqueue(payload.messages)
+	}
+	if len(l.workers) == 0 {
+		return
+	}
+
+	bodies := make([][]byte, 0, len(payload.messages))
+	for _, m := range payload.messages {
+		body, err := encodePayload(m)
+		if err != nil {
+			log.Errorf("Unable to encode message: %s", err)
+			continue
+		}
+		bodies = append(bodies, body)
+	}
+	if len(bodies) == 0 {
+		return
+	}
+
+	p := endpointPayload{checkPath: payload.endpoint, bodies: bodies, created: time.Now()}
+	for _, w := range l.workers {
+		w.enqueue(p)
+	}
+}
+
+func encodePayload(m model.MessageBody) ([]byte, error) {
 	msgType, err := model.DetectMessageType(m)
 	if err != nil {
-		log.Errorf("Unable to detect message type: %s", err)
-		return
+		return nil, fmt.Errorf("unable to detect message type: %s", err)
 	}
 
-	body, err := model.EncodeMessage(model.Message{
+	return model.EncodeMessage(model.Message{
 		Header: model.MessageHeader{
 			Version:  model.MessageV3,
 			Encoding: model.MessageEncodingZstdPB,
 			Type:     msgType,
 		}, Body: m})
-	if err != nil {
-		log.Errorf("Unable to encode message: %s", err)
-	}
+}
 
-	responses := make(chan postResponse)
-	for _, ep := range l.cfg.APIEndpoints {
-		go l.postToAPI(ep, checkPath, body, responses)
-	}
+// sendResult is the outcome of sending a payload to an endpoint.
+type sendResult int
 
-	// Wait for all responses to come back before moving on.
-	statuses := make([]*model.CollectorStatus, 0, len(l.cfg.APIEndpoints))
-	for i := 0; i < len(l.cfg.APIEndpoints); i++ {
-		url := l.cfg.APIEndpoints[i].Endpoint.String()
-		res := <-responses
-		if res.err != nil {
-			log.Error(res.err)
-			continue
+const (
+	// The endpoint accepted the payload.
+	sendDelivered sendResult = iota
+	// The payload could not be delivered for now, it's worth trying again.
+	sendRetryLater
+	// The endpoint refused the payload, sending it again won't change that.
+	sendRejected
+)
+
+// sendPayload posts an encoded payload to the worker's endpoint.
+func (l *Collector) sendPayload(w *endpointWorker, checkPath string, body []byte) sendResult {
+	res := l.postToAPI(w, checkPath, body)
+	if res.err != nil {
+		log.Error(res.err)
+		l.setEndpointStatus(w.index, nil)
+		if res.rejected {
+			return sendRejected
 		}
+		return sendRetryLater
+	}
 
-		r := res.msg
-		switch r.Header.Type {
-		case model.TypeResCollector:
-			rm := r.Body.(*model.ResCollector)
-			if len(rm.Message) > 0 {
-				log.Errorf("error in response from %s: %s", url, rm.Message)
-			} else {
-				statuses = append(statuses, rm.Status)
-			}
-		default:
-			log.Errorf("unexpected response type from %s: %d", url, r.Header.Type)
+	r := res.msg
+	switch r.Header.Type {
+	case model.TypeResCollector:
+		rm := r.Body.(*model.ResCollector)
+		if len(rm.Message) > 0 {
+			log.Errorf("error in response from %s: %s", w.url(), rm.Message)
+		} else {
+			l.setEndpointStatus(w.index, rm.Status)
 		}
+	default:
+		log.Errorf("unexpected response type from %s: %d", w.url(), r.Header.Type)
 	}
+	return sendDelivered
+}
+
+// setEndpointStatus records the last status sent back by an endpoint and
+// updates the real-time settings from the statuses of all the endpoints.
+func (l *Collector) setEndpointStatus(index int, status *model.CollectorStatus) {
+	l.statusMu.Lock()
+	defer l.statusMu.Unlock()
 
-	if len(statuses) > 0 {
-		l.updateStatus(statuses)
+	l.statuses[index] = status
+	if status == nil {
+		return
+	}
+	statuses := make([]*model.CollectorStatus, 0, len(l.statuses))
+	for _, s := range l.statuses {
+		if s != nil {
+			statuses = append(statuses, s)
+		}
 	}
+	l.updateStatus(statuses)
 }
 
 func (l *Collector) updateStatus(statuses []*model.CollectorStatus) {
@@ -252,19 +333,72 @@ func (l *Collector) updateStatus(statuses []*model.CollectorStatus) {
 type postResponse struct {
 	msg model.Message
 	err error
+	// The endpoint refused the payload with a 4xx status other than 429.
+	rejected bool
 }
 
 func errResponse(format string, a ...interface{}) postResponse {
 	return postResponse{err: fmt.Errorf(format, a...)}
 }
 
-func (l *Collector) postToAPI(endpoint config.APIEndpoint, checkPath string, body []byte, responses chan postResponse) {
-	endpoint.Endpoint.Path = checkPath
-	url := endpoint.Endpoint.String()
+// postToAPI sends a payload to the worker's endpoint, retrying transient
+// failures with backoff. Endpoints that keep failing are skipped by their
+// circuit breaker until their cooldown is over.
+func (l *Collector) postToAPI(w *endpointWorker, checkPath string, body []byte) postResponse {
+	u := *w.endpoint.Endpoint
+	u.Path = checkPath
+	url := u.String()
+	if !w.breaker.allow() {
+		return errResponse("circuit breaker open for %s, skipping payload", url)
+	}
+
+	backoff := backoffPolicy{base: l.cfg.RetryBaseDelay, max: l.cfg.RetryMaxDelay}
+	for n := 0; ; n++ {
+		attempt := l.tryPostToAPI(w.endpoint, url, body)
+		if !attempt.retryable {
+			// Either it went through or the endpoint is up but rejected the
+			// payload, in both cases there's no point in retrying.
+			if attempt.responded {
+				w.breaker.success()
+			}
+			return attempt.res
+		}
+		if l.cfg.RetryMaxDelay > 0 && attempt.retryAfter > l.cfg.RetryMaxDelay {
+			// Waiting that long would hold up the payloads behind this one:
+			// give up on it and leave the endpoint alone until then.
+			w.breaker.holdOff(time.Now().Add(attempt.retryAfter))
+			return errResponse("%s, asked to retry in %s", attempt.res.err, attempt.retryAfter)
+		}
+		if n >= l.cfg.MaxRetries {
+			w.breaker.failure()
+			return attempt.res
+		}
+
+		d := backoff.delay(n)
+		if attempt.retryAfter > 0 {
+			d = attempt.retryAfter
+		}
+		log.Debugf("%s, retrying in %s (%d/%d)", attempt.res.err, d, n+1, l.cfg.MaxRetries)
+		time.Sleep(d)
+	}
+}
+
+// postAttempt is the outcome of a single attempt at sending a payload.
+type postAttempt struct {
+	res postResponse
+	// The endpoint answered with a 2xx or 4xx status, it's up.
+	responded bool
+	// The failure, if any, is worth retrying, after retryAfter if the
+	// endpoint asked for it.
+	retryable  bool
+	retryAfter time.Duration
+}
+
+// tryPostToAPI makes a single attempt at sending a payload.
+func (l *Collector) tryPostToAPI(endpoint config.APIEndpoint, url string, body []byte) postAttempt {
 	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
 	if err != nil {
-		responses <- errResponse("could not create request to %s: %s", url, err)
-		return
+		return postAttempt{res: errResponse("could not create request to %s: %s", url, err)}
 	}
 
 	req.Header.Add("X-Dd-APIKey", endpoint.APIKey)
@@ -273,34 +407,35 @@ func (l *Collector) postToAPI(endpoint config.APIEndpoint, checkPath string, bod
 
 	ctx, cancel := context.WithTimeout(context.Background(), ReqCtxTimeout)
 	defer cancel()
-	req.WithContext(ctx)
+	req = req.WithContext(ctx)
 
 	resp, err := l.httpClient.Do(req)
 	if err != nil {
 		if isHTTPTimeout(err) {
-			responses <- errResponse("Timeout detected on %s, %s", url, err)
-		} else {
-			responses <- errResponse("Error submitting payload to %s: %s", url, err)
+			return postAttempt{res: errResponse("Timeout detected on %s, %s", url, err), retryable: true}
 		}
-		return
+		return postAttempt{res: errResponse("Error submitting payload to %s: %s", url, err), retryable: true}
 	}
 
 	defer resp.Body.Close()
 	if resp.StatusCode < 200 || resp.StatusCode > 300 {
-		responses <- errResponse("unexpected response from %s. Status: %s", url, resp.Status)
 		io.Copy(ioutil.Discard, resp.Body)
-		return
+		res := errResponse("unexpected response from %s. Status: %s", url, resp.Status)
+		if !isRetryableStatus(resp.StatusCode) {
+			res.rejected = resp.StatusCode >= 400 && resp.StatusCode < 500
+			return postAttempt{res: res, responded: res.rejected}
+		}
+		return postAttempt{res: res, retryable: true, retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
 	}
 
 	body, err = ioutil.ReadAll(resp.Body)
 	if err != nil {
-		responses <- errResponse("could not decode response body from %s: %s", url, err)
-		return
+		return postAttempt{res: errResponse("could not decode response body from %s: %s", url, err), responded: true}
 	}
 
 	r, err := model.DecodeMessage(body)
 	if err != nil {
-		responses <- errResponse("could not decode message from %s: %s", url, err)
+		return postAttempt{res: errResponse("could not decode message from %s: %s", url, err), responded: true}
 	}
-	responses <- postResponse{r, err}
+	return postAttempt{res: postResponse{msg: r}, responded: true}
 }
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/cihub/seelog"
+)
+
+const (
+	diskQueueExt    = ".payload"
+	diskQueueTmpExt = ".tmp"
+)
+
+// diskQueue is an on-disk FIFO of encoded payloads that could not be delivered.
+// Each payload is stored in its own file, named after the time it was first
+// queued so that a directory listing gives the delivery order back, even
+// across restarts and when a payload that failed to be delivered is written
+// after newer ones that were spooled in the meantime.
+// The queue is bounded both in total size and in age: the oldest payloads are
+// dropped first when either limit is reached.
+type diskQueue struct {
+	sync.Mutex
+
+	dir      string
+	maxBytes int64
+	maxAge   time.Duration
+	seq      uint64
+}
+
+// queuedPayload is a single payload read back from the diskQueue.
+type queuedPayload struct {
+	name      string
+	checkPath string
+	body      []byte
+}
+
+func newDiskQueue(dir string, maxBytes int64, maxAge time.Duration) (*diskQueue, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("could not create queue directory %s: %s", dir, err)
+	}
+	q := &diskQueue{dir: dir, maxBytes: maxBytes, maxAge: maxAge}
+
+	// Remove leftovers of writes interrupted by a crash.
+	tmps, _ := filepath.Glob(filepath.Join(dir, "*"+diskQueueTmpExt))
+	for _, t := range tmps {
+		os.Remove(t)
+	}
+	return q, nil
+}
+
+// push inserts a payload first queued at created, usually at the tail of the
+// queue, and enforces the size limit.
+func (q *diskQueue) push(checkPath string, body []byte, created time.Time) error {
+	q.Lock()
+	defer q.Unlock()
+
+	q.seq++
+	name := fmt.Sprintf("%020d-%06d%s", created.UnixNano(), q.seq%1000000, diskQueueExt)
+	path := filepath.Join(q.dir, name)
+
+	var buf bytes.Buffer
+	buf.WriteString(checkPath)
+	buf.WriteByte('\n')
+	buf.Write(body)
+
+	// Write to a temporary file first so a crash never leaves a truncated payload behind.
+	if err := ioutil.WriteFile(path+diskQueueTmpExt, buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(path+diskQueueTmpExt, path); err != nil {
+		return err
+	}
+	return q.prune()
+}
+
+// peek returns the oldest payload still in the queue, or nil if it is empty.
+func (q *diskQueue) peek() (*queuedPayload, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	if err := q.prune(); err != nil {
+		return nil, err
+	}
+	files, err := q.files()
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+
+	name := files[0].Name()
+	data, err := ioutil.ReadFile(filepath.Join(q.dir, name))
+	if err != nil {
+		return nil, err
+	}
+	i := bytes.IndexByte(data, '\n')
+	if i < 0 {
+		// Not something we wrote, drop it so it doesn't block the queue forever.
+		os.Remove(filepath.Join(q.dir, name))
+		return nil, fmt.Errorf("invalid payload file %s in queue", name)
+	}
+	return &queuedPayload{
+		name:      name,
+		checkPath: string(data[:i]),
+		body:      data[i+1:],
+	}, nil
+}
+
+// remove deletes a payload previously returned by peek.
+func (q *diskQueue) remove(p *queuedPayload) error {
+	q.Lock()
+	defer q.Unlock()
+	if err := os.Remove(filepath.Join(q.dir, p.name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// len returns the number of payloads waiting in the queue.
+func (q *diskQueue) len() int {
+	q.Lock()
+	defer q.Unlock()
+	files, _ := q.files()
+	return len(files)
+}
+
+// prune drops the payloads that are older than maxAge and then the oldest
+// payloads until the queue fits within maxBytes. The caller must hold the lock.
+func (q *diskQueue) prune() error {
+	files, err := q.files()
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, f := range files {
+		total += f.Size()
+	}
+
+	expired, evicted := 0, 0
+	for _, f := range files {
+		switch {
+		case q.maxAge > 0 && time.Since(createdAt(f.Name())) > q.maxAge:
+			expired++
+		case q.maxBytes > 0 && total > q.maxBytes:
+			evicted++
+		default:
+			continue
+		}
+		if err := os.Remove(filepath.Join(q.dir, f.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		total -= f.Size()
+	}
+
+	if expired > 0 {
+		log.Infof("Expired %d payloads older than %s from on-disk queue.", expired, q.maxAge)
+	}
+	if evicted > 0 {
+		log.Infof("Dropped %d payloads from on-disk queue to stay under %d bytes.", evicted, q.maxBytes)
+	}
+	return nil
+}
+
+// files lists the queued payload files, oldest first (ReadDir sorts by name).
+func (q *diskQueue) files() ([]os.FileInfo, error) {
+	all, err := ioutil.ReadDir(q.dir)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]os.FileInfo, 0, len(all))
+	for _, f := range all {
+		if f.Mode().IsRegular() && strings.HasSuffix(f.Name(), diskQueueExt) {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
+// createdAt extracts the time a payload was first queued from its file name.
+func createdAt(name string) time.Time {
+	i := strings.IndexByte(name, '-')
+	if i < 0 {
+		return time.Time{}
+	}
+	ns, err := strconv.ParseInt(name[:i], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+func newTestDiskQueue(t *testing.T, maxBytes int64, maxAge time.Duration) (*diskQueue, func()) {
+	dir, err := ioutil.TempDir("", "process-agent-queue")
+	assert.NoError(t, err)
+	q, err := newDiskQueue(dir, maxBytes, maxAge)
+	assert.NoError(t, err)
+	return q, func() { os.RemoveAll(dir) }
+}
+
+func TestDiskQueueOrder(t *testing.T) {
+	assert := assert.New(t)
+	q, cleanup := newTestDiskQueue(t, 0, 0)
+	defer cleanup()
+
+	assert.NoError(q.push("/api/v1/collector", []byte("first"), time.Now()))
+	assert.NoError(q.push("/api/v1/container", []byte("second\nline"), time.Now()))
+	// Queued before the others, e.g. while it was in flight.
+	assert.NoError(q.push("/api/v1/collector", []byte("zeroth"), time.Now().Add(-time.Second)))
+	assert.Equal(3, q.len())
+
+	p, err := q.peek()
+	assert.NoError(err)
+	assert.Equal([]byte("zeroth"), p.body)
+	assert.NoError(q.remove(p))
+
+	p, err = q.peek()
+	assert.NoError(err)
+	assert.Equal("/api/v1/collector", p.checkPath)
+	assert.Equal([]byte("first"), p.body)
+	assert.NoError(q.remove(p))
+
+	p, err = q.peek()
+	assert.NoError(err)
+	assert.Equal("/api/v1/container", p.checkPath)
+	assert.Equal([]byte("second\nline"), p.body)
+	assert.NoError(q.remove(p))
+
+	p, err = q.peek()
+	assert.NoError(err)
+	assert.Nil(p)
+	assert.Equal(0, q.len())
+}
+
+func TestDiskQueueSurvivesRestart(t *testing.T) {
+	assert := assert.New(t)
+	q, cleanup := newTestDiskQueue(t, 0, 0)
+	defer cleanup()
+
+	assert.NoError(q.push("/api/v1/collector", []byte("payload"), time.Now()))
+	// Simulate a write interrupted by a crash.
+	assert.NoError(ioutil.WriteFile(filepath.Join(q.dir, "1-1.payload.tmp"), []byte("junk"), 0600))
+
+	q, err := newDiskQueue(q.dir, 0, 0)
+	assert.NoError(err)
+	assert.Equal(1, q.len())
+	p, err := q.peek()
+	assert.NoError(err)
+	assert.Equal([]byte("payload"), p.body)
+	assert.False(util.PathExists(filepath.Join(q.dir, "1-1.payload.tmp")))
+}
+
+func TestDiskQueueLimits(t *testing.T) {
+	assert := assert.New(t)
+
+	// Each payload takes len("/p\n") + 10 = 13 bytes, so only 3 fit.
+	q, cleanup := newTestDiskQueue(t, 40, 0)
+	defer cleanup()
+	for _, b := range []string{"aaaaaaaaaa", "bbbbbbbbbb", "cccccccccc", "dddddddddd"} {
+		assert.NoError(q.push("/p", []byte(b), time.Now()))
+	}
+	assert.Equal(3, q.len())
+	p, err := q.peek()
+	assert.NoError(err)
+	assert.Equal([]byte("bbbbbbbbbb"), p.body)
+
+	q, cleanup = newTestDiskQueue(t, 0, time.Millisecond)
+	defer cleanup()
+	assert.NoError(q.push("/p", []byte("old"), time.Now()))
+	time.Sleep(5 * time.Millisecond)
+	p, err = q.peek()
+	assert.NoError(err)
+	assert.Nil(p)
+}
+
+func TestCollectorDrainsDiskQueueInOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	var mu sync.Mutex
+	up := false
+	var received [][]byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if !up {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		received = append(received, body)
+		w.Write(resCollectorV1(&model.ResCollector{Status: &model.CollectorStatus{}}))
+	}))
+	defer srv.Close()
+
+	q, cleanup := newTestDiskQueue(t, 0, 0)
+	defer cleanup()
+	u, _ := url.Parse(srv.URL)
+	cfg := config.NewDefaultAgentConfig()
+	cfg.APIEndpoints = []config.APIEndpoint{{Endpoint: u}}
+	cfg.MaxRetries = 0
+	c, err := NewCollector(cfg)
+	assert.NoError(err)
+	c.rtIntervalCh = make(chan time.Duration, 1000)
+	w := c.workers[0]
+	w.diskQueue = q
+
+	msgs := []model.MessageBody{
+		&model.CollectorProc{HostName: "first"},
+		&model.CollectorProc{HostName: "second"},
+		&model.CollectorProc{HostName: "third"},
+	}
+	expected := make([][]byte, 0, len(msgs))
+	for _, m := range msgs {
+		b, err := encodePayload(m)
+		assert.NoError(err)
+		expected = append(expected, b)
+	}
+
+	// The intake is down: both payloads end up on disk. The second one was
+	// spooled by enqueue while the first was in flight, and still goes after it.
+	created := time.Now()
+	w.spool(endpointPayload{checkPath: "/api/v1/collector", bodies: [][]byte{expected[1]}, created: created.Add(time.Second)})
+	w.deliver(&c, "/api/v1/collector", expected[0], created)
+	assert.Equal(2, q.len())
+	assert.Empty(received)
+
+	// Once it's back, the backlog is delivered before the new payload.
+	mu.Lock()
+	up = true
+	mu.Unlock()
+	w.deliver(&c, "/api/v1/collector", expected[2], created.Add(2*time.Second))
+	assert.Equal(0, q.len())
+	assert.Equal(expected, received)
+}
+
+// resCollectorV1 builds an intake response the way the backend encodes it.
+func resCollectorV1(m *model.ResCollector) []byte {
+	body, _ := m.Marshal()
+	return append([]byte{byte(model.MessageV1), byte(model.MessageEncodingProtobuf), model.TypeResCollector, 0}, body...)
+}
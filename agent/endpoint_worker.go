@@ -0,0 +1,278 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/config"
+)
+
+// endpointPayload is the encoded form of a checkPayload, shared read-only by
+// all the endpoint workers.
+type endpointPayload struct {
+	checkPath string
+	bodies    [][]byte
+	// When the payload was queued, which orders it in the on-disk queue.
+	created time.Time
+}
+
+// endpointWorker delivers payloads to a single API endpoint. Every endpoint
+// has its own queue, retries and circuit breaker so that a slow or dead
+// endpoint never holds up delivery to the others.
+type endpointWorker struct {
+	index    int
+	endpoint config.APIEndpoint
+	queue    chan endpointPayload
+	breaker  *circuitBreaker
+
+	// Optional on-disk spool for payloads that could not be delivered.
+	diskQueue *diskQueue
+
+	// Number of payloads dropped because the queues were full, accessed atomically.
+	dropped int64
+}
+
+func newEndpointWorker(cfg *config.AgentConfig, index int, endpoint config.APIEndpoint, queueDir string) (*endpointWorker, error) {
+	// Work on our own copy of the URL so it's never shared between workers.
+	u := *endpoint.Endpoint
+	endpoint.Endpoint = &u
+
+	w := &endpointWorker{
+		index:    index,
+		endpoint: endpoint,
+		queue:    make(chan endpointPayload, queueCapacity(cfg)),
+		breaker:  newCircuitBreaker(cfg.CircuitBreakerThreshold, cfg.CircuitBreakerCooldown),
+	}
+	if queueDir != "" {
+		dq, err := newDiskQueue(queueDir, endpointQueueMaxBytes(cfg), cfg.QueueMaxAge)
+		if err != nil {
+			return nil, err
+		}
+		w.diskQueue = dq
+	}
+	return w, nil
+}
+
+// newEndpointWorkers creates one worker per configured API endpoint. When an
+// on-disk queue is configured each endpoint gets its own sub-directory, named
+// after its host so that it's still picked up if the endpoints are reordered,
+// and the payloads spooled in the root of the directory by older versions are
+// handed over to all of them.
+func newEndpointWorkers(cfg *config.AgentConfig) ([]*endpointWorker, error) {
+	workers := make([]*endpointWorker, 0, len(cfg.APIEndpoints))
+	seen := make(map[string]bool, len(cfg.APIEndpoints))
+	for i, ep := range cfg.APIEndpoints {
+		var dir string
+		if cfg.QueueDir != "" {
+			name := endpointQueueName(ep.Endpoint.Host)
+			if seen[name] {
+				name = fmt.Sprintf("%s-%d", name, i)
+			}
+			seen[name] = true
+			dir = filepath.Join(cfg.QueueDir, name)
+		}
+		w, err := newEndpointWorker(cfg, i, ep, dir)
+		if err != nil {
+			return nil, err
+		}
+		workers = append(workers, w)
+	}
+	if cfg.QueueDir != "" {
+		if err := migrateRootQueue(cfg.QueueDir, workers); err != nil {
+			log.Errorf("Could not migrate on-disk queue %s: %s", cfg.QueueDir, err)
+		}
+	}
+	return workers, nil
+}
+
+// endpointQueueMaxBytes splits the size limit of the on-disk queue evenly
+// between the endpoints so that queue_max_bytes still bounds the total.
+func endpointQueueMaxBytes(cfg *config.AgentConfig) int64 {
+	if cfg.QueueMaxBytes <= 0 || len(cfg.APIEndpoints) < 2 {
+		return cfg.QueueMaxBytes
+	}
+	return cfg.QueueMaxBytes / int64(len(cfg.APIEndpoints))
+}
+
+// migrateRootQueue moves the payloads queued in the root of dir, from when all
+// the endpoints shared a single on-disk queue, to the queue of every worker.
+// They keep their name, and so their place in the delivery order.
+func migrateRootQueue(dir string, workers []*endpointWorker) error {
+	root := &diskQueue{dir: dir}
+	files, err := root.files()
+	if err != nil || len(files) == 0 {
+		return err
+	}
+	for _, f := range files {
+		src := filepath.Join(dir, f.Name())
+		data, err := ioutil.ReadFile(src)
+		if err != nil {
+			return err
+		}
+		for _, w := range workers {
+			dst := filepath.Join(w.diskQueue.dir, f.Name())
+			if err := ioutil.WriteFile(dst+diskQueueTmpExt, data, 0600); err != nil {
+				return err
+			}
+			if err := os.Rename(dst+diskQueueTmpExt, dst); err != nil {
+				return err
+			}
+		}
+		if err := os.Remove(src); err != nil {
+			return err
+		}
+	}
+	log.Infof("Moved %d payloads from %s to the on-disk queues of the endpoints.", len(files), dir)
+	return nil
+}
+
+// endpointQueueName turns a host into something safe to use as a directory name.
+func endpointQueueName(host string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		}
+		return '_'
+	}, host)
+	if name == "" {
+		return "default"
+	}
+	return name
+}
+
+// queueCapacity is the capacity of the in-memory queue of a worker. It can't
+// be 0: enqueue would then never find room while the worker is busy.
+func queueCapacity(cfg *config.AgentConfig) int {
+	if cfg.QueueSize < 1 {
+		return 1
+	}
+	return cfg.QueueSize
+}
+
+func (w *endpointWorker) url() string {
+	return w.endpoint.Endpoint.String()
+}
+
+// enqueue hands a payload over to the worker without ever blocking. If the
+// queue is full the oldest payload is spooled to disk or, without an on-disk
+// queue, dropped.
+func (w *endpointWorker) enqueue(p endpointPayload) {
+	for {
+		select {
+		case w.queue <- p:
+			return
+		default:
+		}
+
+		select {
+		case oldest := <-w.queue:
+			if w.diskQueue != nil {
+				log.Infof("Spooling payload for %s from in-memory queue to disk.", w.url())
+				w.spool(oldest)
+			} else {
+				log.Infof("Expiring payload for %s from in-memory queue.", w.url())
+				atomic.AddInt64(&w.dropped, 1)
+			}
+		default:
+		}
+	}
+}
+
+func (w *endpointWorker) run(l *Collector, exit chan bool) {
+	diskQueueTicker := time.NewTicker(30 * time.Second)
+	defer diskQueueTicker.Stop()
+
+	// Deliver whatever was left on disk by a previous run first.
+	w.drainDiskQueue(l)
+	for {
+		select {
+		case p := <-w.queue:
+			for _, body := range p.bodies {
+				w.deliver(l, p.checkPath, body, p.created)
+			}
+		case <-diskQueueTicker.C:
+			w.drainDiskQueue(l)
+		case <-exit:
+			return
+		}
+	}
+}
+
+// deliver sends a message or, if it can't be delivered yet, spools it. A
+// message spooled while it was in flight still goes before the newer ones
+// enqueue spooled in the meantime, the on-disk queue being ordered by
+// creation time. Messages the endpoint rejects are dropped.
+func (w *endpointWorker) deliver(l *Collector, checkPath string, body []byte, created time.Time) {
+	if w.diskQueue == nil {
+		if l.sendPayload(w, checkPath, body) != sendDelivered {
+			atomic.AddInt64(&w.dropped, 1)
+		}
+		return
+	}
+
+	// Payloads must be delivered in order, so if older ones are still waiting
+	// on disk we queue up behind them and try to drain the backlog.
+	if w.diskQueue.len() > 0 {
+		w.push(checkPath, body, created)
+		w.drainDiskQueue(l)
+		return
+	}
+	switch l.sendPayload(w, checkPath, body) {
+	case sendRetryLater:
+		w.push(checkPath, body, created)
+	case sendRejected:
+		atomic.AddInt64(&w.dropped, 1)
+	}
+}
+
+// spool writes all the messages of a payload straight to the on-disk queue.
+func (w *endpointWorker) spool(p endpointPayload) {
+	for _, body := range p.bodies {
+		w.push(p.checkPath, body, p.created)
+	}
+}
+
+func (w *endpointWorker) push(checkPath string, body []byte, created time.Time) {
+	if err := w.diskQueue.push(checkPath, body, created); err != nil {
+		log.Errorf("Unable to write payload for %s to on-disk queue: %s", w.url(), err)
+		atomic.AddInt64(&w.dropped, 1)
+	}
+}
+
+// drainDiskQueue delivers the spooled payloads in order, stopping at the first
+// one that cannot be delivered yet so that it is retried later. The ones the
+// endpoint rejects are removed.
+func (w *endpointWorker) drainDiskQueue(l *Collector) {
+	if w.diskQueue == nil {
+		return
+	}
+	for {
+		p, err := w.diskQueue.peek()
+		if err != nil {
+			log.Errorf("Unable to read payload for %s from on-disk queue: %s", w.url(), err)
+			return
+		}
+		if p == nil {
+			return
+		}
+		switch l.sendPayload(w, p.checkPath, p.body) {
+		case sendRetryLater:
+			return
+		case sendRejected:
+			log.Warnf("Dropping payload for %s rejected by the endpoint from on-disk queue.", w.url())
+			atomic.AddInt64(&w.dropped, 1)
+		}
+		if err := w.diskQueue.remove(p); err != nil {
+			log.Errorf("Unable to remove payload for %s from on-disk queue: %s", w.url(), err)
+			return
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestSlowEndpointDoesNotBlockOthers(t *testing.T) {
+	assert := assert.New(t)
+
+	unblock := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-unblock
+		w.Write(resCollectorV1(&model.ResCollector{Status: &model.CollectorStatus{}}))
+	}))
+	defer slow.Close()
+	defer close(unblock)
+
+	var received int32
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&received, 1)
+		w.Write(resCollectorV1(&model.ResCollector{Status: &model.CollectorStatus{}}))
+	}))
+	defer fast.Close()
+
+	fastURL, _ := url.Parse(fast.URL)
+	slowURL, _ := url.Parse(slow.URL)
+	cfg := config.NewDefaultAgentConfig()
+	cfg.APIEndpoints = []config.APIEndpoint{{Endpoint: fastURL}, {Endpoint: slowURL}}
+	cfg.QueueSize = 10
+	c, err := NewCollector(cfg)
+	assert.NoError(err)
+	c.rtIntervalCh = make(chan time.Duration, 1000)
+
+	exit := make(chan bool)
+	defer close(exit)
+	for _, w := range c.workers {
+		go w.run(&c, exit)
+	}
+
+	const n = 8
+	for i := 0; i < n; i++ {
+		c.dispatch(checkPayload{
+			messages: []model.MessageBody{&model.CollectorProc{HostName: "host"}},
+			endpoint: "/api/v1/collector",
+		})
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for atomic.LoadInt32(&received) < n && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Equal(int32(n), atomic.LoadInt32(&received))
+	assert.Equal(int64(0), atomic.LoadInt64(&c.workers[0].dropped))
+	// The slow endpoint is still stuck on its first payload.
+	assert.True(len(c.workers[1].queue) >= n-1)
+}
+
+func TestEndpointWorkerDropsRejectedPayloads(t *testing.T) {
+	assert := assert.New(t)
+
+	var delivered int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if string(body) == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		atomic.AddInt32(&delivered, 1)
+		w.Write(resCollectorV1(&model.ResCollector{Status: &model.CollectorStatus{}}))
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "process-agent-queue")
+	assert.NoError(err)
+	u, _ := url.Parse(srv.URL)
+	cfg := config.NewDefaultAgentConfig()
+	cfg.APIEndpoints = []config.APIEndpoint{{Endpoint: u}}
+	cfg.QueueDir = dir
+	c, err := NewCollector(cfg)
+	assert.NoError(err)
+	c.rtIntervalCh = make(chan time.Duration, 1000)
+	w := c.workers[0]
+
+	// A rejected payload is not spooled.
+	w.deliver(&c, "/api/v1/collector", []byte("bad"), time.Now())
+	assert.Equal(0, w.diskQueue.len())
+	assert.Equal(int64(1), atomic.LoadInt64(&w.dropped))
+
+	// Nor does it hold up the ones spooled after it.
+	now := time.Now()
+	assert.NoError(w.diskQueue.push("/api/v1/collector", []byte("bad"), now))
+	assert.NoError(w.diskQueue.push("/api/v1/collector", []byte("good"), now.Add(time.Second)))
+	w.deliver(&c, "/api/v1/collector", []byte("good"), now.Add(2*time.Second))
+	assert.Equal(0, w.diskQueue.len())
+	assert.Equal(int64(2), atomic.LoadInt64(&w.dropped))
+	assert.Equal(int32(2), atomic.LoadInt32(&delivered))
+}
+
+func TestEndpointWorkerDropsOldest(t *testing.T) {
+	assert := assert.New(t)
+
+	u, _ := url.Parse("https://process.datadoghq.com")
+	cfg := config.NewDefaultAgentConfig()
+	cfg.QueueSize = 2
+	w, err := newEndpointWorker(cfg, 0, config.APIEndpoint{Endpoint: u}, "")
+	assert.NoError(err)
+
+	for _, path := range []string{"/1", "/2", "/3", "/4", "/5"} {
+		w.enqueue(endpointPayload{checkPath: path})
+	}
+	assert.Equal(int64(3), atomic.LoadInt64(&w.dropped))
+	assert.Equal("/4", (<-w.queue).checkPath)
+	assert.Equal("/5", (<-w.queue).checkPath)
+}
+
+func TestEndpointWorkerZeroQueueSize(t *testing.T) {
+	u, _ := url.Parse("https://process.datadoghq.com")
+	cfg := config.NewDefaultAgentConfig()
+	cfg.QueueSize = 0
+	w, err := newEndpointWorker(cfg, 0, config.APIEndpoint{Endpoint: u}, "")
+	assert.NoError(t, err)
+
+	// Nobody reads the queue, as with a worker busy retrying: enqueue must
+	// still return.
+	done := make(chan struct{})
+	go func() {
+		w.enqueue(endpointPayload{checkPath: "/1"})
+		w.enqueue(endpointPayload{checkPath: "/2"})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("enqueue blocked with a queue size of 0")
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(&w.dropped))
+	assert.Equal(t, "/2", (<-w.queue).checkPath)
+}
+
+func TestEndpointWorkersQueueDirs(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "process-agent-queue")
+	assert.NoError(err)
+	a, _ := url.Parse("https://process.datadoghq.com")
+	b, _ := url.Parse("https://process.datadoghq.com:8443")
+	cfg := config.NewDefaultAgentConfig()
+	cfg.QueueDir = dir
+	cfg.APIEndpoints = []config.APIEndpoint{{Endpoint: a}, {Endpoint: a, APIKey: "other"}, {Endpoint: b}}
+
+	workers, err := newEndpointWorkers(cfg)
+	assert.NoError(err)
+	assert.Len(workers, 3)
+	assert.Equal(filepath.Join(dir, "process.datadoghq.com"), workers[0].diskQueue.dir)
+	assert.Equal(filepath.Join(dir, "process.datadoghq.com-1"), workers[1].diskQueue.dir)
+	assert.Equal(filepath.Join(dir, "process.datadoghq.com_8443"), workers[2].diskQueue.dir)
+
+	// Workers don't share the configured URL.
+	assert.False(workers[0].endpoint.Endpoint == a)
+
+	// The size limit is split between the endpoints.
+	assert.Equal(cfg.QueueMaxBytes/3, workers[0].diskQueue.maxBytes)
+}
+
+func TestEndpointWorkersMigrateRootQueue(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "process-agent-queue")
+	assert.NoError(err)
+	root, err := newDiskQueue(dir, 0, 0)
+	assert.NoError(err)
+	now := time.Now()
+	assert.NoError(root.push("/api/v1/collector", []byte("old"), now.Add(-time.Minute)))
+	assert.NoError(root.push("/api/v1/container", []byte("older"), now.Add(-2*time.Minute)))
+
+	a, _ := url.Parse("https://process.datadoghq.com")
+	b, _ := url.Parse("https://process.datadoghq.eu")
+	cfg := config.NewDefaultAgentConfig()
+	cfg.QueueDir = dir
+	cfg.APIEndpoints = []config.APIEndpoint{{Endpoint: a}, {Endpoint: b}}
+
+	workers, err := newEndpointWorkers(cfg)
+	assert.NoError(err)
+	assert.Equal(0, root.len())
+	for _, w := range workers {
+		assert.Equal(2, w.diskQueue.len())
+		p, err := w.diskQueue.peek()
+		assert.NoError(err)
+		assert.Equal("/api/v1/container", p.checkPath)
+		assert.Equal([]byte("older"), p.body)
+	}
+}
+
+func TestDispatchToSinksOnly(t *testing.T) {
+	assert := assert.New(t)
+
+	cfg := config.NewDefaultAgentConfig()
+	cfg.DisableIntake = true
+	_, err := NewCollector(cfg)
+	assert.Error(err)
+
+	cfg.Sinks = []config.SinkConfig{{Type: config.SinkTypeStdout}}
+	c, err := NewCollector(cfg)
+	assert.NoError(err)
+	assert.Len(c.workers, 0)
+	assert.Len(c.sinks, 1)
+
+	msgs := []model.MessageBody{&model.CollectorProc{HostName: "host"}}
+	c.dispatch(checkPayload{messages: msgs, endpoint: "/api/v1/collector"})
+	assert.Equal(msgs, <-c.sinks[0].queue)
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/DataDog/datadog-process-agent/config"
@@ -30,6 +31,7 @@ var (
 	infoProcCount       int
 	infoContainerCount  int
 	infoQueueSize       int
+	infoEndpointQueues  []endpointQueueInfo
 )
 
 const (
@@ -46,7 +48,8 @@ const (
   Docker socket: {{.Status.DockerSocket}}{{end}}
   Number of processes: {{.Status.ProcessCount}}
   Number of containers: {{.Status.ContainerCount}}
-  Queue length: {{.Status.QueueSize}}
+  Queue length: {{.Status.QueueSize}}{{range .Status.EndpointQueues}}
+  Endpoint {{.Endpoint}}: queue length {{.QueueSize}}, dropped {{.Dropped}}{{if .DiskQueueSize}}, on disk {{.DiskQueueSize}}{{end}}{{end}}
 
   Logs: {{.Status.Config.LogFile}}{{if .Status.ProxyURL}}
   HttpProxy: {{.Status.ProxyURL}}{{end}}{{if ne .Status.ContainerID ""}}
@@ -148,6 +151,38 @@ func publishQueueSize() interface{} {
 	return infoQueueSize
 }
 
+type endpointQueueInfo struct {
+	Endpoint      string `json:"endpoint"`
+	QueueSize     int    `json:"queue_size"`
+	DiskQueueSize int    `json:"disk_queue_size"`
+	Dropped       int64  `json:"dropped"`
+}
+
+func updateEndpointQueues(workers []*endpointWorker) {
+	queues := make([]endpointQueueInfo, 0, len(workers))
+	for _, w := range workers {
+		q := endpointQueueInfo{
+			Endpoint:  w.url(),
+			QueueSize: len(w.queue),
+			Dropped:   atomic.LoadInt64(&w.dropped),
+		}
+		if w.diskQueue != nil {
+			q.DiskQueueSize = w.diskQueue.len()
+		}
+		queues = append(queues, q)
+	}
+
+	infoMutex.Lock()
+	defer infoMutex.Unlock()
+	infoEndpointQueues = queues
+}
+
+func publishEndpointQueues() interface{} {
+	infoMutex.RLock()
+	defer infoMutex.RUnlock()
+	return infoEndpointQueues
+}
+
 func publishContainerID() interface{} {
 	cgroupFile := "/proc/self/cgroup"
 	if !util.PathExists(cgroupFile) {
@@ -210,6 +245,7 @@ type StatusInfo struct {
 	ProcessCount    int                    `json:"process_count"`
 	ContainerCount  int                    `json:"container_count"`
 	QueueSize       int                    `json:"queue_size"`
+	EndpointQueues  []endpointQueueInfo    `json:"endpoint_queues"`
 	ContainerID     string                 `json:"container_id"`
 	ProxyURL        string                 `json:"proxy_url"`
 }
@@ -234,6 +270,7 @@ func initInfo(conf *config.AgentConfig) error {
 		expvar.Publish("process_count", expvar.Func(publishProcCount))
 		expvar.Publish("container_count", expvar.Func(publishContainerCount))
 		expvar.Publish("queue_size", expvar.Func(publishQueueSize))
+		expvar.Publish("endpoint_queues", expvar.Func(publishEndpointQueues))
 		expvar.Publish("container_id", expvar.Func(publishContainerID))
 		c := *conf
 		var buf []byte
@@ -26,6 +26,7 @@ var opts struct {
 	debug        bool
 	version      bool
 	check        string
+	view         string
 	info         bool
 }
 
@@ -158,7 +159,7 @@ func runAgent(exit chan bool) {
 
 	log.Debug("Running process-agent with DEBUG logging enabled")
 	if opts.check != "" {
-		err := debugCheckResults(cfg, opts.check)
+		err := debugCheckResults(cfg, opts.check, opts.view)
 		if err != nil {
 			fmt.Println(err)
 			os.Exit(1)
@@ -194,14 +195,17 @@ func runAgent(exit chan bool) {
 	}
 }
 
-func debugCheckResults(cfg *config.AgentConfig, check string) error {
+func debugCheckResults(cfg *config.AgentConfig, check, view string) error {
+	if err := validateCheckView(check, view); err != nil {
+		return err
+	}
 	sysInfo, err := checks.CollectSystemInfo(cfg)
 	if err != nil {
 		return err
 	}
 
-	if check == checks.Connections.Name() {
-		// Connections check requires process-check to have occurred first (for process creation ts)
+	if check == checks.Connections.Name() || check == checks.Listeners.Name() {
+		// Connections and listeners checks require process-check to have occurred first (for process creation ts)
 		checks.Process.Init(cfg, sysInfo)
 		checks.Process.Run(cfg, 0)
 	}
@@ -210,14 +214,14 @@ func debugCheckResults(cfg *config.AgentConfig, check string) error {
 	for _, ch := range checks.All {
 		if ch.Name() == check {
 			ch.Init(cfg, sysInfo)
-			return printResults(cfg, ch)
+			return printResults(cfg, ch, view)
 		}
 		names = append(names, ch.Name())
 	}
 	return fmt.Errorf("invalid check '%s', choose from: %v", check, names)
 }
 
-func printResults(cfg *config.AgentConfig, ch checks.Check) error {
+func printResults(cfg *config.AgentConfig, ch checks.Check, view string) error {
 	// Run the check once to prime the cache.
 	if _, err := ch.Run(cfg, 0); err != nil {
 		return fmt.Errorf("collection error: %s", err)
@@ -239,6 +243,10 @@ func printResults(cfg *config.AgentConfig, ch checks.Check) error {
 		return fmt.Errorf("collection error: %s", err)
 	}
 
+	if view != "" {
+		checkViews[view](os.Stdout, msgs)
+		return nil
+	}
 	for _, m := range msgs {
 		b, err := json.MarshalIndent(m, "", "  ")
 		if err != nil {
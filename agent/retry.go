@@ -0,0 +1,128 @@
+package main
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// backoffPolicy computes the delay before a retry using exponential backoff
+// with full jitter, so that agents failing at the same time don't all come
+// back at the same time.
+type backoffPolicy struct {
+	base time.Duration
+	max  time.Duration
+}
+
+// delay returns how long to wait before the given retry attempt (starting at 0).
+func (b backoffPolicy) delay(attempt int) time.Duration {
+	if b.base <= 0 {
+		return 0
+	}
+	ceiling := b.max
+	// Stop shifting before it overflows, we're past the max by then anyway.
+	if attempt < 32 {
+		if d := b.base << uint(attempt); d > 0 && (b.max <= 0 || d < b.max) {
+			ceiling = d
+		}
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// circuitBreaker stops sending to an endpoint after too many consecutive
+// failures. Once the cooldown is over a single trial request is let through:
+// if it succeeds the breaker closes, otherwise it stays open for another cooldown.
+// It also stays open for as long as the endpoint asked, see holdOff.
+type circuitBreaker struct {
+	sync.Mutex
+
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	holdUntil time.Time
+	now       func() time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// allow reports whether a request may be sent to the endpoint.
+func (b *circuitBreaker) allow() bool {
+	b.Lock()
+	defer b.Unlock()
+	now := b.now()
+	if now.Before(b.holdUntil) {
+		return false
+	}
+	if b.threshold <= 0 || b.failures < b.threshold {
+		return true
+	}
+	if now.Before(b.openUntil) {
+		return false
+	}
+	// Half-open: let this request through and hold off the others until it
+	// either succeeds or fails.
+	b.openUntil = now.Add(b.cooldown)
+	return true
+}
+
+// holdOff keeps the requests from going through until the given time, e.g.
+// as asked by the endpoint with Retry-After, whatever the threshold.
+func (b *circuitBreaker) holdOff(until time.Time) {
+	b.Lock()
+	defer b.Unlock()
+	if until.After(b.holdUntil) {
+		b.holdUntil = until
+	}
+}
+
+func (b *circuitBreaker) success() {
+	b.Lock()
+	defer b.Unlock()
+	b.failures = 0
+	b.openUntil = time.Time{}
+}
+
+func (b *circuitBreaker) failure() {
+	b.Lock()
+	defer b.Unlock()
+	b.failures++
+	if b.threshold > 0 && b.failures >= b.threshold {
+		b.openUntil = b.now().Add(b.cooldown)
+	}
+}
+
+// isRetryableStatus returns whether a request that got the given status code
+// may succeed if sent again.
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a
+// number of seconds or an HTTP date. It returns 0 if the value is missing or invalid.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+	if s, err := strconv.Atoi(v); err == nil {
+		if s < 0 {
+			return 0
+		}
+		return time.Duration(s) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	assert := assert.New(t)
+	b := backoffPolicy{base: 100 * time.Millisecond, max: time.Second}
+	for i := 0; i < 100; i++ {
+		assert.True(b.delay(0) <= 100*time.Millisecond)
+		assert.True(b.delay(2) <= 400*time.Millisecond)
+		assert.True(b.delay(10) <= time.Second)
+		assert.True(b.delay(100) <= time.Second)
+		assert.True(b.delay(100) >= 0)
+	}
+	assert.Equal(time.Duration(0), backoffPolicy{}.delay(3))
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	b := newCircuitBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	assert.True(b.allow())
+	b.failure()
+	assert.True(b.allow())
+	b.failure()
+	assert.False(b.allow())
+
+	// After the cooldown a single trial goes through.
+	now = now.Add(time.Minute)
+	assert.True(b.allow())
+	assert.False(b.allow())
+
+	// The trial failed: wait for another cooldown.
+	b.failure()
+	assert.False(b.allow())
+	now = now.Add(time.Minute)
+	assert.True(b.allow())
+
+	// The trial succeeded: closed again.
+	b.success()
+	assert.True(b.allow())
+	assert.True(b.allow())
+
+	// A threshold of 0 disables the breaker.
+	b = newCircuitBreaker(0, time.Minute)
+	b.now = func() time.Time { return now }
+	for i := 0; i < 10; i++ {
+		b.failure()
+	}
+	assert.True(b.allow())
+
+	// But the endpoint is still left alone for as long as it asks.
+	b.holdOff(now.Add(time.Hour))
+	b.holdOff(now.Add(time.Minute))
+	assert.False(b.allow())
+	now = now.Add(time.Minute)
+	assert.False(b.allow())
+	now = now.Add(time.Hour)
+	assert.True(b.allow())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(3*time.Second, parseRetryAfter("3", now))
+	assert.Equal(10*time.Second, parseRetryAfter(now.Add(10*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(time.Duration(0), parseRetryAfter(now.Add(-10*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(time.Duration(0), parseRetryAfter("-1", now))
+	assert.Equal(time.Duration(0), parseRetryAfter("soon", now))
+}
+
+func TestPostToAPIRetries(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.Write(resCollectorV1(&model.ResCollector{Status: &model.CollectorStatus{}}))
+		}
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	cfg := config.NewDefaultAgentConfig()
+	cfg.APIEndpoints = []config.APIEndpoint{{Endpoint: u}}
+	cfg.RetryBaseDelay = time.Millisecond
+	c, err := NewCollector(cfg)
+	assert.NoError(err)
+	c.rtIntervalCh = make(chan time.Duration, 1000)
+
+	body, err := encodePayload(&model.CollectorProc{HostName: "host"})
+	assert.NoError(err)
+	assert.Equal(sendDelivered, c.sendPayload(c.workers[0], "/api/v1/collector", body))
+	assert.Equal(int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestPostToAPICircuitBreaker(t *testing.T) {
+	assert := assert.New(t)
+
+	var badCalls, goodCalls int32
+	bad := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&badCalls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer bad.Close()
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&goodCalls, 1)
+		w.Write(resCollectorV1(&model.ResCollector{Status: &model.CollectorStatus{}}))
+	}))
+	defer good.Close()
+
+	goodURL, _ := url.Parse(good.URL)
+	badURL, _ := url.Parse(bad.URL)
+	cfg := config.NewDefaultAgentConfig()
+	cfg.APIEndpoints = []config.APIEndpoint{{Endpoint: goodURL}, {Endpoint: badURL}}
+	cfg.MaxRetries = 1
+	cfg.RetryBaseDelay = time.Millisecond
+	cfg.CircuitBreakerThreshold = 2
+	cfg.CircuitBreakerCooldown = time.Hour
+	c, err := NewCollector(cfg)
+	assert.NoError(err)
+	c.rtIntervalCh = make(chan time.Duration, 1000)
+
+	body, err := encodePayload(&model.CollectorProc{HostName: "host"})
+	assert.NoError(err)
+	for i := 0; i < 5; i++ {
+		assert.Equal(sendDelivered, c.sendPayload(c.workers[0], "/api/v1/collector", body))
+		assert.Equal(sendRetryLater, c.sendPayload(c.workers[1], "/api/v1/collector", body))
+	}
+
+	// The bad endpoint was tried (and retried once) until its breaker opened.
+	assert.Equal(int32(4), atomic.LoadInt32(&badCalls))
+	assert.Equal(int32(5), atomic.LoadInt32(&goodCalls))
+}
+
+func TestPostToAPIRetryAfter(t *testing.T) {
+	assert := assert.New(t)
+
+	var calls int32
+	retryAfter := "1"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", retryAfter)
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write(resCollectorV1(&model.ResCollector{Status: &model.CollectorStatus{}}))
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	cfg := config.NewDefaultAgentConfig()
+	cfg.APIEndpoints = []config.APIEndpoint{{Endpoint: u}}
+	cfg.RetryBaseDelay = time.Millisecond
+	cfg.RetryMaxDelay = 10 * time.Second
+	c, err := NewCollector(cfg)
+	assert.NoError(err)
+	c.rtIntervalCh = make(chan time.Duration, 1000)
+	body, err := encodePayload(&model.CollectorProc{HostName: "host"})
+	assert.NoError(err)
+
+	// Retry-After is honored even when longer than the backoff would be.
+	start := time.Now()
+	assert.Equal(sendDelivered, c.sendPayload(c.workers[0], "/api/v1/collector", body))
+	assert.True(time.Since(start) >= time.Second)
+	assert.Equal(int32(2), atomic.LoadInt32(&calls))
+
+	// Too long to wait for: the payload is given up on and the endpoint left
+	// alone until then.
+	atomic.StoreInt32(&calls, 0)
+	retryAfter = "3600"
+	start = time.Now()
+	assert.Equal(sendRetryLater, c.sendPayload(c.workers[0], "/api/v1/collector", body))
+	assert.True(time.Since(start) < time.Second)
+	assert.Equal(sendRetryLater, c.sendPayload(c.workers[0], "/api/v1/collector", body))
+	assert.Equal(int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestPostToAPIBreakerOnlyClosedByResponses(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	// A host that can't make a valid request.
+	cfg.APIEndpoints = []config.APIEndpoint{{Endpoint: &url.URL{Scheme: "http", Host: "bad host"}}}
+	cfg.CircuitBreakerThreshold = 2
+	cfg.CircuitBreakerCooldown = time.Hour
+	c, err := NewCollector(cfg)
+	assert.NoError(t, err)
+	c.rtIntervalCh = make(chan time.Duration, 1000)
+
+	w := c.workers[0]
+	w.breaker.failure()
+	assert.Equal(t, sendRetryLater, c.sendPayload(w, "/api/v1/collector", []byte("body")))
+	w.breaker.failure()
+	assert.False(t, w.breaker.allow())
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"sync/atomic"
+
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/sink"
+)
+
+// sinkWorker writes check results to a sink from its own goroutine so that a
+// slow sink never holds up the intake or the other sinks.
+type sinkWorker struct {
+	sink  sink.Sink
+	queue chan []model.MessageBody
+
+	// Number of check results dropped because the queue was full or the
+	// sink failed, accessed atomically.
+	dropped int64
+}
+
+func newSinkWorkers(cfg *config.AgentConfig) ([]*sinkWorker, error) {
+	workers := make([]*sinkWorker, 0, len(cfg.Sinks))
+	for _, sc := range cfg.Sinks {
+		s, err := sink.New(sc, cfg.HostName, cfg.Transport)
+		if err != nil {
+			return nil, err
+		}
+		workers = append(workers, &sinkWorker{
+			sink:  s,
+			queue: make(chan []model.MessageBody, queueCapacity(cfg)),
+		})
+	}
+	return workers, nil
+}
+
+// enqueue hands check results over to the worker without ever blocking,
+// dropping the oldest ones if the queue is full.
+func (w *sinkWorker) enqueue(msgs []model.MessageBody) {
+	for {
+		select {
+		case w.queue <- msgs:
+			return
+		default:
+		}
+
+		select {
+		case <-w.queue:
+			log.Infof("Expiring payload for sink %s from in-memory queue.", w.sink.Name())
+			atomic.AddInt64(&w.dropped, 1)
+		default:
+		}
+	}
+}
+
+func (w *sinkWorker) run(exit chan bool) {
+	defer w.sink.Close()
+	for {
+		select {
+		case msgs := <-w.queue:
+			if err := w.sink.Send(msgs); err != nil {
+				log.Errorf("Unable to write to sink %s: %s", w.sink.Name(), err)
+				atomic.AddInt64(&w.dropped, 1)
+			}
+		case <-exit:
+			return
+		}
+	}
+}
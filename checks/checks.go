@@ -24,4 +24,6 @@ var All = []Check{
 	Container,
 	RTContainer,
 	Connections,
+	ProcessEvents,
+	Listeners,
 }
@@ -0,0 +1,70 @@
+// +build linux
+
+package checks
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// fillCommandDetails sets what gopsutil doesn't collect about the command of
+// a process: its root, whether its executable is still on disk and its
+// process group.
+func fillCommandDetails(pid int32, cmd *model.Command) {
+	dir := util.HostProc(strconv.Itoa(int(pid)))
+	cmd.Root, _ = os.Readlink(filepath.Join(dir, "root"))
+	cmd.OnDisk = exeOnDisk(dir)
+	if data, err := ioutil.ReadFile(filepath.Join(dir, "stat")); err == nil {
+		cmd.Pgroup, _ = parseStatPgroup(string(data))
+	}
+}
+
+// exeOnDisk tells if the executable a process runs is the file found at the
+// same path, from the process' root. It isn't when the file was deleted or
+// replaced since the process started, e.g. by a deploy.
+func exeOnDisk(dir string) bool {
+	exe, err := os.Readlink(filepath.Join(dir, "exe"))
+	if err != nil || strings.HasSuffix(exe, " (deleted)") {
+		return false
+	}
+	running, err := os.Stat(filepath.Join(dir, "exe"))
+	if err != nil {
+		return false
+	}
+	onDisk, err := os.Stat(filepath.Join(dir, "root", exe))
+	if err != nil {
+		return false
+	}
+	return os.SameFile(running, onDisk)
+}
+
+// statFields splits the content of /proc/<pid>/stat after the command name,
+// which may contain spaces and parentheses: the first field returned is the
+// 3rd one, the state.
+func statFields(stat string) []string {
+	i := strings.LastIndexByte(stat, ')')
+	if i < 0 {
+		return nil
+	}
+	return strings.Fields(stat[i+1:])
+}
+
+// parseStatPgroup extracts the process group id (the 5th field) from the
+// content of /proc/<pid>/stat.
+func parseStatPgroup(stat string) (int32, bool) {
+	fields := statFields(stat)
+	if len(fields) < 5-2 {
+		return 0, false
+	}
+	pgrp, err := strconv.ParseInt(fields[5-3], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(pgrp), true
+}
@@ -0,0 +1,62 @@
+// +build linux
+
+package checks
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestFillCommandDetails(t *testing.T) {
+	cmd := &model.Command{}
+	fillCommandDetails(int32(os.Getpid()), cmd)
+	assert.Equal(t, "/", cmd.Root)
+	assert.True(t, cmd.OnDisk)
+	assert.Equal(t, int32(syscall.Getpgrp()), cmd.Pgroup)
+}
+
+func TestExeOnDisk(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "exe-on-disk")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+
+	// A fake /proc/<pid> whose exe is a file in tmp.
+	exe := filepath.Join(tmp, "app")
+	require.NoError(t, ioutil.WriteFile(exe, []byte("v1"), 0755))
+	dir := filepath.Join(tmp, "proc")
+	require.NoError(t, os.Mkdir(dir, 0755))
+	require.NoError(t, os.Symlink(exe, filepath.Join(dir, "exe")))
+	require.NoError(t, os.Symlink("/", filepath.Join(dir, "root")))
+	assert.True(t, exeOnDisk(dir))
+
+	// The path leads to another file from the process' root, as it would
+	// after a deploy replaced the executable.
+	other := filepath.Join(tmp, "other")
+	require.NoError(t, os.MkdirAll(filepath.Join(other, tmp), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(other, exe), []byte("v2"), 0755))
+	require.NoError(t, os.Remove(filepath.Join(dir, "root")))
+	require.NoError(t, os.Symlink(other, filepath.Join(dir, "root")))
+	assert.False(t, exeOnDisk(dir))
+
+	// Deleted.
+	require.NoError(t, os.Remove(filepath.Join(dir, "exe")))
+	require.NoError(t, os.Symlink(exe+" (deleted)", filepath.Join(dir, "exe")))
+	assert.False(t, exeOnDisk(dir))
+}
+
+func TestParseStatPgroup(t *testing.T) {
+	pgrp, ok := parseStatPgroup("4242 (sh -c (x)) S 1 4240 4240 34816 4242 4194304 0 0 0 0")
+	assert.True(t, ok)
+	assert.Equal(t, int32(4240), pgrp)
+
+	_, ok = parseStatPgroup("4242 (sh) S")
+	assert.False(t, ok)
+}
@@ -0,0 +1,8 @@
+// +build !linux
+
+package checks
+
+import "github.com/DataDog/datadog-process-agent/model"
+
+// fillCommandDetails is only supported on Linux, the fields are left empty.
+func fillCommandDetails(pid int32, cmd *model.Command) {}
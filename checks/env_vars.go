@@ -0,0 +1,56 @@
+package checks
+
+import (
+	"path"
+	"sort"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// formatEnv returns the allowlisted environment variables of a process,
+// scrubbed, and whether some were left out to stay under cfg.EnvMaxBytes.
+func formatEnv(cfg *config.AgentConfig, pid int32) ([]*model.EnvVar, bool) {
+	if len(cfg.EnvAllowlist) == 0 {
+		return nil, false
+	}
+	env := readEnviron(pid, func(name string) bool { return envAllowed(name, cfg.EnvAllowlist) })
+	return limitEnv(cfg.Scrubber, env, cfg.EnvMaxBytes)
+}
+
+// envAllowed tells if the name of a variable matches one of the patterns.
+func envAllowed(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		// Invalid patterns never match.
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// limitEnv scrubs the variables and keeps as many as fit in maxBytes, by name.
+func limitEnv(scrubber *config.DataScrubber, env map[string]string, maxBytes int) ([]*model.EnvVar, bool) {
+	if len(env) == 0 {
+		return nil, false
+	}
+	names := make([]string, 0, len(env))
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	vars := make([]*model.EnvVar, 0, len(names))
+	size, truncated := 0, false
+	for _, name := range names {
+		value := scrubber.ScrubEnvVar(name, env[name])
+		if size+len(name)+len(value) > maxBytes {
+			// A smaller one further down may still fit.
+			truncated = true
+			continue
+		}
+		size += len(name) + len(value)
+		vars = append(vars, &model.EnvVar{Name: name, Value: value})
+	}
+	return vars, truncated
+}
@@ -0,0 +1,40 @@
+// +build linux
+
+package checks
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestFormatEnv(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	cmd.Env = []string{"PATH=/usr/bin", "APP_VERSION=1.2", "DB_PASSWORD=hunter2"}
+	require.NoError(t, cmd.Start())
+	defer cmd.Process.Kill()
+	pid := int32(cmd.Process.Pid)
+	// Until the child exec'ed, it has our environment.
+	for i := 0; i < 100 && readEnvVars(pid, []string{"APP_VERSION"})["APP_VERSION"] == ""; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cfg := config.NewDefaultAgentConfig()
+	env, truncated := formatEnv(cfg, pid)
+	assert.Nil(t, env)
+	assert.False(t, truncated)
+
+	cfg.EnvAllowlist = []string{"APP_*", "DB_PASSWORD"}
+	env, truncated = formatEnv(cfg, pid)
+	assert.False(t, truncated)
+	assert.Equal(t, []*model.EnvVar{
+		{Name: "APP_VERSION", Value: "1.2"},
+		{Name: "DB_PASSWORD", Value: "********"},
+	}, env)
+}
@@ -0,0 +1,52 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestEnvAllowed(t *testing.T) {
+	patterns := []string{"SERVICE_NAME", "DD_*", "APP_?ERSION", "[bad"}
+	assert.True(t, envAllowed("SERVICE_NAME", patterns))
+	assert.True(t, envAllowed("DD_ENV", patterns))
+	assert.True(t, envAllowed("APP_VERSION", patterns))
+	assert.False(t, envAllowed("SERVICE_NAMES", patterns))
+	assert.False(t, envAllowed("PATH", patterns))
+	assert.False(t, envAllowed("[bad", patterns))
+}
+
+func TestLimitEnv(t *testing.T) {
+	scrubber := config.NewDefaultDataScrubber()
+	env := map[string]string{
+		"DD_ENV":      "prod",
+		"DD_PASSWORD": "hunter2",
+		"DD_TAGS":     "team:billing,tier:1",
+		"DD_VERSION":  "1.2",
+	}
+
+	vars, truncated := limitEnv(scrubber, env, 1024)
+	assert.False(t, truncated)
+	assert.Equal(t, []*model.EnvVar{
+		{Name: "DD_ENV", Value: "prod"},
+		{Name: "DD_PASSWORD", Value: "********"},
+		{Name: "DD_TAGS", Value: "team:billing,tier:1"},
+		{Name: "DD_VERSION", Value: "1.2"},
+	}, vars)
+
+	// DD_TAGS doesn't fit, DD_VERSION does.
+	vars, truncated = limitEnv(scrubber, env, 45)
+	assert.True(t, truncated)
+	assert.Equal(t, []*model.EnvVar{
+		{Name: "DD_ENV", Value: "prod"},
+		{Name: "DD_PASSWORD", Value: "********"},
+		{Name: "DD_VERSION", Value: "1.2"},
+	}, vars)
+
+	vars, truncated = limitEnv(scrubber, nil, 1024)
+	assert.Nil(t, vars)
+	assert.False(t, truncated)
+}
@@ -0,0 +1,270 @@
+package checks
+
+import (
+	"crypto/sha256"
+	"debug/elf"
+	"encoding/hex"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/DataDog/gopsutil/process"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const (
+	// maxHashedExeSize is the size of the largest executable we hash.
+	maxHashedExeSize = 256 << 20
+	// Bounds of the work done per run, the executables left over are
+	// fingerprinted in the next runs.
+	maxNewFingerprintsPerRun = 8
+	maxHashedBytesPerRun     = 256 << 20
+)
+
+// exeKey identifies the content of an executable, which gets a new inode or
+// modification time when replaced or updated. The size tells what hashing it
+// costs.
+type exeKey struct {
+	dev, ino uint64
+	mtime    int64
+	size     int64
+}
+
+// packageOwner is the package a file was installed by.
+type packageOwner struct {
+	manager, name, version string
+}
+
+// packageDB finds the packages owning files, in the database of the package
+// manager of the host.
+type packageDB interface {
+	owners(paths []string) map[string]packageOwner
+}
+
+// exeFingerprinter fingerprints the executables of processes, once per
+// executable content, and a few new executables per run at most.
+//
+// Finding the package of an executable can mean forking rpm or scanning the
+// file lists of dpkg, so it's done in the background rather than while the
+// process check holds its lock: packages show up from the next run on.
+type exeFingerprinter struct {
+	cache map[exeKey]*model.ExeFingerprint
+	// The executables of the host whose package is still to be found.
+	pending map[exeKey]string
+	lookup  packageLookup
+
+	// Results of the last update.
+	byPid map[int32]*model.ExeFingerprint
+
+	// Overridden in tests.
+	statExe       func(pid int32) (exeKey, error)
+	readExe       func(pid int32) *model.ExeFingerprint
+	onHostRoot    func(pid int32) bool
+	openPackageDB func() packageDB
+}
+
+func newExeFingerprinter() *exeFingerprinter {
+	return &exeFingerprinter{
+		cache:         make(map[exeKey]*model.ExeFingerprint),
+		pending:       make(map[exeKey]string),
+		statExe:       statExe,
+		readExe:       readExeFingerprint,
+		onHostRoot:    onHostRoot,
+		openPackageDB: newPackageDBOpener(),
+	}
+}
+
+// packageLookup is a lookup of the owners of executables running in the
+// background.
+type packageLookup struct {
+	sync.Mutex
+	wg      sync.WaitGroup
+	running bool
+	// The paths of the last lookup once it's over, with their owner if they
+	// have one.
+	done map[string]*packageOwner
+}
+
+// update fingerprints the executables not seen so far, within the budget of a
+// run, and forgets the ones no process runs anymore.
+func (f *exeFingerprinter) update(cfg *config.AgentConfig, procs map[int32]*process.FilledProcess) {
+	f.addPackages()
+
+	fingerprinted, hashedBytes := 0, int64(0)
+	used := make(map[exeKey]struct{})
+	f.byPid = make(map[int32]*model.ExeFingerprint, len(procs))
+	for pid, fp := range procs {
+		if len(fp.Cmdline) == 0 || config.IsBlacklisted(fp.Cmdline, cfg.Blacklist) {
+			continue
+		}
+		key, err := f.statExe(pid)
+		if err != nil {
+			continue
+		}
+		used[key] = struct{}{}
+
+		fingerprint, ok := f.cache[key]
+		if !ok {
+			hashed := key.size
+			if hashed > maxHashedExeSize {
+				hashed = 0 // Only its build id is read.
+			}
+			if fingerprinted >= maxNewFingerprintsPerRun || (hashedBytes > 0 && hashedBytes+hashed > maxHashedBytesPerRun) {
+				continue
+			}
+			fingerprinted++
+			hashedBytes += hashed
+
+			fingerprint = f.readExe(pid)
+			// The package database only knows about the files of the host.
+			if fingerprint != nil && fp.Exe != "" && f.onHostRoot(pid) {
+				f.pending[key] = fp.Exe
+			}
+			f.cache[key] = fingerprint
+		}
+		if fingerprint != nil {
+			f.byPid[pid] = fingerprint
+		}
+	}
+	for key := range f.cache {
+		if _, ok := used[key]; !ok {
+			delete(f.cache, key)
+			delete(f.pending, key)
+		}
+	}
+
+	f.lookupPackages()
+}
+
+// addPackages sets the packages found by the last lookup, if it's over. The
+// fingerprints already reported are left untouched, they may still be in use.
+func (f *exeFingerprinter) addPackages() {
+	f.lookup.Lock()
+	done := f.lookup.done
+	f.lookup.done = nil
+	f.lookup.Unlock()
+	if done == nil {
+		return
+	}
+
+	for key, path := range f.pending {
+		owner, ok := done[path]
+		if !ok {
+			// Not part of the last lookup.
+			continue
+		}
+		delete(f.pending, key)
+		fingerprint := f.cache[key]
+		if owner == nil || fingerprint == nil {
+			continue
+		}
+		withPackage := *fingerprint
+		withPackage.PackageManager = owner.manager
+		withPackage.Package = owner.name
+		withPackage.PackageVersion = owner.version
+		f.cache[key] = &withPackage
+	}
+}
+
+// lookupPackages starts looking up the packages of the pending executables,
+// unless a lookup is already running.
+func (f *exeFingerprinter) lookupPackages() {
+	f.lookup.Lock()
+	defer f.lookup.Unlock()
+	if len(f.pending) == 0 || f.lookup.running || f.lookup.done != nil {
+		return
+	}
+
+	seen := make(map[string]struct{}, len(f.pending))
+	paths := make([]string, 0, len(f.pending))
+	for _, path := range f.pending {
+		if _, ok := seen[path]; !ok {
+			seen[path] = struct{}{}
+			paths = append(paths, path)
+		}
+	}
+	f.lookup.running = true
+	f.lookup.wg.Add(1)
+	go func() {
+		defer f.lookup.wg.Done()
+		var owners map[string]packageOwner
+		if db := f.openPackageDB(); db != nil {
+			owners = db.owners(paths)
+		}
+		done := make(map[string]*packageOwner, len(paths))
+		for _, path := range paths {
+			done[path] = nil
+			if owner, ok := owners[path]; ok {
+				done[path] = &owner
+			}
+		}
+
+		f.lookup.Lock()
+		defer f.lookup.Unlock()
+		f.lookup.done = done
+		f.lookup.running = false
+	}()
+}
+
+// get returns the fingerprint of the executable of a process, if any.
+func (f *exeFingerprinter) get(pid int32) *model.ExeFingerprint {
+	return f.byPid[pid]
+}
+
+// fingerprintFile returns the build id and hash of an executable.
+func fingerprintFile(path string) *model.ExeFingerprint {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil
+	}
+
+	fingerprint := &model.ExeFingerprint{BuildId: readBuildID(file)}
+	if info.Size() <= maxHashedExeSize {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return nil
+		}
+		h := sha256.New()
+		if _, err := io.Copy(h, file); err != nil {
+			return nil
+		}
+		fingerprint.Sha256 = hex.EncodeToString(h.Sum(nil))
+	}
+	return fingerprint
+}
+
+// readBuildID returns the GNU build id of an ELF executable, as a hex string.
+func readBuildID(r io.ReaderAt) string {
+	f, err := elf.NewFile(r)
+	if err != nil {
+		return ""
+	}
+	section := f.Section(".note.gnu.build-id")
+	if section == nil {
+		return ""
+	}
+	note, err := section.Data()
+	if err != nil {
+		return ""
+	}
+	// namesz, descsz and type, then the "GNU\0" name and the id, 4-byte aligned.
+	if len(note) < 12 {
+		return ""
+	}
+	nameSize := int(f.ByteOrder.Uint32(note[0:]))
+	descSize := int(f.ByteOrder.Uint32(note[4:]))
+	if f.ByteOrder.Uint32(note[8:]) != 3 { // NT_GNU_BUILD_ID
+		return ""
+	}
+	start := 12 + (nameSize+3)&^3
+	if start+descSize > len(note) {
+		return ""
+	}
+	return hex.EncodeToString(note[start : start+descSize])
+}
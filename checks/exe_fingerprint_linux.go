@@ -0,0 +1,262 @@
+// +build linux
+
+package checks
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+const (
+	dpkgDir    = "/var/lib/dpkg"
+	rpmDir     = "/var/lib/rpm"
+	rpmTimeout = 5 * time.Second
+)
+
+// statExe identifies the content of the executable of a process.
+func statExe(pid int32) (exeKey, error) {
+	var st syscall.Stat_t
+	if err := syscall.Stat(util.HostProc(strconv.Itoa(int(pid)), "exe"), &st); err != nil {
+		return exeKey{}, err
+	}
+	return exeKey{dev: uint64(st.Dev), ino: uint64(st.Ino), mtime: st.Mtim.Nano(), size: st.Size}, nil
+}
+
+// readExeFingerprint fingerprints the executable of a process, even when it
+// was deleted or lives in another mount namespace.
+func readExeFingerprint(pid int32) *model.ExeFingerprint {
+	return fingerprintFile(util.HostProc(strconv.Itoa(int(pid)), "exe"))
+}
+
+// onHostRoot tells if a process sees the same root directory as the host,
+// rather than the one of a container or chroot.
+func onHostRoot(pid int32) bool {
+	root := hostRoot()
+	if root == "" {
+		return false
+	}
+	var host, st syscall.Stat_t
+	if syscall.Stat(root, &host) != nil || syscall.Stat(util.HostProc(strconv.Itoa(int(pid)), "root"), &st) != nil {
+		return false
+	}
+	return st.Dev == host.Dev && st.Ino == host.Ino
+}
+
+// hostRoot returns the path of the root directory of the host: / when the
+// agent runs on it, or the root of pid 1 through the procfs of the host
+// otherwise, e.g. when the agent runs in a container. It returns "" when it
+// can't be told.
+func hostRoot() string {
+	pid1Root := util.HostProc("1", "root")
+	var self, host syscall.Stat_t
+	if err := syscall.Stat(pid1Root, &host); err != nil {
+		// Without the privileges to follow the root of pid 1, only the
+		// procfs of our own mount namespace tells that it's the host's.
+		if util.HostProc() == "/proc" {
+			return "/"
+		}
+		return ""
+	}
+	if syscall.Stat("/", &self) == nil && self.Dev == host.Dev && self.Ino == host.Ino {
+		return "/"
+	}
+	return pid1Root
+}
+
+// newPackageDBOpener returns a func opening the package database of the host.
+func newPackageDBOpener() func() packageDB {
+	c := &packageDBCache{}
+	return func() packageDB {
+		root := hostRoot()
+		if root == "" {
+			return nil
+		}
+		return c.open(root)
+	}
+}
+
+// packageDBCache keeps the packages of dpkg, and the owners looked up so far,
+// until its status file changes.
+type packageDBCache struct {
+	dpkg      *dpkgDB
+	status    string
+	statusMod time.Time
+}
+
+// open opens the database of dpkg or rpm under root, whichever is present.
+func (c *packageDBCache) open(root string) packageDB {
+	dir := filepath.Join(root, dpkgDir)
+	status := filepath.Join(dir, "status")
+	if info, err := os.Stat(status); err == nil {
+		if c.dpkg == nil || status != c.status || !info.ModTime().Equal(c.statusMod) {
+			db, err := loadDpkgDB(dir)
+			if err != nil {
+				return nil
+			}
+			c.dpkg, c.status, c.statusMod = db, status, info.ModTime()
+		}
+		return c.dpkg
+	}
+	if _, err := os.Stat(filepath.Join(root, rpmDir)); err != nil {
+		return nil
+	}
+	rpm, err := exec.LookPath("rpm")
+	if err != nil {
+		return nil
+	}
+	return rpmDB{path: rpm, root: root}
+}
+
+// dpkgDB finds the owners of files in the file lists of the installed
+// packages. The lists hold every file of the host, so they are only scanned
+// for the files we look up, and only the results are kept.
+type dpkgDB struct {
+	dir  string
+	pkgs []dpkgPackage
+	// The owners of the files looked up so far, nil for the ones without.
+	owned map[string]*packageOwner
+}
+
+// loadDpkgDB reads the installed packages from the status file of dpkg.
+func loadDpkgDB(dir string) (*dpkgDB, error) {
+	f, err := os.Open(filepath.Join(dir, "status"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return &dpkgDB{dir: dir, pkgs: parseDpkgStatus(f), owned: make(map[string]*packageOwner)}, nil
+}
+
+type dpkgPackage struct {
+	name, version, arch string
+}
+
+// parseDpkgStatus returns the installed packages from the content of the
+// status file of dpkg, one paragraph of "Field: value" lines per package.
+func parseDpkgStatus(r io.Reader) []dpkgPackage {
+	var pkgs []dpkgPackage
+	var pkg dpkgPackage
+	installed := false
+	flush := func() {
+		if installed && pkg.name != "" {
+			pkgs = append(pkgs, pkg)
+		}
+		pkg, installed = dpkgPackage{}, false
+	}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			flush()
+			continue
+		}
+		parts := strings.SplitN(line, ": ", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch parts[0] {
+		case "Package":
+			pkg.name = parts[1]
+		case "Version":
+			pkg.version = parts[1]
+		case "Architecture":
+			pkg.arch = parts[1]
+		case "Status":
+			installed = strings.HasSuffix(parts[1], " installed")
+		}
+	}
+	flush()
+	return pkgs
+}
+
+func (db *dpkgDB) owners(paths []string) map[string]packageOwner {
+	owners := make(map[string]packageOwner, len(paths))
+	// The files listed under one of their names, by name.
+	wanted := make(map[string][]string)
+	for _, path := range paths {
+		if owner, ok := db.owned[path]; ok {
+			if owner != nil {
+				owners[path] = *owner
+			}
+			continue
+		}
+		db.owned[path] = nil
+		// With merged /usr, /bin/ls is listed but runs as /usr/bin/ls, or the
+		// other way around.
+		candidates := []string{path, "/usr" + path}
+		if strings.HasPrefix(path, "/usr/") {
+			candidates = append(candidates, strings.TrimPrefix(path, "/usr"))
+		}
+		for _, c := range candidates {
+			wanted[c] = append(wanted[c], path)
+		}
+	}
+	if len(wanted) == 0 {
+		return owners
+	}
+
+	for _, pkg := range db.pkgs {
+		lists := []string{pkg.name + ":" + pkg.arch + ".list", pkg.name + ".list"}
+		for _, list := range lists {
+			f, err := os.Open(filepath.Join(db.dir, "info", list))
+			if err != nil {
+				continue
+			}
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				for _, path := range wanted[scanner.Text()] {
+					if db.owned[path] == nil {
+						owner := packageOwner{manager: "dpkg", name: pkg.name, version: pkg.version}
+						db.owned[path] = &owner
+						owners[path] = owner
+					}
+				}
+			}
+			f.Close()
+			break
+		}
+	}
+	return owners
+}
+
+// rpmDB asks the rpm command for the owners of files in the database under
+// root, the database not being readable without librpm.
+type rpmDB struct {
+	path, root string
+}
+
+func (rpm rpmDB) owners(paths []string) map[string]packageOwner {
+	owners := make(map[string]packageOwner, len(paths))
+	for _, path := range paths {
+		if owner, ok := rpm.owner(path); ok {
+			owners[path] = owner
+		}
+	}
+	return owners
+}
+
+func (rpm rpmDB) owner(path string) (packageOwner, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), rpmTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, rpm.path, "--root", rpm.root, "-qf", "--queryformat", "%{NAME}\t%{VERSION}-%{RELEASE}", path).Output()
+	if err != nil {
+		return packageOwner{}, false
+	}
+	parts := strings.SplitN(string(out), "\t", 2)
+	if len(parts) != 2 {
+		return packageOwner{}, false
+	}
+	return packageOwner{manager: "rpm", name: parts[0], version: parts[1]}, true
+}
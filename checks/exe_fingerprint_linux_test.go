@@ -0,0 +1,139 @@
+// +build linux
+
+package checks
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadExeFingerprint(t *testing.T) {
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile(exe)
+	require.NoError(t, err)
+	sum := sha256.Sum256(data)
+
+	pid := int32(os.Getpid())
+	fingerprint := readExeFingerprint(pid)
+	require.NotNil(t, fingerprint)
+	assert.Equal(t, hex.EncodeToString(sum[:]), fingerprint.Sha256)
+
+	key, err := statExe(pid)
+	require.NoError(t, err)
+	assert.NotZero(t, key.ino)
+	assert.True(t, onHostRoot(pid))
+}
+
+func TestReadBuildID(t *testing.T) {
+	// Most distributions build their binaries with a build id.
+	f, err := os.Open("/bin/sh")
+	if err != nil {
+		t.Skip("no /bin/sh")
+	}
+	defer f.Close()
+	id := readBuildID(f)
+	if id == "" {
+		t.Skip("/bin/sh has no build id")
+	}
+	_, err = hex.DecodeString(id)
+	assert.NoError(t, err)
+	assert.Len(t, id, 40)
+
+	assert.Equal(t, "", readBuildID(strings.NewReader("not an ELF file")))
+}
+
+func TestLoadDpkgDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dpkg")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "info"), 0755))
+
+	status := `Package: coreutils
+Essential: yes
+Status: install ok installed
+Architecture: amd64
+Version: 8.28-1ubuntu1
+Description: GNU core utilities
+ This package contains the basic file, shell and text manipulation
+ utilities which are expected to exist on every operating system.
+
+Package: nginx-core
+Status: install ok installed
+Architecture: amd64
+Version: 1.14.0-0ubuntu1
+
+Package: removed
+Status: deinstall ok config-files
+Architecture: amd64
+Version: 1.0
+`
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "status"), []byte(status), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "info", "coreutils.list"), []byte("/.\n/bin\n/bin/ls\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "info", "nginx-core:amd64.list"), []byte("/usr/sbin/nginx\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "info", "removed.list"), []byte("/usr/bin/removed\n"), 0644))
+
+	db, err := loadDpkgDB(dir)
+	require.NoError(t, err)
+
+	owners := db.owners([]string{"/usr/bin/ls", "/usr/sbin/nginx", "/usr/bin/removed"})
+	assert.Equal(t, map[string]packageOwner{
+		"/usr/bin/ls":     {manager: "dpkg", name: "coreutils", version: "8.28-1ubuntu1"},
+		"/usr/sbin/nginx": {manager: "dpkg", name: "nginx-core", version: "1.14.0-0ubuntu1"},
+	}, owners)
+	// Only the files looked up are kept.
+	assert.Len(t, db.owned, 3)
+	assert.Nil(t, db.owned["/usr/bin/removed"])
+
+	// Looked up once.
+	require.NoError(t, os.Remove(filepath.Join(dir, "info", "coreutils.list")))
+	assert.Equal(t, "coreutils", db.owners([]string{"/usr/bin/ls"})["/usr/bin/ls"].name)
+
+	_, err = loadDpkgDB(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestPackageDBCache(t *testing.T) {
+	root, err := ioutil.TempDir("", "root")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	c := &packageDBCache{}
+	assert.Nil(t, c.open(root))
+
+	dir := filepath.Join(root, dpkgDir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "info"), 0755))
+	status := filepath.Join(dir, "status")
+	require.NoError(t, ioutil.WriteFile(status, []byte("Package: app\nStatus: install ok installed\nVersion: 1\n"), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "info", "app.list"), []byte("/usr/bin/app\n"), 0644))
+
+	db := c.open(root)
+	require.NotNil(t, db)
+	owner, ok := db.owners([]string{"/usr/bin/app"})["/usr/bin/app"]
+	assert.True(t, ok)
+	assert.Equal(t, "1", owner.version)
+
+	// Kept loaded while the status file doesn't change.
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "info", "app.list"), []byte("/usr/bin/app2\n"), 0644))
+	_, ok = c.open(root).owners([]string{"/usr/bin/app"})["/usr/bin/app"]
+	assert.True(t, ok)
+
+	// Reloaded once it does.
+	require.NoError(t, ioutil.WriteFile(status, []byte("Package: app\nStatus: install ok installed\nVersion: 2\n"), 0644))
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(status, later, later))
+	owners := c.open(root).owners([]string{"/usr/bin/app", "/usr/bin/app2"})
+	_, ok = owners["/usr/bin/app"]
+	assert.False(t, ok)
+	owner, ok = owners["/usr/bin/app2"]
+	assert.True(t, ok)
+	assert.Equal(t, "2", owner.version)
+}
@@ -0,0 +1,25 @@
+// +build !linux
+
+package checks
+
+import (
+	"errors"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// statExe is only supported on Linux.
+func statExe(pid int32) (exeKey, error) {
+	return exeKey{}, errors.New("executable fingerprints are only supported on Linux")
+}
+
+// readExeFingerprint is only supported on Linux.
+func readExeFingerprint(pid int32) *model.ExeFingerprint { return nil }
+
+// onHostRoot is only supported on Linux.
+func onHostRoot(pid int32) bool { return false }
+
+// newPackageDBOpener is only supported on Linux.
+func newPackageDBOpener() func() packageDB {
+	return func() packageDB { return nil }
+}
@@ -0,0 +1,134 @@
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+type fakePackageDB map[string]packageOwner
+
+func (db fakePackageDB) owners(paths []string) map[string]packageOwner {
+	owners := make(map[string]packageOwner)
+	for _, path := range paths {
+		if owner, ok := db[path]; ok {
+			owners[path] = owner
+		}
+	}
+	return owners
+}
+
+func TestExeFingerprinter(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	keys := map[int32]exeKey{
+		1: {dev: 1, ino: 10, mtime: 100},
+		2: {dev: 1, ino: 10, mtime: 100},
+		3: {dev: 1, ino: 30, mtime: 100},
+	}
+	reads, dbOpens := 0, 0
+	f := newExeFingerprinter()
+	f.statExe = func(pid int32) (exeKey, error) {
+		if key, ok := keys[pid]; ok {
+			return key, nil
+		}
+		return exeKey{}, errors.New("gone")
+	}
+	f.readExe = func(pid int32) *model.ExeFingerprint {
+		reads++
+		return &model.ExeFingerprint{BuildId: "id"}
+	}
+	// pid 3 runs in a container.
+	f.onHostRoot = func(pid int32) bool { return pid != 3 }
+	f.openPackageDB = func() packageDB {
+		dbOpens++
+		return fakePackageDB{
+			"/usr/sbin/nginx": {manager: "dpkg", name: "nginx-core", version: "1.14.0-0ubuntu1"},
+			"/usr/bin/app":    {manager: "dpkg", name: "app", version: "1"},
+		}
+	}
+
+	procs := map[int32]*process.FilledProcess{
+		1: {Pid: 1, Exe: "/usr/sbin/nginx", Cmdline: []string{"nginx: master"}},
+		2: {Pid: 2, Exe: "/usr/sbin/nginx", Cmdline: []string{"nginx: worker"}},
+		3: {Pid: 3, Exe: "/usr/bin/app", Cmdline: []string{"app"}},
+		4: {Pid: 4, Exe: "/usr/bin/gone", Cmdline: []string{"gone"}},
+	}
+	f.update(cfg, procs)
+	assert.Equal(t, 2, reads)
+	// The package is looked up in the background, and set on the next run.
+	first := f.get(1)
+	assert.Equal(t, &model.ExeFingerprint{BuildId: "id"}, first)
+	f.lookup.wg.Wait()
+	assert.Equal(t, 1, dbOpens)
+
+	f.update(cfg, procs)
+	assert.Equal(t, 2, reads)
+	assert.Equal(t, &model.ExeFingerprint{
+		BuildId:        "id",
+		PackageManager: "dpkg",
+		Package:        "nginx-core",
+		PackageVersion: "1.14.0-0ubuntu1",
+	}, f.get(1))
+	assert.Equal(t, f.get(1), f.get(2))
+	assert.Equal(t, &model.ExeFingerprint{BuildId: "id"}, f.get(3))
+	assert.Nil(t, f.get(4))
+	// The fingerprint already reported is left untouched.
+	assert.Equal(t, &model.ExeFingerprint{BuildId: "id"}, first)
+
+	// Cached, no need for the package database.
+	f.update(cfg, procs)
+	f.lookup.wg.Wait()
+	assert.Equal(t, 2, reads)
+	assert.Equal(t, 1, dbOpens)
+	assert.Empty(t, f.pending)
+
+	// The binary of pid 3 was upgraded.
+	keys[3] = exeKey{dev: 1, ino: 31, mtime: 200}
+	delete(procs, 1)
+	delete(procs, 2)
+	f.update(cfg, procs)
+	assert.Equal(t, 3, reads)
+	assert.Len(t, f.cache, 1)
+}
+
+func TestExeFingerprinterBudget(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	procs := make(map[int32]*process.FilledProcess)
+	keys := make(map[int32]exeKey)
+	for pid := int32(1); pid <= 2*maxNewFingerprintsPerRun; pid++ {
+		procs[pid] = &process.FilledProcess{Pid: pid, Exe: fmt.Sprintf("/bin/%d", pid), Cmdline: []string{"exe"}}
+		keys[pid] = exeKey{ino: uint64(pid), size: 1 << 20}
+	}
+	// Two executables that take the whole hashing budget.
+	keys[1] = exeKey{ino: 1, size: maxHashedBytesPerRun}
+	keys[2] = exeKey{ino: 2, size: maxHashedBytesPerRun}
+	reads := 0
+	f := newExeFingerprinter()
+	f.statExe = func(pid int32) (exeKey, error) { return keys[pid], nil }
+	f.readExe = func(pid int32) *model.ExeFingerprint {
+		reads++
+		return &model.ExeFingerprint{BuildId: fmt.Sprint(pid)}
+	}
+	f.onHostRoot = func(pid int32) bool { return false }
+
+	runs := 0
+	for len(f.cache) < len(procs) {
+		f.update(cfg, procs)
+		runs++
+		assert.True(t, reads <= runs*maxNewFingerprintsPerRun)
+		require.True(t, runs <= 4, "not all executables fingerprinted")
+	}
+	assert.Equal(t, len(procs), reads)
+	// Both large executables can't be hashed in the same run.
+	assert.True(t, runs >= 3)
+	for pid := range procs {
+		assert.NotNil(t, f.get(pid))
+	}
+}
@@ -0,0 +1,77 @@
+package checks
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// formatFdStat breaks down the open file descriptors of a process by type,
+// when enabled.
+func formatFdStat(cfg *config.AgentConfig, pid int32) *model.FdStat {
+	if !cfg.CollectFdTypes {
+		return nil
+	}
+	targets, truncated, err := readFdTargets(pid, cfg.MaxProcFDs)
+	if err != nil {
+		return nil
+	}
+	stat := classifyFds(targets, cfg.FdTopPaths, cfg.Scrubber)
+	stat.Truncated = truncated
+	return stat
+}
+
+// classifyFds counts the descriptors by type from the targets of the links in
+// /proc/<pid>/fd, e.g. "socket:[1234]", "anon_inode:[eventfd]" or a path,
+// and keeps the topN paths opened the most times.
+func classifyFds(targets []string, topN int, scrubber *config.DataScrubber) *model.FdStat {
+	stat := &model.FdStat{}
+	paths := make(map[string]int32)
+	for _, t := range targets {
+		switch {
+		case strings.HasPrefix(t, "socket:"):
+			stat.Sockets++
+		case strings.HasPrefix(t, "pipe:"):
+			stat.Pipes++
+		case t == "anon_inode:[eventfd]":
+			stat.Eventfds++
+		case t == "anon_inode:[eventpoll]":
+			stat.Epolls++
+		case strings.HasPrefix(t, "anon_inode:"):
+			stat.AnonInodes++
+		case strings.HasPrefix(t, "/dev/"):
+			stat.Devices++
+		case strings.HasPrefix(t, "/"):
+			stat.Files++
+			if topN > 0 {
+				paths[t]++
+			}
+		default:
+			stat.Other++
+		}
+	}
+	if len(paths) == 0 {
+		return stat
+	}
+
+	top := make([]*model.FdPath, 0, len(paths))
+	for p, count := range paths {
+		top = append(top, &model.FdPath{Path: p, Count: count})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Path < top[j].Path
+	})
+	if len(top) > topN {
+		top = top[:topN]
+	}
+	for _, p := range top {
+		p.Path = scrubber.ScrubPath(p.Path)
+	}
+	stat.TopPaths = top
+	return stat
+}
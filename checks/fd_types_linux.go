@@ -0,0 +1,45 @@
+// +build linux
+
+package checks
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// readFdTargets reads where at most max of the file descriptors of a process
+// point to, and tells if it has more of them.
+func readFdTargets(pid int32, max int) ([]string, bool, error) {
+	dir := util.HostProc(strconv.Itoa(int(pid)), "fd")
+	d, err := os.Open(dir)
+	if err != nil {
+		return nil, false, err
+	}
+	defer d.Close()
+	names, err := d.Readdirnames(max + 1)
+	if err == io.EOF {
+		// No descriptors at all, e.g. a kernel thread.
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	truncated := len(names) > max
+	if truncated {
+		names = names[:max]
+	}
+
+	targets := make([]string, 0, len(names))
+	for _, name := range names {
+		t, err := os.Readlink(filepath.Join(dir, name))
+		if err != nil {
+			// Closed in the meantime.
+			continue
+		}
+		targets = append(targets, t)
+	}
+	return targets, truncated, nil
+}
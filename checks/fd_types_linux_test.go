@@ -0,0 +1,68 @@
+// +build linux
+
+package checks
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/config"
+)
+
+func TestFormatFdStat(t *testing.T) {
+	f, err := ioutil.TempFile("", "fd-types")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	defer r.Close()
+	defer w.Close()
+
+	cfg := config.NewDefaultAgentConfig()
+	pid := int32(os.Getpid())
+	assert.Nil(t, formatFdStat(cfg, pid))
+
+	cfg.CollectFdTypes = true
+	cfg.FdTopPaths = 100
+	stat := formatFdStat(cfg, pid)
+	require.NotNil(t, stat)
+	assert.False(t, stat.Truncated)
+	assert.True(t, stat.Files >= 1)
+	assert.True(t, stat.Pipes >= 2)
+	found := false
+	for _, p := range stat.TopPaths {
+		if p.Path == f.Name() {
+			found = true
+		}
+	}
+	assert.True(t, found, "%s not in %v", f.Name(), stat.TopPaths)
+
+	cfg.MaxProcFDs = 1
+	stat = formatFdStat(cfg, pid)
+	require.NotNil(t, stat)
+	assert.True(t, stat.Truncated)
+	total := stat.Files + stat.Sockets + stat.Pipes + stat.Eventfds + stat.Epolls + stat.AnonInodes + stat.Devices + stat.Other
+	assert.Equal(t, int32(1), total)
+}
+
+func TestFormatFdStatNoDescriptors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fd-types-proc")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "2", "fd"), 0755))
+	os.Setenv("HOST_PROC", dir)
+	defer os.Unsetenv("HOST_PROC")
+
+	cfg := config.NewDefaultAgentConfig()
+	cfg.CollectFdTypes = true
+	stat := formatFdStat(cfg, 2)
+	require.NotNil(t, stat)
+	assert.False(t, stat.Truncated)
+	assert.Equal(t, int32(0), stat.Files+stat.Sockets+stat.Pipes+stat.Other)
+}
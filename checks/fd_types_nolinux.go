@@ -0,0 +1,10 @@
+// +build !linux
+
+package checks
+
+import "errors"
+
+// readFdTargets is only supported on Linux.
+func readFdTargets(pid int32, max int) ([]string, bool, error) {
+	return nil, false, errors.New("file descriptor types are only supported on Linux")
+}
@@ -0,0 +1,54 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestClassifyFds(t *testing.T) {
+	targets := []string{
+		"/dev/null",
+		"/dev/pts/0",
+		"/var/log/app.log",
+		"/var/log/app.log",
+		"/run/secret/db.key",
+		"/etc/hosts",
+		"socket:[1234]",
+		"socket:[1235]",
+		"pipe:[42]",
+		"anon_inode:[eventfd]",
+		"anon_inode:[eventpoll]",
+		"anon_inode:inotify",
+		"net:[4026531840]",
+	}
+	scrubber := config.NewDefaultDataScrubber()
+
+	stat := classifyFds(targets, 0, scrubber)
+	assert.Equal(t, &model.FdStat{
+		Files:      4,
+		Sockets:    2,
+		Pipes:      1,
+		Eventfds:   1,
+		Epolls:     1,
+		AnonInodes: 1,
+		Devices:    2,
+		Other:      1,
+	}, stat)
+
+	stat = classifyFds(targets, 2, scrubber)
+	assert.Equal(t, []*model.FdPath{
+		{Path: "/var/log/app.log", Count: 2},
+		{Path: "/etc/hosts", Count: 1},
+	}, stat.TopPaths)
+
+	stat = classifyFds(targets, 10, scrubber)
+	assert.Equal(t, []*model.FdPath{
+		{Path: "/var/log/app.log", Count: 2},
+		{Path: "/etc/hosts", Count: 1},
+		{Path: "/run/secret/********", Count: 1},
+	}, stat.TopPaths)
+}
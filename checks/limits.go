@@ -0,0 +1,21 @@
+package checks
+
+import (
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// rlimInfinity is the value of an unlimited resource limit.
+const rlimInfinity = ^uint64(0)
+
+// fdUtilizationPct returns how close a process is to running out of file
+// descriptors, and failing with EMFILE, against its soft limit.
+func fdUtilizationPct(openFdCount int32, limits *model.ProcessLimits) float32 {
+	if openFdCount <= 0 || limits == nil || limits.OpenFiles == nil {
+		return 0
+	}
+	soft := limits.OpenFiles.Soft
+	if soft == 0 || soft == rlimInfinity {
+		return 0
+	}
+	return float32(float64(openFdCount) / float64(soft) * 100)
+}
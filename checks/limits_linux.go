@@ -0,0 +1,65 @@
+// +build linux
+
+package checks
+
+import (
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// readLimits reads the resource limits of a process from /proc/<pid>/limits.
+func readLimits(pid int32) *model.ProcessLimits {
+	data, err := ioutil.ReadFile(util.HostProc(strconv.Itoa(int(pid)), "limits"))
+	if err != nil {
+		return nil
+	}
+	return parseLimits(string(data))
+}
+
+// parseLimits parses the content of /proc/<pid>/limits, e.g.
+//   Limit                     Soft Limit           Hard Limit           Units
+//   Max open files            1024                 1048576              files
+func parseLimits(data string) *model.ProcessLimits {
+	limits := &model.ProcessLimits{}
+	for _, line := range strings.Split(data, "\n") {
+		switch {
+		case strings.HasPrefix(line, "Max open files "):
+			limits.OpenFiles = parseLimit(line[len("Max open files "):])
+		case strings.HasPrefix(line, "Max processes "):
+			limits.Processes = parseLimit(line[len("Max processes "):])
+		case strings.HasPrefix(line, "Max locked memory "):
+			limits.LockedMemory = parseLimit(line[len("Max locked memory "):])
+		case strings.HasPrefix(line, "Max core file size "):
+			limits.CoreSize = parseLimit(line[len("Max core file size "):])
+		}
+	}
+	return limits
+}
+
+func parseLimit(s string) *model.ResourceLimit {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return nil
+	}
+	soft, ok := parseLimitValue(fields[0])
+	if !ok {
+		return nil
+	}
+	hard, ok := parseLimitValue(fields[1])
+	if !ok {
+		return nil
+	}
+	return &model.ResourceLimit{Soft: soft, Hard: hard}
+}
+
+func parseLimitValue(s string) (uint64, bool) {
+	if s == "unlimited" {
+		return rlimInfinity, true
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	return v, err == nil
+}
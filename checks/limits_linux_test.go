@@ -0,0 +1,42 @@
+// +build linux
+
+package checks
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestParseLimits(t *testing.T) {
+	data := `Limit                     Soft Limit           Hard Limit           Units     
+Max cpu time              unlimited            unlimited            seconds   
+Max file size             unlimited            unlimited            bytes     
+Max stack size            8388608              unlimited            bytes     
+Max core file size        0                    unlimited            bytes     
+Max processes             63512                63512                processes 
+Max open files            1024                 1048576              files     
+Max locked memory         65536                65536                bytes     
+Max pending signals       63512                63512                signals   
+`
+	assert.Equal(t, &model.ProcessLimits{
+		OpenFiles:    &model.ResourceLimit{Soft: 1024, Hard: 1048576},
+		Processes:    &model.ResourceLimit{Soft: 63512, Hard: 63512},
+		LockedMemory: &model.ResourceLimit{Soft: 65536, Hard: 65536},
+		CoreSize:     &model.ResourceLimit{Soft: 0, Hard: rlimInfinity},
+	}, parseLimits(data))
+}
+
+func TestReadLimits(t *testing.T) {
+	var rlim syscall.Rlimit
+	assert.NoError(t, syscall.Getrlimit(syscall.RLIMIT_NOFILE, &rlim))
+	limits := readLimits(int32(os.Getpid()))
+	if assert.NotNil(t, limits) && assert.NotNil(t, limits.OpenFiles) {
+		assert.Equal(t, rlim.Cur, limits.OpenFiles.Soft)
+		assert.Equal(t, rlim.Max, limits.OpenFiles.Hard)
+	}
+}
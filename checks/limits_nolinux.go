@@ -0,0 +1,8 @@
+// +build !linux
+
+package checks
+
+import "github.com/DataDog/datadog-process-agent/model"
+
+// readLimits is only supported on Linux.
+func readLimits(pid int32) *model.ProcessLimits { return nil }
@@ -0,0 +1,20 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestFdUtilizationPct(t *testing.T) {
+	assert := assert.New(t)
+	limits := &model.ProcessLimits{OpenFiles: &model.ResourceLimit{Soft: 1024, Hard: 4096}}
+	assert.Equal(float32(50), fdUtilizationPct(512, limits))
+	assert.Equal(float32(0), fdUtilizationPct(-1, limits))
+	assert.Equal(float32(0), fdUtilizationPct(512, nil))
+	assert.Equal(float32(0), fdUtilizationPct(512, &model.ProcessLimits{}))
+	limits.OpenFiles.Soft = rlimInfinity
+	assert.Equal(float32(0), fdUtilizationPct(512, limits))
+}
@@ -0,0 +1,63 @@
+package checks
+
+import (
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// Listeners is a singleton ListenersCheck.
+var Listeners = &ListenersCheck{}
+
+// ListenersCheck reports which processes listen on which TCP, UDP and unix
+// sockets, for an audit of the open ports. Unlike the ConnectionsCheck, it
+// reads procfs and doesn't need the network tracer.
+type ListenersCheck struct{}
+
+// Init initializes the singleton ListenersCheck.
+func (l *ListenersCheck) Init(cfg *config.AgentConfig, info *model.SystemInfo) {}
+
+// Name returns the name of the ListenersCheck.
+func (l *ListenersCheck) Name() string { return "listeners" }
+
+// Endpoint returns the endpoint where this check is submitted.
+func (l *ListenersCheck) Endpoint() string { return "/api/v1/listeners" }
+
+// RealTime indicates if this check only runs in real-time mode.
+func (l *ListenersCheck) RealTime() bool { return false }
+
+// Run runs the ListenersCheck to collect the listening sockets of all the
+// network namespaces of the host. Only Linux is supported.
+func (l *ListenersCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.MessageBody, error) {
+	listeners, err := collectListeners()
+	if err != nil {
+		return nil, err
+	}
+
+	// Process create-times required to construct unique process hash keys on the backend
+	pids := make([]uint32, 0, len(listeners))
+	for _, ln := range listeners {
+		pids = append(pids, uint32(ln.Pid))
+	}
+	createTimeForPID := Process.createTimesforPIDs(pids)
+	for _, ln := range listeners {
+		ln.PidCreateTime = createTimeForPID[uint32(ln.Pid)]
+	}
+	return batchListeners(cfg, groupID, listeners), nil
+}
+
+func batchListeners(cfg *config.AgentConfig, groupID int32, listeners []*model.Listener) []model.MessageBody {
+	groupSize := groupSize(len(listeners), cfg.MaxPerMessage)
+	batches := make([]model.MessageBody, 0, groupSize)
+
+	for len(listeners) > 0 {
+		batchSize := min(cfg.MaxPerMessage, len(listeners))
+		batches = append(batches, &model.CollectorListeners{
+			HostName:  cfg.HostName,
+			Listeners: listeners[:batchSize],
+			GroupId:   groupID,
+			GroupSize: groupSize,
+		})
+		listeners = listeners[batchSize:]
+	}
+	return batches
+}
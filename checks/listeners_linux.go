@@ -0,0 +1,88 @@
+// +build linux
+
+package checks
+
+import (
+	"sort"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// collectListeners reads the listening sockets of each network namespace,
+// and finds the processes holding them. The sockets no process could be
+// found for, e.g. the ones of the kernel, are reported with the pid 0.
+func collectListeners() ([]*model.Listener, error) {
+	byInode := make(map[uint64]*model.Listener)
+	for ns, pid := range netNamespaces() {
+		for _, s := range readInetSockets(pid) {
+			if s.inode == 0 || !isInetListener(s) {
+				continue
+			}
+			protocol := model.ListenerProtocol_listenTcp
+			if s.typ == model.ConnectionType_udp {
+				protocol = model.ListenerProtocol_listenUdp
+			}
+			byInode[s.inode] = &model.Listener{
+				Protocol:     protocol,
+				Family:       s.family,
+				Addr:         s.laddr,
+				Inode:        s.inode,
+				NetNamespace: ns,
+			}
+		}
+		for _, s := range readUnixSockets(pid) {
+			if s.inode == 0 || !s.listening {
+				continue
+			}
+			byInode[s.inode] = &model.Listener{
+				Protocol:     model.ListenerProtocol_listenUnix,
+				Path:         s.path,
+				Inode:        s.inode,
+				NetNamespace: ns,
+			}
+		}
+	}
+
+	inodes := make(map[uint64]struct{}, len(byInode))
+	for inode := range byInode {
+		inodes[inode] = struct{}{}
+	}
+	owners := socketOwners(inodes)
+
+	listeners := make([]*model.Listener, 0, len(byInode))
+	for inode, ln := range byInode {
+		// Sorted by pid, the lowest is usually the parent of the others.
+		if pids := owners[inode]; len(pids) > 0 {
+			ln.Pid = pids[0]
+			ln.SharedBy = int32(len(pids))
+		}
+		listeners = append(listeners, ln)
+	}
+	sortListeners(listeners)
+	return listeners, nil
+}
+
+// isInetListener tells if a TCP socket is listening, or if a UDP socket is
+// bound without being connected.
+func isInetListener(s inetSocket) bool {
+	if s.typ == model.ConnectionType_tcp {
+		return s.state == tcpListen
+	}
+	return s.state == tcpClose && s.raddr.Port == 0
+}
+
+func sortListeners(listeners []*model.Listener) {
+	sort.Slice(listeners, func(i, j int) bool {
+		a, b := listeners[i], listeners[j]
+		if a.Protocol != b.Protocol {
+			return a.Protocol < b.Protocol
+		}
+		if a.Addr != nil && b.Addr != nil && a.Addr.Port != b.Addr.Port {
+			return a.Addr.Port < b.Addr.Port
+		}
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Inode < b.Inode
+	})
+}
@@ -0,0 +1,63 @@
+// +build linux
+
+package checks
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestCollectListeners(t *testing.T) {
+	tcp, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer tcp.Close()
+	udp, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer udp.Close()
+	dir, err := ioutil.TempDir("", "listeners")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "test.sock")
+	unix, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	defer unix.Close()
+	// Connected sockets aren't listening.
+	conn, err := net.Dial("tcp4", tcp.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	listeners, err := collectListeners()
+	require.NoError(t, err)
+
+	pid := int32(os.Getpid())
+	find := func(protocol model.ListenerProtocol, port int, path string) *model.Listener {
+		for _, ln := range listeners {
+			if ln.Protocol != protocol || ln.Pid != pid {
+				continue
+			}
+			if (ln.Addr != nil && int(ln.Addr.Port) == port) || (path != "" && ln.Path == path) {
+				return ln
+			}
+		}
+		return nil
+	}
+
+	ln := find(model.ListenerProtocol_listenTcp, tcp.Addr().(*net.TCPAddr).Port, "")
+	if assert.NotNil(t, ln) {
+		assert.Equal(t, "127.0.0.1", ln.Addr.Ip)
+		assert.Equal(t, model.ConnectionFamily_v4, ln.Family)
+		assert.Equal(t, int32(1), ln.SharedBy)
+		assert.NotZero(t, ln.Inode)
+	}
+	assert.NotNil(t, find(model.ListenerProtocol_listenUdp, udp.LocalAddr().(*net.UDPAddr).Port, ""))
+	assert.NotNil(t, find(model.ListenerProtocol_listenUnix, 0, path))
+	assert.Nil(t, find(model.ListenerProtocol_listenTcp, conn.LocalAddr().(*net.TCPAddr).Port, ""))
+}
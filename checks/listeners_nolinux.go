@@ -0,0 +1,14 @@
+// +build !linux
+
+package checks
+
+import (
+	"errors"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// collectListeners is only supported on Linux.
+func collectListeners() ([]*model.Listener, error) {
+	return nil, errors.New("the listeners check is only supported on Linux")
+}
@@ -0,0 +1,26 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestBatchListeners(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	cfg.MaxPerMessage = 2
+	listeners := []*model.Listener{{Pid: 1}, {Pid: 2}, {Pid: 3}}
+
+	batches := batchListeners(cfg, 7, listeners)
+	assert.Len(t, batches, 2)
+	first := batches[0].(*model.CollectorListeners)
+	assert.Equal(t, int32(7), first.GroupId)
+	assert.Equal(t, int32(2), first.GroupSize)
+	assert.Len(t, first.Listeners, 2)
+	assert.Len(t, batches[1].(*model.CollectorListeners).Listeners, 1)
+
+	assert.Empty(t, batchListeners(cfg, 7, nil))
+}
@@ -0,0 +1,104 @@
+package checks
+
+import (
+	"sort"
+	"time"
+
+	"github.com/DataDog/gopsutil/process"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// memoryDetails is the memory usage of a process, accounting for the pages
+// shared with other processes, in bytes.
+type memoryDetails struct {
+	pss        uint64
+	uss        uint64
+	swapPss    uint64
+	anonymous  uint64
+	fileBacked uint64
+}
+
+type cachedMemoryDetails struct {
+	createTime int64
+	readAt     time.Time
+	details    *memoryDetails
+}
+
+// memoryDetailsCollector reads the detailed memory usage of processes, which
+// is expensive: the kernel walks all the pages of a process to produce it.
+// At most budget processes are read per run, the ones never read first and
+// then the ones read the longest time ago, the others report the last values
+// read.
+type memoryDetailsCollector struct {
+	budget int
+	cache  map[int32]*cachedMemoryDetails
+
+	// Overridden in tests.
+	now         func() time.Time
+	readDetails func(pid int32) (*memoryDetails, error)
+}
+
+func newMemoryDetailsCollector(budget int) *memoryDetailsCollector {
+	return &memoryDetailsCollector{
+		budget:      budget,
+		cache:       make(map[int32]*cachedMemoryDetails),
+		now:         time.Now,
+		readDetails: readMemoryDetails,
+	}
+}
+
+// update reads the memory details of up to budget processes.
+func (c *memoryDetailsCollector) update(cfg *config.AgentConfig, procs map[int32]*process.FilledProcess) {
+	for pid, cached := range c.cache {
+		if fp, ok := procs[pid]; !ok || fp.CreateTime != cached.createTime {
+			delete(c.cache, pid)
+		}
+	}
+
+	candidates := make([]*process.FilledProcess, 0, len(procs))
+	for _, fp := range procs {
+		if len(fp.Cmdline) == 0 || config.IsBlacklisted(fp.Cmdline, cfg.Blacklist) {
+			continue
+		}
+		candidates = append(candidates, fp)
+	}
+	// Never read (zero time) first, then the stalest.
+	sort.Slice(candidates, func(i, j int) bool {
+		return c.readAt(candidates[i].Pid).Before(c.readAt(candidates[j].Pid))
+	})
+	if len(candidates) > c.budget {
+		candidates = candidates[:c.budget]
+	}
+
+	now := c.now()
+	for _, fp := range candidates {
+		details, err := c.readDetails(fp.Pid)
+		if err != nil {
+			// Most likely gone or not readable, don't retry it before the others.
+			details = nil
+		}
+		c.cache[fp.Pid] = &cachedMemoryDetails{createTime: fp.CreateTime, readAt: now, details: details}
+	}
+}
+
+func (c *memoryDetailsCollector) readAt(pid int32) time.Time {
+	if cached, ok := c.cache[pid]; ok {
+		return cached.readAt
+	}
+	return time.Time{}
+}
+
+// fill sets the last memory details read for a process in its memory stats.
+func (c *memoryDetailsCollector) fill(pid int32, stat *model.MemoryStat) {
+	cached, ok := c.cache[pid]
+	if !ok || cached.details == nil || stat == nil {
+		return
+	}
+	stat.Pss = cached.details.pss
+	stat.Uss = cached.details.uss
+	stat.SwapPss = cached.details.swapPss
+	stat.Anonymous = cached.details.anonymous
+	stat.FileBacked = cached.details.fileBacked
+}
@@ -0,0 +1,70 @@
+// +build linux
+
+package checks
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// readMemoryDetails reads the memory details of a process from
+// /proc/<pid>/smaps_rollup (Linux 4.14+), or /proc/<pid>/smaps.
+func readMemoryDetails(pid int32) (*memoryDetails, error) {
+	dir := util.HostProc(strconv.Itoa(int(pid)))
+	f, err := os.Open(dir + "/smaps_rollup")
+	if os.IsNotExist(err) {
+		f, err = os.Open(dir + "/smaps")
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseSmaps(f)
+}
+
+// parseSmaps sums the sizes of all the mappings listed in the content of
+// /proc/<pid>/smaps, smaps_rollup having a single one.
+func parseSmaps(r io.Reader) (*memoryDetails, error) {
+	var rss, privateClean, privateDirty uint64
+	d := &memoryDetails{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		// e.g. "Private_Dirty:      1234 kB"
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || fields[2] != "kB" {
+			continue
+		}
+		v, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		v *= 1024
+		switch fields[0] {
+		case "Rss:":
+			rss += v
+		case "Pss:":
+			d.pss += v
+		case "Private_Clean:":
+			privateClean += v
+		case "Private_Dirty:":
+			privateDirty += v
+		case "SwapPss:":
+			d.swapPss += v
+		case "Anonymous:":
+			d.anonymous += v
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	d.uss = privateClean + privateDirty
+	if rss > d.anonymous {
+		d.fileBacked = rss - d.anonymous
+	}
+	return d, nil
+}
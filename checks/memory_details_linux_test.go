@@ -0,0 +1,73 @@
+// +build linux
+
+package checks
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSmaps(t *testing.T) {
+	assert := assert.New(t)
+	rollup := `55d0c8a4e000-7ffd3b1f2000 ---p 00000000 00:00 0                          [rollup]
+Rss:                4000 kB
+Pss:                2500 kB
+Pss_Anon:           1200 kB
+Pss_File:           1300 kB
+Shared_Clean:       1500 kB
+Shared_Dirty:        500 kB
+Private_Clean:       800 kB
+Private_Dirty:      1200 kB
+Anonymous:          1500 kB
+Swap:                100 kB
+SwapPss:              50 kB
+Locked:                0 kB
+`
+	d, err := parseSmaps(strings.NewReader(rollup))
+	assert.NoError(err)
+	assert.Equal(&memoryDetails{
+		pss:        2500 * 1024,
+		uss:        2000 * 1024,
+		swapPss:    50 * 1024,
+		anonymous:  1500 * 1024,
+		fileBacked: 2500 * 1024,
+	}, d)
+
+	// Without smaps_rollup, all the mappings are summed up.
+	smaps := `00400000-00452000 r-xp 00000000 08:02 173521      /usr/bin/dbus-daemon
+Rss:                 100 kB
+Pss:                  50 kB
+Private_Clean:        20 kB
+Private_Dirty:         0 kB
+Anonymous:             0 kB
+SwapPss:               0 kB
+VmFlags: rd ex mr mw me dw
+00e03000-00e24000 rw-p 00000000 00:00 0           [heap]
+Rss:                  40 kB
+Pss:                  40 kB
+Private_Clean:         0 kB
+Private_Dirty:        40 kB
+Anonymous:            40 kB
+SwapPss:               8 kB
+VmFlags: rd wr mr mw me ac
+`
+	d, err = parseSmaps(strings.NewReader(smaps))
+	assert.NoError(err)
+	assert.Equal(&memoryDetails{
+		pss:        90 * 1024,
+		uss:        60 * 1024,
+		swapPss:    8 * 1024,
+		anonymous:  40 * 1024,
+		fileBacked: 100 * 1024,
+	}, d)
+}
+
+func TestReadMemoryDetails(t *testing.T) {
+	d, err := readMemoryDetails(int32(os.Getpid()))
+	assert.NoError(t, err)
+	assert.NotZero(t, d.pss)
+	assert.NotZero(t, d.uss)
+}
@@ -0,0 +1,10 @@
+// +build !linux
+
+package checks
+
+import "errors"
+
+// readMemoryDetails is only supported on Linux.
+func readMemoryDetails(pid int32) (*memoryDetails, error) {
+	return nil, errors.New("memory details are only supported on Linux")
+}
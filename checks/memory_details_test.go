@@ -0,0 +1,65 @@
+package checks
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestMemoryDetailsCollectorBudget(t *testing.T) {
+	assert := assert.New(t)
+	cfg := config.NewDefaultAgentConfig()
+	now := time.Unix(1000, 0)
+	var reads []int32
+	c := newMemoryDetailsCollector(2)
+	c.now = func() time.Time { return now }
+	c.readDetails = func(pid int32) (*memoryDetails, error) {
+		reads = append(reads, pid)
+		if pid == 4 {
+			return nil, fmt.Errorf("permission denied")
+		}
+		return &memoryDetails{pss: uint64(pid) * 1024, uss: uint64(pid)}, nil
+	}
+	procs := map[int32]*process.FilledProcess{
+		1: makeProcess(1, "worker"),
+		2: makeProcess(2, "worker"),
+		3: makeProcess(3, "worker"),
+		4: makeProcess(4, "worker"),
+	}
+
+	// Each run reads the processes not read yet, then the stalest ones.
+	c.update(cfg, procs)
+	assert.Len(reads, 2)
+	now = now.Add(time.Second)
+	c.update(cfg, procs)
+	assert.Len(reads, 4)
+	assert.ElementsMatch([]int32{1, 2, 3, 4}, reads)
+	now = now.Add(time.Second)
+	c.update(cfg, procs)
+	assert.ElementsMatch(reads[:2], reads[4:])
+
+	stat := &model.MemoryStat{Rss: 4096}
+	c.fill(3, stat)
+	assert.Equal(uint64(3*1024), stat.Pss)
+	assert.Equal(uint64(3), stat.Uss)
+	assert.Equal(uint64(4096), stat.Rss)
+
+	// Unreadable.
+	stat = &model.MemoryStat{}
+	c.fill(4, stat)
+	assert.Equal(uint64(0), stat.Pss)
+
+	// Gone or reused pids are forgotten.
+	procs[1].CreateTime = 42
+	delete(procs, 2)
+	c.update(cfg, procs)
+	_, ok := c.cache[2]
+	assert.False(ok)
+	assert.Equal(int32(1), reads[len(reads)-2])
+}
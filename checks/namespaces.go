@@ -0,0 +1,60 @@
+package checks
+
+import (
+	"github.com/DataDog/gopsutil/process"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// procIsolation is the namespaces and cgroup of a process.
+type procIsolation struct {
+	createTime int64
+	exe        string
+
+	namespaces    *model.Namespaces
+	cgroup        string
+	systemdCgroup string
+}
+
+// isolationCache reads the namespaces and cgroup of each process once per
+// program it runs: they are set up before a program starts, e.g. by a
+// container runtime, and seldom change afterwards.
+type isolationCache struct {
+	byPid map[int32]*procIsolation
+
+	// Overridden in tests.
+	read func(pid int32) *procIsolation
+}
+
+func newIsolationCache() *isolationCache {
+	return &isolationCache{
+		byPid: make(map[int32]*procIsolation),
+		read:  readIsolation,
+	}
+}
+
+func readIsolation(pid int32) *procIsolation {
+	iso := &procIsolation{namespaces: readNamespaces(pid)}
+	iso.cgroup, iso.systemdCgroup = readCgroup(pid)
+	return iso
+}
+
+// update reads the namespaces and cgroup of the processes started or exec'ed
+// since the last update, forgets the ones that exited and returns them all by
+// pid. The result is only valid until the next update.
+func (c *isolationCache) update(procs map[int32]*process.FilledProcess) map[int32]*procIsolation {
+	for pid := range c.byPid {
+		if _, ok := procs[pid]; !ok {
+			delete(c.byPid, pid)
+		}
+	}
+	for pid, fp := range procs {
+		if iso, ok := c.byPid[pid]; ok && iso.createTime == fp.CreateTime && iso.exe == fp.Exe {
+			continue
+		}
+		iso := c.read(pid)
+		iso.createTime, iso.exe = fp.CreateTime, fp.Exe
+		c.byPid[pid] = iso
+	}
+	return c.byPid
+}
@@ -0,0 +1,110 @@
+// +build linux
+
+package checks
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// readNamespaces reads the namespaces of a process from the links in
+// /proc/<pid>/ns, e.g. "net:[4026531840]". Namespaces not supported by the
+// kernel are left to 0.
+func readNamespaces(pid int32) *model.Namespaces {
+	dir := util.HostProc(strconv.Itoa(int(pid)), "ns")
+	ns := &model.Namespaces{}
+	found := false
+	for name, inode := range map[string]*uint64{
+		"pid":    &ns.Pid,
+		"net":    &ns.Net,
+		"mnt":    &ns.Mnt,
+		"user":   &ns.User,
+		"uts":    &ns.Uts,
+		"ipc":    &ns.Ipc,
+		"cgroup": &ns.Cgroup,
+	} {
+		link, err := os.Readlink(dir + "/" + name)
+		if err != nil {
+			continue
+		}
+		if *inode = parseNamespaceInode(link); *inode != 0 {
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return ns
+}
+
+// parseNamespaceInode returns the inode of a namespace link, e.g. 4026531840
+// for "net:[4026531840]".
+func parseNamespaceInode(link string) uint64 {
+	start := strings.IndexByte(link, '[')
+	if start < 0 || !strings.HasSuffix(link, "]") {
+		return 0
+	}
+	inode, err := strconv.ParseUint(link[start+1:len(link)-1], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return inode
+}
+
+// readCgroup reads the cgroup of a process from /proc/<pid>/cgroup, and the
+// cgroup systemd placed it in.
+func readCgroup(pid int32) (path, systemdPath string) {
+	data, err := ioutil.ReadFile(util.HostProc(strconv.Itoa(int(pid)), "cgroup"))
+	if err != nil {
+		return "", ""
+	}
+	return parseCgroup(string(data))
+}
+
+// parseCgroup returns the cgroup path of a process from the content of
+// /proc/<pid>/cgroup, one "hierarchy-ID:controllers:path" line per hierarchy.
+// The unified (v2) hierarchy has the ID 0 and no controllers, and is only used
+// when it's the only one. Otherwise, with cgroup v1 or in hybrid mode, the
+// path of the memory controller is used, which is where container runtimes
+// put their processes: cgroupfs drivers, e.g. LXC's, may leave the unified
+// path at the parent. systemd manages its own named v1 hierarchy, or the
+// unified one.
+func parseCgroup(data string) (path, systemdPath string) {
+	var unified, memory, named, first string
+	for _, line := range strings.Split(data, "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		if parts[0] == "0" && parts[1] == "" {
+			unified = parts[2]
+			continue
+		}
+		if first == "" {
+			first = parts[2]
+		}
+		for _, c := range strings.Split(parts[1], ",") {
+			switch c {
+			case "memory":
+				memory = parts[2]
+			case "name=systemd":
+				named = parts[2]
+			}
+		}
+	}
+	if named == "" {
+		named = unified
+	}
+	if memory != "" {
+		return memory, named
+	}
+	if first != "" {
+		return first, named
+	}
+	return unified, named
+}
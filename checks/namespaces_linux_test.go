@@ -0,0 +1,96 @@
+// +build linux
+
+package checks
+
+import (
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNamespaceInode(t *testing.T) {
+	assert.Equal(t, uint64(4026531840), parseNamespaceInode("net:[4026531840]"))
+	assert.Equal(t, uint64(4026532197), parseNamespaceInode("cgroup:[4026532197]"))
+	assert.Equal(t, uint64(0), parseNamespaceInode("net:[]"))
+	assert.Equal(t, uint64(0), parseNamespaceInode("/proc/1/ns/net"))
+}
+
+func TestParseCgroup(t *testing.T) {
+	for _, tc := range []struct {
+		data            string
+		expected        string
+		expectedSystemd string
+	}{
+		{
+			data:            "0::/system.slice/nginx.service\n",
+			expected:        "/system.slice/nginx.service",
+			expectedSystemd: "/system.slice/nginx.service",
+		},
+		{
+			// Hybrid mode, the unified hierarchy has no controllers.
+			data: `12:pids:/machine.slice/libpod-2b6d.scope
+4:memory:/machine.slice/libpod-2b6d.scope
+1:name=systemd:/machine.slice/libpod-2b6d.scope
+0::/machine.slice/libpod-2b6d.scope
+`,
+			expected:        "/machine.slice/libpod-2b6d.scope",
+			expectedSystemd: "/machine.slice/libpod-2b6d.scope",
+		},
+		{
+			data: `11:cpu,cpuacct:/lxc/web
+7:memory:/lxc/web/payload
+1:name=systemd:/lxc/web
+`,
+			expected:        "/lxc/web/payload",
+			expectedSystemd: "/lxc/web",
+		},
+		{
+			// Hybrid mode, with a cgroupfs driver leaving the unified path at the parent.
+			data: `11:cpu,cpuacct:/lxc/web
+7:memory:/lxc/web/payload
+1:name=systemd:/lxc/web
+0::/lxc
+`,
+			expected:        "/lxc/web/payload",
+			expectedSystemd: "/lxc/web",
+		},
+		{
+			// Hybrid mode, without the named systemd hierarchy.
+			data: `7:memory:/docker/49de419da182
+0::/system.slice/docker.service
+`,
+			expected:        "/docker/49de419da182",
+			expectedSystemd: "/system.slice/docker.service",
+		},
+		{
+			data:     "3:cpuset:/jailer/vm1\n",
+			expected: "/jailer/vm1",
+		},
+		{
+			data:     "",
+			expected: "",
+		},
+	} {
+		path, systemdPath := parseCgroup(tc.data)
+		assert.Equal(t, tc.expected, path)
+		assert.Equal(t, tc.expectedSystemd, systemdPath)
+	}
+}
+
+func TestReadNamespaces(t *testing.T) {
+	var st syscall.Stat_t
+	require.NoError(t, syscall.Stat("/proc/self/ns/net", &st))
+
+	ns := readNamespaces(int32(os.Getpid()))
+	require.NotNil(t, ns)
+	assert.Equal(t, st.Ino, ns.Net)
+	assert.NotZero(t, ns.Pid)
+	assert.NotZero(t, ns.Mnt)
+
+	path, _ := readCgroup(int32(os.Getpid()))
+	assert.NotEmpty(t, path)
+	assert.Nil(t, readNamespaces(-1))
+}
@@ -0,0 +1,11 @@
+// +build !linux
+
+package checks
+
+import "github.com/DataDog/datadog-process-agent/model"
+
+// readNamespaces is only supported on Linux.
+func readNamespaces(pid int32) *model.Namespaces { return nil }
+
+// readCgroup is only supported on Linux.
+func readCgroup(pid int32) (path, systemdPath string) { return "", "" }
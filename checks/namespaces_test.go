@@ -0,0 +1,41 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsolationCache(t *testing.T) {
+	assert := assert.New(t)
+	reads := map[int32]int{}
+	c := newIsolationCache()
+	c.read = func(pid int32) *procIsolation {
+		reads[pid]++
+		return &procIsolation{cgroup: "/system.slice/app.service"}
+	}
+
+	procs := map[int32]*process.FilledProcess{
+		1: {Pid: 1, CreateTime: 100, Exe: "/sbin/init"},
+		2: {Pid: 2, CreateTime: 200, Exe: "/usr/bin/runc"},
+	}
+	isolation := c.update(procs)
+	assert.Len(isolation, 2)
+	assert.Equal("/system.slice/app.service", isolation[2].cgroup)
+
+	// Read once per program.
+	c.update(procs)
+	assert.Equal(map[int32]int{1: 1, 2: 1}, reads)
+	procs[2] = &process.FilledProcess{Pid: 2, CreateTime: 200, Exe: "/usr/bin/app"}
+	c.update(procs)
+	assert.Equal(2, reads[2])
+	// The pid was reused.
+	procs[2] = &process.FilledProcess{Pid: 2, CreateTime: 300, Exe: "/usr/bin/app"}
+	c.update(procs)
+	assert.Equal(3, reads[2])
+
+	delete(procs, 1)
+	assert.Len(c.update(procs), 1)
+	assert.Equal(1, reads[1])
+}
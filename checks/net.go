@@ -26,6 +26,10 @@ type ConnectionsCheck struct {
 	// Local network tracer
 	useLocalTracer bool
 	localTracer    *tracer.Tracer
+	// Fallback on procfs when no network tracer is available
+	useProcfs bool
+	// Join the state and the stats of TCP connections from sock_diag
+	collectTCPStats bool
 
 	prevCheckConns []tracer.ConnectionStats
 	prevCheckTime  time.Time
@@ -37,19 +41,22 @@ type ConnectionsCheck struct {
 func (c *ConnectionsCheck) Init(cfg *config.AgentConfig, sysInfo *model.SystemInfo) {
 	var err error
 
+	c.collectTCPStats = cfg.CollectTCPStats
 	if cfg.EnableLocalNetworkTracer {
 		log.Info("starting network tracer locally")
 
 		// Checking whether the current kernel version is supported by the tracer
 		if _, err = tracer.IsTracerSupportedByOS(); err != nil {
 			// err is always returned when false, so the above catches the !ok case as well
-			log.Warnf("network tracer unsupported by OS: %s", err)
+			log.Warnf("network tracer unsupported by OS, falling back on procfs: %s", err)
+			c.useProcfs = true
 			return
 		}
 
 		t, err := tracer.NewTracer(tracer.DefaultConfig)
 		if err != nil {
-			log.Errorf("failed to create network tracer: %s", err)
+			log.Errorf("failed to create network tracer, falling back on procfs: %s", err)
+			c.useProcfs = true
 			return
 		}
 
@@ -80,6 +87,9 @@ func (c *ConnectionsCheck) RealTime() bool { return false }
 // that will be bundled up into a `CollectorConnections`.
 // See agent.proto for the schema of the message and models.
 func (c *ConnectionsCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.MessageBody, error) {
+	if c.useProcfs {
+		return c.runProcfs(cfg, groupID)
+	}
 	if c.useLocalTracer && c.localTracer == nil {
 		return nil, nil
 	}
@@ -87,6 +97,11 @@ func (c *ConnectionsCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.
 	start := time.Now()
 
 	conns, err := c.getConnections()
+	if err != nil && net.IsRemoteNetworkTracerPermaFail() {
+		log.Warnf("network tracer unreachable, falling back on procfs: %s", err)
+		c.useProcfs = true
+		return c.runProcfs(cfg, groupID)
+	}
 	if err != nil {
 		// If the tracer is not initialized, or still not initialized, then we want to exit without error'ing
 		if err == tracer.ErrNotImplemented || err == ErrTracerStillNotInitialized {
@@ -115,6 +130,38 @@ func (c *ConnectionsCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.
 	return batchConnections(cfg, groupID, c.formatConnections(conns, lastConnByKey, c.prevCheckTime)), nil
 }
 
+// runProcfs collects the connections from procfs, with their state but
+// without the bytes sent and received that only the network tracer knows.
+func (c *ConnectionsCheck) runProcfs(cfg *config.AgentConfig, groupID int32) ([]model.MessageBody, error) {
+	start := time.Now()
+
+	cxs, err := procfsConnections()
+	if err != nil {
+		if err == tracer.ErrNotImplemented {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Process create-times required to construct unique process hash keys on the backend
+	pids := make([]uint32, 0, len(cxs))
+	for _, cx := range cxs {
+		pids = append(pids, uint32(cx.Pid))
+	}
+	createTimeForPID := Process.createTimesforPIDs(pids)
+	known := cxs[:0]
+	for _, cx := range cxs {
+		if createTime, ok := createTimeForPID[uint32(cx.Pid)]; ok {
+			cx.PidCreateTime = createTime
+			known = append(known, cx)
+		}
+	}
+	addTCPStats(known, c.tcpDiags(), inAgentNetNamespace())
+
+	log.Debugf("collected connections from procfs in %s", time.Since(start))
+	return batchConnections(cfg, groupID, known), nil
+}
+
 func (c *ConnectionsCheck) getConnections() ([]tracer.ConnectionStats, error) {
 	if c.useLocalTracer { // If local tracer is set up, use that
 		if c.localTracer == nil {
@@ -172,9 +219,25 @@ func (c *ConnectionsCheck) formatConnections(conns []tracer.ConnectionStats, las
 		})
 	}
 	c.prevCheckConns = conns
+	addTCPStats(cxs, c.tcpDiags(), inAgentNetNamespace())
 	return cxs
 }
 
+// tcpDiags returns the state and the stats of the TCP sockets by connection
+// tuple, when enabled. Only the sockets of the network namespace of the agent
+// are known, see addTCPStats.
+func (c *ConnectionsCheck) tcpDiags() map[connTuple]tcpDiag {
+	if !c.collectTCPStats {
+		return nil
+	}
+	diags, err := readTCPDiag()
+	if err != nil {
+		log.Debugf("could not read TCP stats from sock_diag: %s", err)
+		return nil
+	}
+	return diags
+}
+
 func formatFamily(f tracer.ConnectionFamily) model.ConnectionFamily {
 	switch f {
 	case tracer.AF_INET:
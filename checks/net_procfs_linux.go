@@ -0,0 +1,47 @@
+// +build linux
+
+package checks
+
+import (
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// procfsConnections reads the TCP and UDP connections of all the network
+// namespaces of the host from procfs, for when the network tracer isn't
+// available. Listening sockets and the connections no process holds anymore,
+// like the ones in TIME_WAIT, are left out.
+func procfsConnections() ([]*model.Connection, error) {
+	var sockets []inetSocket
+	inodes := make(map[uint64]struct{})
+	for _, pid := range netNamespaces() {
+		for _, s := range readInetSockets(pid) {
+			if s.inode == 0 || isInetListener(s) {
+				continue
+			}
+			sockets = append(sockets, s)
+			inodes[s.inode] = struct{}{}
+		}
+	}
+	owners := socketOwners(inodes)
+
+	cxs := make([]*model.Connection, 0, len(sockets))
+	for _, s := range sockets {
+		pids := owners[s.inode]
+		if len(pids) == 0 {
+			continue
+		}
+		cx := &model.Connection{
+			Pid:        pids[0],
+			Family:     s.family,
+			Type:       s.typ,
+			Laddr:      s.laddr,
+			Raddr:      s.raddr,
+			FromProcfs: true,
+		}
+		if s.typ == model.ConnectionType_tcp {
+			cx.TcpState = model.TCPState(s.state)
+		}
+		cxs = append(cxs, cx)
+	}
+	return cxs, nil
+}
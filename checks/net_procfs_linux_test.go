@@ -0,0 +1,56 @@
+// +build linux
+
+package checks
+
+import (
+	"net"
+	"os"
+	"testing"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestProcfsConnections(t *testing.T) {
+	ln, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	conn, err := net.Dial("tcp4", ln.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	port := int32(conn.LocalAddr().(*net.TCPAddr).Port)
+	lnPort := int32(ln.Addr().(*net.TCPAddr).Port)
+
+	pid := int32(os.Getpid())
+	lastProcs := Process.lastProcs
+	defer func() { Process.lastProcs = lastProcs }()
+	Process.lastProcs = map[int32]*process.FilledProcess{pid: {Pid: pid, CreateTime: 1234}}
+
+	c := &ConnectionsCheck{useProcfs: true}
+	msgs, err := c.Run(config.NewDefaultAgentConfig(), 1)
+	require.NoError(t, err)
+
+	var found *model.Connection
+	for _, m := range msgs {
+		for _, cx := range m.(*model.CollectorConnections).Connections {
+			assert.Equal(t, pid, cx.Pid)
+			assert.True(t, cx.FromProcfs)
+			if cx.Laddr.Port == lnPort && cx.Raddr.Port == 0 {
+				t.Errorf("listening socket reported as a connection: %v", cx)
+			}
+			if cx.Laddr.Port == port {
+				found = cx
+			}
+		}
+	}
+	require.NotNil(t, found)
+	assert.Equal(t, int64(1234), found.PidCreateTime)
+	assert.Equal(t, model.ConnectionType_tcp, found.Type)
+	assert.Equal(t, model.ConnectionFamily_v4, found.Family)
+	assert.Equal(t, model.TCPState_established, found.TcpState)
+	assert.Equal(t, &model.Addr{Ip: "127.0.0.1", Port: lnPort}, found.Raddr)
+}
@@ -0,0 +1,13 @@
+// +build !linux
+
+package checks
+
+import (
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/tcptracer-bpf/pkg/tracer"
+)
+
+// procfsConnections is only implemented on linux
+func procfsConnections() ([]*model.Connection, error) {
+	return nil, tracer.ErrNotImplemented
+}
@@ -0,0 +1,157 @@
+package checks
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/DataDog/gopsutil/cpu"
+	"github.com/DataDog/gopsutil/process"
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// threadCPUTime is the CPU time used by a thread so far, in clock ticks, and
+// the CPU it last ran on.
+type threadCPUTime struct {
+	tid       int32
+	ticks     uint64
+	processor int32
+}
+
+// perCPUTracker breaks down the CPU usage of the host and of each process by
+// CPU, between two runs. The time a thread used since the last run is
+// attributed to the CPU it last ran on.
+type perCPUTracker struct {
+	lastHost    map[string]cpu.TimesStat
+	lastThreads map[int32]map[int32]uint64
+
+	// Results of the last update.
+	hostCPUs []*model.SingleCPUStat
+	procCPUs map[int32][]*model.SingleCPUStat
+	lastCPU  map[int32]string
+
+	// Overridden in tests.
+	readThreads func(pid int32) ([]threadCPUTime, error)
+}
+
+func newPerCPUTracker() *perCPUTracker {
+	return &perCPUTracker{readThreads: readThreadCPUTimes}
+}
+
+// update collects the CPU times of the host CPUs and of the threads of the
+// processes, and computes their usage since the last update.
+func (t *perCPUTracker) update(cfg *config.AgentConfig, procs map[int32]*process.FilledProcess) {
+	hostTimes, err := cpu.Times(true)
+	if err != nil {
+		log.Debugf("Unable to collect per-CPU times: %s", err)
+		hostTimes = nil
+	}
+	t.updateFrom(cfg, hostTimes, procs)
+}
+
+func (t *perCPUTracker) updateFrom(
+	cfg *config.AgentConfig,
+	hostTimes []cpu.TimesStat,
+	procs map[int32]*process.FilledProcess,
+) {
+	// The number of seconds elapsed on each CPU, to compute percentages.
+	elapsed := make(map[string]float64, len(hostTimes))
+	host := make(map[string]cpu.TimesStat, len(hostTimes))
+	t.hostCPUs = make([]*model.SingleCPUStat, 0, len(hostTimes))
+	for _, ht := range hostTimes {
+		host[ht.CPU] = ht
+		last, ok := t.lastHost[ht.CPU]
+		if !ok {
+			continue
+		}
+		delta := ht.Total() - last.Total()
+		elapsed[ht.CPU] = delta
+		busy := delta - (ht.Idle - last.Idle) - (ht.Iowait - last.Iowait)
+		t.hostCPUs = append(t.hostCPUs, &model.SingleCPUStat{
+			Name:     ht.CPU,
+			TotalPct: percent(busy, delta),
+		})
+	}
+
+	threads := make(map[int32]map[int32]uint64, len(procs))
+	t.procCPUs = make(map[int32][]*model.SingleCPUStat, len(procs))
+	t.lastCPU = make(map[int32]string, len(procs))
+	for pid, fp := range procs {
+		if len(fp.Cmdline) == 0 || config.IsBlacklisted(fp.Cmdline, cfg.Blacklist) {
+			continue
+		}
+		ts, err := t.readThreads(pid)
+		if err != nil || len(ts) == 0 {
+			continue
+		}
+
+		ticks := make(map[int32]uint64, len(ts))
+		byCPU := make(map[int32]uint64)
+		for _, th := range ts {
+			ticks[th.tid] = th.ticks
+			if th.tid == pid {
+				t.lastCPU[pid] = cpuName(th.processor)
+			}
+			if last, ok := t.lastThreads[pid][th.tid]; ok && th.ticks >= last {
+				byCPU[th.processor] += th.ticks - last
+			} else if _, known := t.lastThreads[pid]; known {
+				// A thread started since the last run.
+				byCPU[th.processor] += th.ticks
+			}
+		}
+		threads[pid] = ticks
+
+		if _, known := t.lastThreads[pid]; !known {
+			continue
+		}
+		processors := make([]int, 0, len(byCPU))
+		for processor := range byCPU {
+			processors = append(processors, int(processor))
+		}
+		sort.Ints(processors)
+		cpus := make([]*model.SingleCPUStat, 0, len(byCPU))
+		for _, processor := range processors {
+			name := cpuName(int32(processor))
+			cpus = append(cpus, &model.SingleCPUStat{
+				Name:     name,
+				TotalPct: percent(float64(byCPU[int32(processor)])/clockTicks, elapsed[name]),
+			})
+		}
+		t.procCPUs[pid] = cpus
+	}
+
+	t.lastHost = host
+	t.lastThreads = threads
+}
+
+// fill sets the per-CPU usage of a process in its CPU stats.
+func (t *perCPUTracker) fill(pid int32, stat *model.CPUStat) {
+	if stat == nil {
+		return
+	}
+	if cpus, ok := t.procCPUs[pid]; ok {
+		stat.Cpus = cpus
+	}
+	if name, ok := t.lastCPU[pid]; ok {
+		stat.LastCpu = name
+	}
+}
+
+// cpuName returns the name of a CPU as in the per-CPU times of the host.
+func cpuName(processor int32) string {
+	return fmt.Sprintf("cpu%d", processor)
+}
+
+func percent(part, total float64) float32 {
+	if total <= 0 {
+		return 0
+	}
+	pct := part / total * 100
+	// Clamp the values that don't make sense, as in calculatePct.
+	if pct > 100 {
+		pct = 100
+	}
+	return float32(pct)
+}
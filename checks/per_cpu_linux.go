@@ -0,0 +1,63 @@
+// +build linux
+
+package checks
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// clockTicks is the number of clock ticks per second used in procfs
+// (USER_HZ), 100 on all the architectures we support.
+const clockTicks = 100
+
+// readThreadCPUTimes reads the CPU times of each thread of a process from
+// /proc/<pid>/task/<tid>/stat.
+func readThreadCPUTimes(pid int32) ([]threadCPUTime, error) {
+	dir := util.HostProc(strconv.Itoa(int(pid)), "task")
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	threads := make([]threadCPUTime, 0, len(entries))
+	for _, e := range entries {
+		tid, err := strconv.ParseInt(e.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, e.Name(), "stat"))
+		if err != nil {
+			// The thread exited.
+			continue
+		}
+		if th, ok := parseThreadStat(int32(tid), string(data)); ok {
+			threads = append(threads, th)
+		}
+	}
+	return threads, nil
+}
+
+// parseThreadStat extracts utime and stime (the 14th and 15th fields) and the
+// processor (the 39th) from the content of /proc/<pid>/task/<tid>/stat.
+func parseThreadStat(tid int32, stat string) (threadCPUTime, bool) {
+	fields := statFields(stat)
+	if len(fields) < 39-2 {
+		return threadCPUTime{}, false
+	}
+	utime, err := strconv.ParseUint(fields[14-3], 10, 64)
+	if err != nil {
+		return threadCPUTime{}, false
+	}
+	stime, err := strconv.ParseUint(fields[15-3], 10, 64)
+	if err != nil {
+		return threadCPUTime{}, false
+	}
+	processor, err := strconv.ParseInt(fields[39-3], 10, 32)
+	if err != nil {
+		return threadCPUTime{}, false
+	}
+	return threadCPUTime{tid: tid, ticks: utime + stime, processor: int32(processor)}, true
+}
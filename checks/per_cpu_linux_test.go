@@ -0,0 +1,35 @@
+// +build linux
+
+package checks
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadThreadCPUTimes(t *testing.T) {
+	pid := int32(os.Getpid())
+	threads, err := readThreadCPUTimes(pid)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, threads)
+	var found bool
+	for _, th := range threads {
+		if th.tid == pid {
+			found = true
+		}
+	}
+	assert.True(t, found)
+}
+
+func TestParseThreadStat(t *testing.T) {
+	stat := "4242 (my (thread)) S 1 4240 4240 0 -1 4194560 100 0 0 0 " +
+		"150 30 0 0 20 0 4 0 1000 100000 200 18446744073709551615 1 1 0 0 0 0 0 0 0 0 0 0 17 3 0 0 0 0 0\n"
+	th, ok := parseThreadStat(4242, stat)
+	assert.True(t, ok)
+	assert.Equal(t, threadCPUTime{tid: 4242, ticks: 180, processor: 3}, th)
+
+	_, ok = parseThreadStat(4242, "4242 (sh) S 1 4240")
+	assert.False(t, ok)
+}
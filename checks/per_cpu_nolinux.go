@@ -0,0 +1,14 @@
+// +build !linux
+
+package checks
+
+import "errors"
+
+// clockTicks is only used with the thread CPU times, read on Linux.
+const clockTicks = 100
+
+// readThreadCPUTimes is only supported on Linux, where the CPU a thread ran
+// on is exposed by procfs.
+func readThreadCPUTimes(pid int32) ([]threadCPUTime, error) {
+	return nil, errors.New("per-CPU process times are only supported on Linux")
+}
@@ -0,0 +1,71 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/DataDog/gopsutil/cpu"
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestPerCPUTracker(t *testing.T) {
+	assert := assert.New(t)
+	cfg := config.NewDefaultAgentConfig()
+	threads := map[int32][]threadCPUTime{
+		1: {{tid: 1, ticks: 100, processor: 0}, {tid: 2, ticks: 50, processor: 1}},
+		2: {{tid: 2, ticks: 10, processor: 1}},
+	}
+	tracker := newPerCPUTracker()
+	tracker.readThreads = func(pid int32) ([]threadCPUTime, error) { return threads[pid], nil }
+	procs := map[int32]*process.FilledProcess{
+		1: makeProcess(1, "server"),
+		2: makeProcess(2, "worker"),
+		3: makeProcess(3, ""),
+	}
+
+	tracker.updateFrom(cfg, []cpu.TimesStat{
+		{CPU: "cpu0", User: 10, Idle: 10},
+		{CPU: "cpu1", User: 10, Idle: 10},
+	}, procs)
+	assert.Empty(tracker.hostCPUs)
+	assert.Empty(tracker.procCPUs)
+
+	// 10s elapsed on each CPU.
+	threads[1] = []threadCPUTime{
+		{tid: 1, ticks: 600, processor: 1},
+		{tid: 2, ticks: 50, processor: 1},
+		{tid: 3, ticks: 200, processor: 0},
+	}
+	threads[2] = []threadCPUTime{{tid: 2, ticks: 260, processor: 1}}
+	tracker.updateFrom(cfg, []cpu.TimesStat{
+		{CPU: "cpu0", User: 12, Idle: 18},
+		{CPU: "cpu1", User: 20, System: 0, Idle: 10},
+	}, procs)
+
+	assert.Equal([]*model.SingleCPUStat{
+		{Name: "cpu0", TotalPct: 20},
+		{Name: "cpu1", TotalPct: 100},
+	}, tracker.hostCPUs)
+
+	stat := &model.CPUStat{LastCpu: "cpu-total", Cpus: []*model.SingleCPUStat{}}
+	tracker.fill(1, stat)
+	assert.Equal("cpu1", stat.LastCpu)
+	// The new thread 3 used 2s on cpu0, the main thread 5s on cpu1.
+	assert.Equal([]*model.SingleCPUStat{
+		{Name: "cpu0", TotalPct: 20},
+		{Name: "cpu1", TotalPct: 50},
+	}, stat.Cpus)
+
+	stat = &model.CPUStat{}
+	tracker.fill(2, stat)
+	assert.Equal([]*model.SingleCPUStat{{Name: "cpu1", TotalPct: 25}}, stat.Cpus)
+
+	// Unknown processes are left untouched.
+	stat = &model.CPUStat{LastCpu: "cpu-total"}
+	tracker.fill(3, stat)
+	assert.Equal("cpu-total", stat.LastCpu)
+	assert.Nil(stat.Cpus)
+}
@@ -0,0 +1,310 @@
+package checks
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// maxExitedProcesses caps how many short-lived processes are kept between two
+// check runs, so that a fork bomb can't make us run out of memory.
+const maxExitedProcesses = 1000
+
+// maxTrackedProcesses caps how many running processes are tracked. Exit events
+// are lost when the netlink socket overflows, so the pids that are gone are
+// also dropped every trackerPruneInterval.
+const (
+	maxTrackedProcesses  = 32768
+	trackerPruneInterval = time.Minute
+)
+
+type procEventType uint32
+
+// Event types reported by the kernel proc connector (see linux/cn_proc.h).
+const (
+	procEventFork procEventType = 0x00000001
+	procEventExec procEventType = 0x00000002
+	procEventUID  procEventType = 0x00000004
+	procEventExit procEventType = 0x80000000
+)
+
+// procEvent is a process lifecycle event reported by the proc connector.
+type procEvent struct {
+	what procEventType
+	time time.Time
+
+	// The process the event is about: the child for a fork.
+	pid  int32
+	tgid int32
+	// The parent, for a fork.
+	parentPid int32
+
+	// For an exit: the wait status, as returned by wait(2).
+	exitCode uint32
+	// For a uid change.
+	ruid, euid uint32
+}
+
+// trackedProcess is what we know about a process started after the tracker.
+type trackedProcess struct {
+	pid         int32
+	ppid        int32
+	createTime  time.Time
+	cmdline     []string
+	exe         string
+	cwd         string
+	uid, gid    int32
+	containerID string
+}
+
+// procTracker follows the processes started while the agent is running, from
+// the events of the proc connector, and keeps the ones that exited until the
+// next check run.
+type procTracker struct {
+	sync.Mutex
+
+	running   map[int32]*trackedProcess
+	exited    []*model.ShortLivedProcess
+	dropped   int
+	lastPrune time.Time
+
+	// Read the details of a running process and tell whether it still
+	// exists, overridden in tests.
+	readProcess   func(pid int32) (*trackedProcess, error)
+	processExists func(pid int32) bool
+}
+
+func newProcTracker() *procTracker {
+	return &procTracker{
+		running:       make(map[int32]*trackedProcess),
+		lastPrune:     time.Now(),
+		readProcess:   readTrackedProcess,
+		processExists: processExists,
+	}
+}
+
+// handle updates the tracker with an event from the proc connector.
+func (t *procTracker) handle(e procEvent) {
+	// Threads share their process' tgid, we only care about processes.
+	if e.pid != e.tgid {
+		return
+	}
+
+	switch e.what {
+	case procEventFork, procEventExec, procEventUID:
+		tp, err := t.readProcess(e.pid)
+		if err != nil {
+			// Already gone: we'll find out what it was from our last read, if any.
+			return
+		}
+
+		t.Lock()
+		defer t.Unlock()
+		if prev, ok := t.running[e.pid]; ok {
+			tp.createTime = prev.createTime
+		} else if len(t.running) >= maxTrackedProcesses {
+			return
+		} else {
+			tp.createTime = e.time
+		}
+		if e.what == procEventFork && tp.ppid == 0 {
+			tp.ppid = e.parentPid
+		}
+		t.running[e.pid] = tp
+	case procEventExit:
+		t.Lock()
+		defer t.Unlock()
+		tp, ok := t.running[e.pid]
+		if !ok {
+			// Started before the tracker, the process check knows about it.
+			return
+		}
+		delete(t.running, e.pid)
+		if len(t.exited) >= maxExitedProcesses {
+			t.dropped++
+			return
+		}
+		t.exited = append(t.exited, newShortLivedProcess(tp, e))
+	}
+}
+
+// drain returns the processes that exited since the last call, along with how
+// many were dropped because there were too many of them.
+func (t *procTracker) drain() ([]*model.ShortLivedProcess, int) {
+	t.Lock()
+	exited, dropped := t.exited, t.dropped
+	t.exited, t.dropped = nil, 0
+	prune := time.Since(t.lastPrune) >= trackerPruneInterval
+	if prune {
+		t.lastPrune = time.Now()
+	}
+	t.Unlock()
+
+	if prune {
+		t.prune()
+	}
+	return exited, dropped
+}
+
+// prune drops the running processes that no longer exist, whose exit event was
+// lost. procfs is read without holding the lock.
+func (t *procTracker) prune() {
+	t.Lock()
+	running := make(map[int32]*trackedProcess, len(t.running))
+	for pid, tp := range t.running {
+		running[pid] = tp
+	}
+	t.Unlock()
+
+	for pid := range running {
+		if t.processExists(pid) {
+			delete(running, pid)
+		}
+	}
+
+	t.Lock()
+	defer t.Unlock()
+	for pid, tp := range running {
+		// Unless the pid was reused by a process tracked in the meantime.
+		if t.running[pid] == tp {
+			delete(t.running, pid)
+		}
+	}
+}
+
+func newShortLivedProcess(tp *trackedProcess, e procEvent) *model.ShortLivedProcess {
+	slp := &model.ShortLivedProcess{
+		Pid: tp.pid,
+		Command: &model.Command{
+			Args: tp.cmdline,
+			Cwd:  tp.cwd,
+			Ppid: tp.ppid,
+			Exe:  tp.exe,
+		},
+		User:        &model.ProcessUser{Name: usernames.get(tp.uid), Uid: tp.uid, Gid: tp.gid},
+		CreateTime:  tp.createTime.UnixNano() / int64(time.Millisecond),
+		ExitTime:    e.time.UnixNano() / int64(time.Millisecond),
+		ContainerId: tp.containerID,
+	}
+	// The low 7 bits are the signal that killed the process, if any, otherwise
+	// the exit code is in the next 8 bits.
+	if sig := e.exitCode & 0x7f; sig != 0 {
+		slp.ExitSignal = int32(sig)
+	} else {
+		slp.ExitCode = int32((e.exitCode >> 8) & 0xff)
+	}
+	return slp
+}
+
+func processExists(pid int32) bool {
+	_, err := os.Stat(util.HostProc(strconv.Itoa(int(pid))))
+	return err == nil
+}
+
+// readTrackedProcess reads the details of a running process from procfs.
+func readTrackedProcess(pid int32) (*trackedProcess, error) {
+	dir := util.HostProc(strconv.Itoa(int(pid)))
+
+	cmdline, err := ioutil.ReadFile(dir + "/cmdline")
+	if err != nil {
+		return nil, err
+	}
+	status, err := os.Open(dir + "/status")
+	if err != nil {
+		return nil, err
+	}
+	defer status.Close()
+
+	tp := &trackedProcess{pid: pid, cmdline: parseCmdline(cmdline)}
+	tp.ppid, tp.uid, tp.gid = parseStatusIDs(status)
+	tp.exe, _ = os.Readlink(dir + "/exe")
+	tp.cwd, _ = os.Readlink(dir + "/cwd")
+	if f, err := os.Open(dir + "/cgroup"); err == nil {
+		tp.containerID = containerIDFromCgroup(f)
+		f.Close()
+	}
+	return tp, nil
+}
+
+// parseCmdline splits the NUL-separated content of /proc/<pid>/cmdline.
+func parseCmdline(data []byte) []string {
+	data = bytes.TrimRight(data, "\x00")
+	if len(data) == 0 {
+		return nil
+	}
+	parts := bytes.Split(data, []byte{0})
+	args := make([]string, 0, len(parts))
+	for _, p := range parts {
+		args = append(args, string(p))
+	}
+	return args
+}
+
+// parseStatusIDs extracts the parent pid and the real uid and gid from the
+// content of /proc/<pid>/status.
+func parseStatusIDs(r io.Reader) (ppid, uid, gid int32) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		v, err := strconv.ParseInt(fields[1], 10, 32)
+		if err != nil {
+			continue
+		}
+		switch fields[0] {
+		case "PPid:":
+			ppid = int32(v)
+		case "Uid:":
+			uid = int32(v)
+		case "Gid:":
+			gid = int32(v)
+		}
+	}
+	return
+}
+
+// containerIDFromCgroup finds the id of the container a process runs in from
+// the content of /proc/<pid>/cgroup: it's the last element of a cgroup path
+// when it looks like a 64 characters hex id, possibly with a prefix and a
+// suffix as set by systemd (e.g. docker-<id>.scope).
+func containerIDFromCgroup(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		i := strings.LastIndexByte(line, '/')
+		if i < 0 {
+			continue
+		}
+		last := strings.TrimSuffix(line[i+1:], ".scope")
+		if j := strings.LastIndexAny(last, "-:"); j >= 0 {
+			last = last[j+1:]
+		}
+		if isContainerID(last) {
+			return last
+		}
+	}
+	return ""
+}
+
+func isContainerID(s string) bool {
+	if len(s) != 64 {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
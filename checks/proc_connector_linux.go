@@ -0,0 +1,151 @@
+// +build linux
+
+package checks
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+	"unsafe"
+
+	log "github.com/cihub/seelog"
+)
+
+// Constants from linux/connector.h and linux/cn_proc.h.
+const (
+	cnIdxProc         = 1
+	cnValProc         = 1
+	procCnMcastListen = 1
+
+	// Size of struct cn_msg, before its payload.
+	cnMsgLen = 20
+	// Size of the header of struct proc_event: what, cpu and timestamp_ns.
+	procEventHeaderLen = 16
+)
+
+// The proc connector uses the host byte order.
+var nativeEndian binary.ByteOrder
+
+func init() {
+	i := uint16(1)
+	if *(*byte)(unsafe.Pointer(&i)) == 1 {
+		nativeEndian = binary.LittleEndian
+	} else {
+		nativeEndian = binary.BigEndian
+	}
+}
+
+// procConnector receives the process events of the kernel proc connector
+// over netlink. Subscribing to it requires CAP_NET_ADMIN.
+type procConnector struct {
+	fd int
+}
+
+// startProcTracker subscribes to the proc connector and starts feeding its
+// events to a new procTracker.
+func startProcTracker() (*procTracker, error) {
+	c, err := newProcConnector()
+	if err != nil {
+		return nil, err
+	}
+	t := newProcTracker()
+	go c.run(t)
+	return t, nil
+}
+
+func newProcConnector() (*procConnector, error) {
+	fd, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_DGRAM|syscall.SOCK_CLOEXEC, syscall.NETLINK_CONNECTOR)
+	if err != nil {
+		return nil, fmt.Errorf("could not create netlink socket: %s", err)
+	}
+	if err := syscall.Bind(fd, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK, Groups: cnIdxProc}); err != nil {
+		syscall.Close(fd)
+		return nil, fmt.Errorf("could not bind netlink socket: %s", err)
+	}
+
+	msg := make([]byte, syscall.NLMSG_HDRLEN+cnMsgLen+4)
+	nativeEndian.PutUint32(msg[0:], uint32(len(msg)))
+	nativeEndian.PutUint16(msg[4:], syscall.NLMSG_DONE)
+	nativeEndian.PutUint32(msg[12:], uint32(os.Getpid()))
+	cn := msg[syscall.NLMSG_HDRLEN:]
+	nativeEndian.PutUint32(cn[0:], cnIdxProc)
+	nativeEndian.PutUint32(cn[4:], cnValProc)
+	nativeEndian.PutUint16(cn[16:], 4)
+	nativeEndian.PutUint32(cn[cnMsgLen:], procCnMcastListen)
+	if err := syscall.Sendto(fd, msg, 0, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		syscall.Close(fd)
+		return nil, fmt.Errorf("could not subscribe to the proc connector: %s", err)
+	}
+	return &procConnector{fd: fd}, nil
+}
+
+func (c *procConnector) run(t *procTracker) {
+	defer syscall.Close(c.fd)
+	buf := make([]byte, os.Getpagesize())
+	for {
+		n, _, err := syscall.Recvfrom(c.fd, buf, 0)
+		if err == syscall.EINTR {
+			continue
+		} else if err == syscall.ENOBUFS {
+			log.Warn("proc connector: events were lost, short-lived processes may be missing")
+			continue
+		} else if err != nil {
+			log.Errorf("proc connector: stopped receiving events: %s", err)
+			return
+		}
+
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			log.Debugf("proc connector: invalid message: %s", err)
+			continue
+		}
+		now := time.Now()
+		for _, m := range msgs {
+			if e, ok := parseProcEvent(m.Data, now); ok {
+				t.handle(e)
+			}
+		}
+	}
+}
+
+// parseProcEvent decodes the cn_msg holding a struct proc_event.
+func parseProcEvent(data []byte, now time.Time) (procEvent, bool) {
+	if len(data) < cnMsgLen+procEventHeaderLen {
+		return procEvent{}, false
+	}
+	if nativeEndian.Uint32(data[0:]) != cnIdxProc || nativeEndian.Uint32(data[4:]) != cnValProc {
+		return procEvent{}, false
+	}
+	ev := data[cnMsgLen:]
+	e := procEvent{what: procEventType(nativeEndian.Uint32(ev[0:])), time: now}
+	body := ev[procEventHeaderLen:]
+	u32 := func(i int) uint32 { return nativeEndian.Uint32(body[i*4:]) }
+
+	switch e.what {
+	case procEventFork:
+		if len(body) < 16 {
+			return procEvent{}, false
+		}
+		e.parentPid, e.pid, e.tgid = int32(u32(1)), int32(u32(2)), int32(u32(3))
+	case procEventExec:
+		if len(body) < 8 {
+			return procEvent{}, false
+		}
+		e.pid, e.tgid = int32(u32(0)), int32(u32(1))
+	case procEventUID:
+		if len(body) < 16 {
+			return procEvent{}, false
+		}
+		e.pid, e.tgid, e.ruid, e.euid = int32(u32(0)), int32(u32(1)), u32(2), u32(3)
+	case procEventExit:
+		if len(body) < 12 {
+			return procEvent{}, false
+		}
+		e.pid, e.tgid, e.exitCode = int32(u32(0)), int32(u32(1)), u32(2)
+	default:
+		return procEvent{}, false
+	}
+	return e, true
+}
@@ -0,0 +1,52 @@
+// +build linux
+
+package checks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeProcEventMsg(what procEventType, body ...uint32) []byte {
+	data := make([]byte, cnMsgLen+procEventHeaderLen+len(body)*4)
+	nativeEndian.PutUint32(data[0:], cnIdxProc)
+	nativeEndian.PutUint32(data[4:], cnValProc)
+	nativeEndian.PutUint32(data[cnMsgLen:], uint32(what))
+	for i, v := range body {
+		nativeEndian.PutUint32(data[cnMsgLen+procEventHeaderLen+i*4:], v)
+	}
+	return data
+}
+
+func TestParseProcEvent(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+
+	e, ok := parseProcEvent(makeProcEventMsg(procEventFork, 1, 1, 42, 42), now)
+	assert.True(ok)
+	assert.Equal(procEvent{what: procEventFork, time: now, pid: 42, tgid: 42, parentPid: 1}, e)
+
+	e, ok = parseProcEvent(makeProcEventMsg(procEventExec, 42, 42), now)
+	assert.True(ok)
+	assert.Equal(procEvent{what: procEventExec, time: now, pid: 42, tgid: 42}, e)
+
+	e, ok = parseProcEvent(makeProcEventMsg(procEventUID, 42, 42, 1000, 0), now)
+	assert.True(ok)
+	assert.Equal(procEvent{what: procEventUID, time: now, pid: 42, tgid: 42, ruid: 1000}, e)
+
+	e, ok = parseProcEvent(makeProcEventMsg(procEventExit, 42, 42, 9, 17), now)
+	assert.True(ok)
+	assert.Equal(procEvent{what: procEventExit, time: now, pid: 42, tgid: 42, exitCode: 9}, e)
+
+	// Unsupported events, truncated and foreign messages.
+	_, ok = parseProcEvent(makeProcEventMsg(0x00000100, 42, 42), now)
+	assert.False(ok)
+	_, ok = parseProcEvent(makeProcEventMsg(procEventFork, 1, 1), now)
+	assert.False(ok)
+	msg := makeProcEventMsg(procEventExec, 42, 42)
+	nativeEndian.PutUint32(msg[0:], 2)
+	_, ok = parseProcEvent(msg, now)
+	assert.False(ok)
+}
@@ -0,0 +1,10 @@
+// +build !linux
+
+package checks
+
+import "errors"
+
+// startProcTracker is only supported on Linux, where the proc connector lives.
+func startProcTracker() (*procTracker, error) {
+	return nil, errors.New("short-lived process collection is only supported on Linux")
+}
@@ -0,0 +1,169 @@
+package checks
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestProcTracker(procs map[int32]*trackedProcess) *procTracker {
+	t := newProcTracker()
+	t.readProcess = func(pid int32) (*trackedProcess, error) {
+		tp, ok := procs[pid]
+		if !ok {
+			return nil, fmt.Errorf("no such process: %d", pid)
+		}
+		cp := *tp
+		return &cp, nil
+	}
+	return t
+}
+
+func TestProcTracker(t *testing.T) {
+	assert := assert.New(t)
+	start := time.Unix(1000, 0)
+	procs := map[int32]*trackedProcess{
+		10: {pid: 10, ppid: 1, cmdline: []string{"sh", "-c", "true"}, uid: 1000, gid: 1000},
+	}
+	tracker := newTestProcTracker(procs)
+
+	tracker.handle(procEvent{what: procEventFork, time: start, pid: 10, tgid: 10, parentPid: 1})
+	// The exec changes the command line, the creation time is kept.
+	procs[10].cmdline = []string{"true"}
+	tracker.handle(procEvent{what: procEventExec, time: start.Add(time.Second), pid: 10, tgid: 10})
+	// Threads are ignored.
+	tracker.handle(procEvent{what: procEventExit, time: start.Add(2 * time.Second), pid: 11, tgid: 10})
+	tracker.handle(procEvent{what: procEventExit, time: start.Add(3 * time.Second), pid: 10, tgid: 10, exitCode: 1 << 8})
+	// Started before the tracker.
+	tracker.handle(procEvent{what: procEventExit, time: start, pid: 20, tgid: 20})
+
+	exited, dropped := tracker.drain()
+	assert.Equal(0, dropped)
+	assert.Len(exited, 1)
+	slp := exited[0]
+	assert.Equal(int32(10), slp.Pid)
+	assert.Equal([]string{"true"}, slp.Command.Args)
+	assert.Equal(int32(1), slp.Command.Ppid)
+	assert.Equal(int32(1000), slp.User.Uid)
+	assert.Equal(int64(1000000), slp.CreateTime)
+	assert.Equal(int64(1003000), slp.ExitTime)
+	assert.Equal(int32(1), slp.ExitCode)
+	assert.Equal(int32(0), slp.ExitSignal)
+	assert.Empty(tracker.running)
+
+	exited, _ = tracker.drain()
+	assert.Empty(exited)
+}
+
+func TestProcTrackerDropsOverflow(t *testing.T) {
+	assert := assert.New(t)
+	procs := make(map[int32]*trackedProcess)
+	for pid := int32(1); pid <= maxExitedProcesses+5; pid++ {
+		procs[pid] = &trackedProcess{pid: pid, cmdline: []string{"true"}}
+	}
+	tracker := newTestProcTracker(procs)
+	for pid := range procs {
+		tracker.handle(procEvent{what: procEventFork, pid: pid, tgid: pid})
+		tracker.handle(procEvent{what: procEventExit, pid: pid, tgid: pid})
+	}
+
+	exited, dropped := tracker.drain()
+	assert.Len(exited, maxExitedProcesses)
+	assert.Equal(5, dropped)
+}
+
+func TestShortLivedProcessExitStatus(t *testing.T) {
+	assert := assert.New(t)
+	tp := &trackedProcess{pid: 1}
+
+	slp := newShortLivedProcess(tp, procEvent{exitCode: 2 << 8})
+	assert.Equal(int32(2), slp.ExitCode)
+	assert.Equal(int32(0), slp.ExitSignal)
+
+	// Killed by SIGKILL, with a core dump flag that must be ignored.
+	slp = newShortLivedProcess(tp, procEvent{exitCode: 0x80 | 9})
+	assert.Equal(int32(0), slp.ExitCode)
+	assert.Equal(int32(9), slp.ExitSignal)
+}
+
+func TestParseCmdline(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{"python", "", "-c"}, parseCmdline([]byte("python\x00\x00-c\x00")))
+	assert.Equal([]string{"sleep", "1"}, parseCmdline([]byte("sleep\x001\x00")))
+	assert.Nil(parseCmdline([]byte("\x00")))
+	assert.Nil(parseCmdline(nil))
+}
+
+func TestParseStatusIDs(t *testing.T) {
+	status := `Name:	sleep
+State:	S (sleeping)
+Tgid:	4242
+Pid:	4242
+PPid:	1337
+Uid:	1000	0	0	0
+Gid:	100	100	100	100
+`
+	ppid, uid, gid := parseStatusIDs(strings.NewReader(status))
+	assert.Equal(t, int32(1337), ppid)
+	assert.Equal(t, int32(1000), uid)
+	assert.Equal(t, int32(100), gid)
+}
+
+func TestContainerIDFromCgroup(t *testing.T) {
+	id := strings.Repeat("0123456789abcdef", 4)
+	for _, tc := range []struct {
+		cgroup   string
+		expected string
+	}{
+		{"12:memory:/docker/" + id + "\n", id},
+		{"1:name=systemd:/system.slice/docker-" + id + ".scope\n", id},
+		{"11:cpu:/kubepods/burstable/pod1234/" + id + "\n", id},
+		{"0::/system.slice/cri-containerd:" + id + "\n", id},
+		{"1:name=systemd:/user.slice/user-1000.slice/session-2.scope\n0::/\n", ""},
+		{"12:memory:/docker/" + id[:63] + "\n", ""},
+	} {
+		assert.Equal(t, tc.expected, containerIDFromCgroup(strings.NewReader(tc.cgroup)), tc.cgroup)
+	}
+}
+
+func TestProcTrackerPrunesLostExits(t *testing.T) {
+	assert := assert.New(t)
+	procs := map[int32]*trackedProcess{
+		10: {pid: 10, cmdline: []string{"sleep"}},
+		11: {pid: 11, cmdline: []string{"sleep"}},
+	}
+	tracker := newTestProcTracker(procs)
+	tracker.processExists = func(pid int32) bool { return pid == 10 }
+	tracker.handle(procEvent{what: procEventFork, pid: 10, tgid: 10})
+	tracker.handle(procEvent{what: procEventFork, pid: 11, tgid: 11})
+
+	// Not pruned before the interval is over.
+	tracker.drain()
+	assert.Len(tracker.running, 2)
+
+	tracker.lastPrune = time.Now().Add(-trackerPruneInterval)
+	tracker.drain()
+	assert.Len(tracker.running, 1)
+	assert.NotNil(tracker.running[10])
+}
+
+func TestProcTrackerCapsRunning(t *testing.T) {
+	assert := assert.New(t)
+	procs := map[int32]*trackedProcess{1: {pid: 1}}
+	tracker := newTestProcTracker(procs)
+	for pid := int32(2); pid < maxTrackedProcesses+2; pid++ {
+		tracker.running[pid] = &trackedProcess{pid: pid}
+	}
+
+	tracker.handle(procEvent{what: procEventFork, pid: 1, tgid: 1})
+	assert.Len(tracker.running, maxTrackedProcesses)
+	assert.Nil(tracker.running[1])
+
+	// Tracked processes are still updated.
+	procs[2] = &trackedProcess{pid: 2, cmdline: []string{"true"}}
+	tracker.handle(procEvent{what: procEventExec, pid: 2, tgid: 2})
+	assert.Equal([]string{"true"}, tracker.running[2].cmdline)
+}
@@ -0,0 +1,272 @@
+// +build linux
+
+package checks
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// TCP states, as in /proc/net/tcp (see include/net/tcp_states.h).
+const (
+	tcpEstablished = 0x01
+	tcpClose       = 0x07
+	tcpListen      = 0x0A
+)
+
+// unixAcceptCon is the flag of the listening unix sockets in /proc/net/unix.
+const unixAcceptCon = 0x10000
+
+// inetSocket is a TCP or UDP socket, as listed in /proc/net/{tcp,udp}{,6}.
+type inetSocket struct {
+	family  model.ConnectionFamily
+	typ     model.ConnectionType
+	laddr   *model.Addr
+	raddr   *model.Addr
+	state   uint8
+	txQueue uint64
+	rxQueue uint64
+	inode   uint64
+}
+
+// unixSocket is a socket listed in /proc/net/unix.
+type unixSocket struct {
+	inode     uint64
+	path      string
+	listening bool
+}
+
+// netNamespaces returns a pid in each network namespace, by inode of the
+// namespace, so that the sockets of the namespace can be read from
+// /proc/<pid>/net. When the namespaces can't be read, the sockets of our own
+// namespace are read from /proc/net, with the pid and namespace 0.
+func netNamespaces() map[uint64]int32 {
+	namespaces := make(map[uint64]int32)
+	for _, pid := range procPids() {
+		link, err := os.Readlink(util.HostProc(strconv.Itoa(int(pid)), "ns", "net"))
+		if err != nil {
+			continue
+		}
+		ns := parseNamespaceInode(link)
+		if ns == 0 {
+			continue
+		}
+		if _, ok := namespaces[ns]; !ok {
+			namespaces[ns] = pid
+		}
+	}
+	if len(namespaces) == 0 {
+		namespaces[0] = 0
+	}
+	return namespaces
+}
+
+// procNetPath returns the path of a file of /proc/<pid>/net, or of /proc/net
+// for pid 0.
+func procNetPath(pid int32, name string) string {
+	if pid == 0 {
+		return util.HostProc("net", name)
+	}
+	return util.HostProc(strconv.Itoa(int(pid)), "net", name)
+}
+
+// readInetSockets reads the TCP and UDP sockets of the network namespace of
+// a process.
+func readInetSockets(pid int32) []inetSocket {
+	var sockets []inetSocket
+	for _, f := range []struct {
+		name   string
+		family model.ConnectionFamily
+		typ    model.ConnectionType
+	}{
+		{"tcp", model.ConnectionFamily_v4, model.ConnectionType_tcp},
+		{"tcp6", model.ConnectionFamily_v6, model.ConnectionType_tcp},
+		{"udp", model.ConnectionFamily_v4, model.ConnectionType_udp},
+		{"udp6", model.ConnectionFamily_v6, model.ConnectionType_udp},
+	} {
+		file, err := os.Open(procNetPath(pid, f.name))
+		if err != nil {
+			// e.g. IPv6 is disabled.
+			continue
+		}
+		sockets = append(sockets, parseInetSockets(file, f.family, f.typ)...)
+		file.Close()
+	}
+	return sockets
+}
+
+// parseInetSockets parses the content of /proc/net/{tcp,udp}{,6}, e.g.
+//   sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+//    0: 0100007F:0CEA 00000000:0000 0A 00000000:00000000 00:00000000 00000000   999        0 20338 1 ...
+func parseInetSockets(r io.Reader, family model.ConnectionFamily, typ model.ConnectionType) []inetSocket {
+	var sockets []inetSocket
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 || !strings.HasSuffix(fields[0], ":") {
+			// The header.
+			continue
+		}
+		laddr, err := parseHexAddr(fields[1])
+		if err != nil {
+			continue
+		}
+		raddr, err := parseHexAddr(fields[2])
+		if err != nil {
+			continue
+		}
+		state, err := strconv.ParseUint(fields[3], 16, 8)
+		if err != nil {
+			continue
+		}
+		queues := strings.SplitN(fields[4], ":", 2)
+		if len(queues) != 2 {
+			continue
+		}
+		tx, _ := strconv.ParseUint(queues[0], 16, 64)
+		rx, _ := strconv.ParseUint(queues[1], 16, 64)
+		inode, err := strconv.ParseUint(fields[9], 10, 64)
+		if err != nil {
+			continue
+		}
+		sockets = append(sockets, inetSocket{
+			family:  family,
+			typ:     typ,
+			laddr:   laddr,
+			raddr:   raddr,
+			state:   uint8(state),
+			txQueue: tx,
+			rxQueue: rx,
+			inode:   inode,
+		})
+	}
+	return sockets
+}
+
+// parseHexAddr parses an address of /proc/net/{tcp,udp}{,6}: the IP as 32-bit
+// words in host byte order, and the port, e.g. "0100007F:0050" for
+// 127.0.0.1:80.
+func parseHexAddr(s string) (*model.Addr, error) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid address %q", s)
+	}
+	raw, err := hex.DecodeString(parts[0])
+	if err != nil || (len(raw) != net.IPv4len && len(raw) != net.IPv6len) {
+		return nil, fmt.Errorf("invalid address %q", s)
+	}
+	port, err := strconv.ParseUint(parts[1], 16, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port %q", s)
+	}
+	ip := make(net.IP, len(raw))
+	for i := 0; i < len(raw); i += 4 {
+		binary.BigEndian.PutUint32(ip[i:], nativeEndian.Uint32(raw[i:]))
+	}
+	return &model.Addr{Ip: ip.String(), Port: int32(port)}, nil
+}
+
+// readUnixSockets reads the unix sockets of the network namespace of a
+// process.
+func readUnixSockets(pid int32) []unixSocket {
+	file, err := os.Open(procNetPath(pid, "unix"))
+	if err != nil {
+		return nil
+	}
+	defer file.Close()
+	return parseUnixSockets(file)
+}
+
+// parseUnixSockets parses the content of /proc/net/unix, e.g.
+//   Num       RefCount Protocol Flags    Type St Inode Path
+//   0000000000000000: 00000002 00000000 00010000 0001 01 20338 /run/systemd/private
+func parseUnixSockets(r io.Reader) []unixSocket {
+	var sockets []unixSocket
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 7 || !strings.HasSuffix(fields[0], ":") {
+			continue
+		}
+		flags, err := strconv.ParseUint(fields[3], 16, 32)
+		if err != nil {
+			continue
+		}
+		inode, err := strconv.ParseUint(fields[6], 10, 64)
+		if err != nil {
+			continue
+		}
+		sockets = append(sockets, unixSocket{
+			inode:     inode,
+			path:      strings.Join(fields[7:], " "),
+			listening: flags&unixAcceptCon != 0,
+		})
+	}
+	return sockets
+}
+
+// socketOwners finds the processes holding some sockets, by inode, from
+// their file descriptors.
+func socketOwners(inodes map[uint64]struct{}) map[uint64][]int32 {
+	owners := make(map[uint64][]int32)
+	if len(inodes) == 0 {
+		return owners
+	}
+	for _, pid := range procPids() {
+		dir := util.HostProc(strconv.Itoa(int(pid)), "fd")
+		fds, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		seen := make(map[uint64]struct{})
+		for _, fd := range fds {
+			link, err := os.Readlink(dir + "/" + fd.Name())
+			if err != nil || !strings.HasPrefix(link, "socket:[") {
+				continue
+			}
+			inode := parseNamespaceInode(link)
+			if _, ok := inodes[inode]; !ok {
+				continue
+			}
+			if _, ok := seen[inode]; ok {
+				continue
+			}
+			seen[inode] = struct{}{}
+			owners[inode] = append(owners[inode], pid)
+		}
+	}
+	return owners
+}
+
+// procPids lists the pids in /proc, in increasing order.
+func procPids() []int32 {
+	d, err := os.Open(util.HostProc())
+	if err != nil {
+		return nil
+	}
+	defer d.Close()
+	names, err := d.Readdirnames(-1)
+	if err != nil {
+		return nil
+	}
+	pids := make([]int32, 0, len(names))
+	for _, name := range names {
+		if pid, err := strconv.ParseInt(name, 10, 32); err == nil {
+			pids = append(pids, int32(pid))
+		}
+	}
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+	return pids
+}
@@ -0,0 +1,72 @@
+// +build linux
+
+package checks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestParseInetSockets(t *testing.T) {
+	tcp := `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100007F:0CEA 00000000:0000 0A 00000000:00000000 00:00000000 00000000   999        0 20338 1 0000000000000000 100 0 0 10 0
+   1: 0F02000A:0016 0202000A:C5B6 01 00000024:00000003 01:00000015 00000000     0        0 61412 4 0000000000000000 20 4 31 10 -1
+`
+	sockets := parseInetSockets(strings.NewReader(tcp), model.ConnectionFamily_v4, model.ConnectionType_tcp)
+	require.Len(t, sockets, 2)
+	assert.Equal(t, inetSocket{
+		family: model.ConnectionFamily_v4,
+		typ:    model.ConnectionType_tcp,
+		laddr:  &model.Addr{Ip: "127.0.0.1", Port: 3306},
+		raddr:  &model.Addr{Ip: "0.0.0.0", Port: 0},
+		state:  tcpListen,
+		inode:  20338,
+	}, sockets[0])
+	assert.Equal(t, &model.Addr{Ip: "10.0.2.15", Port: 22}, sockets[1].laddr)
+	assert.Equal(t, &model.Addr{Ip: "10.0.2.2", Port: 50614}, sockets[1].raddr)
+	assert.Equal(t, uint8(tcpEstablished), sockets[1].state)
+	assert.Equal(t, uint64(0x24), sockets[1].txQueue)
+	assert.Equal(t, uint64(3), sockets[1].rxQueue)
+
+	tcp6 := `  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000000000000000000000000000:0050 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 23456 1 0000000000000000 100 0 0 10 0
+   1: 00000000000000000000000001000000:1F90 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 23457 1 0000000000000000 100 0 0 10 0
+   2: 0000000000000000FFFF00000100007F:1F91 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 23458 1 0000000000000000 100 0 0 10 0
+`
+	sockets = parseInetSockets(strings.NewReader(tcp6), model.ConnectionFamily_v6, model.ConnectionType_tcp)
+	require.Len(t, sockets, 3)
+	assert.Equal(t, &model.Addr{Ip: "::", Port: 80}, sockets[0].laddr)
+	assert.Equal(t, &model.Addr{Ip: "::1", Port: 8080}, sockets[1].laddr)
+	assert.Equal(t, &model.Addr{Ip: "127.0.0.1", Port: 8081}, sockets[2].laddr)
+}
+
+func TestParseHexAddr(t *testing.T) {
+	_, err := parseHexAddr("0100007F")
+	assert.Error(t, err)
+	_, err = parseHexAddr("01007F:0050")
+	assert.Error(t, err)
+	_, err = parseHexAddr("0100007F:ZZ")
+	assert.Error(t, err)
+}
+
+func TestParseUnixSockets(t *testing.T) {
+	unix := `Num       RefCount Protocol Flags    Type St Inode Path
+0000000000000000: 00000002 00000000 00010000 0001 01 20338 /run/systemd/private
+0000000000000000: 00000002 00000000 00010000 0001 01 20339 @/tmp/.X11-unix/X0
+0000000000000000: 00000003 00000000 00000000 0001 03 20340 /run/systemd/journal/stdout
+0000000000000000: 00000003 00000000 00000000 0001 03 20341
+0000000000000000: 00000002 00000000 00010000 0001 01 20342 /tmp/with space.sock
+`
+	assert.Equal(t, []unixSocket{
+		{inode: 20338, path: "/run/systemd/private", listening: true},
+		{inode: 20339, path: "@/tmp/.X11-unix/X0", listening: true},
+		{inode: 20340, path: "/run/systemd/journal/stdout"},
+		{inode: 20341},
+		{inode: 20342, path: "/tmp/with space.sock", listening: true},
+	}, parseUnixSockets(strings.NewReader(unix)))
+}
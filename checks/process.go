@@ -29,11 +29,58 @@ type ProcessCheck struct {
 	lastProcs    map[int32]*process.FilledProcess
 	lastCtrRates map[string]util.ContainerRateMetrics
 	lastRun      time.Time
+
+	// Optional, follows the processes exiting between two runs.
+	procTracker *procTracker
+	// Pids of the processes sent in the last run.
+	lastReported map[int32]struct{}
+	// The processes of the last run that reported them, for the ProcessEventsCheck.
+	snapshot *processSnapshot
+	// The namespaces and cgroups of processes.
+	isolation *isolationCache
+	// The parents the processes were first seen with.
+	parents *parentHistory
+	// Optional, breaks down the CPU usage by CPU.
+	perCPU *perCPUTracker
+	// Optional, reads PSS, USS and the like for a few processes per run.
+	memDetails *memoryDetailsCollector
+	// Optional, reads scheduling latency and delays.
+	schedStats *schedStatsCollector
+	// Optional, detects the runtime and service of processes.
+	services *serviceDetector
+	// Optional, fingerprints the executables of processes.
+	fingerprints *exeFingerprinter
 }
 
 // Init initializes the singleton ProcessCheck.
 func (p *ProcessCheck) Init(cfg *config.AgentConfig, info *model.SystemInfo) {
 	p.sysInfo = info
+	p.isolation = newIsolationCache()
+	p.parents = newParentHistory()
+	if cfg.CollectPerCPUStats {
+		p.perCPU = newPerCPUTracker()
+	}
+	if cfg.CollectMemoryDetails {
+		p.memDetails = newMemoryDetailsCollector(cfg.MemoryDetailsBudget)
+	}
+	if cfg.CollectSchedStats {
+		p.schedStats = newSchedStatsCollector()
+	}
+	if cfg.DetectServices {
+		p.services = newServiceDetector()
+	}
+	if cfg.CollectExeFingerprints {
+		p.fingerprints = newExeFingerprinter()
+	}
+
+	if cfg.CollectShortLivedProcesses && p.procTracker == nil {
+		t, err := startProcTracker()
+		if err != nil {
+			log.Errorf("Unable to collect short-lived processes: %s", err)
+			return
+		}
+		p.procTracker = t
+	}
 }
 
 // Name returns the name of the ProcessCheck.
@@ -45,6 +92,22 @@ func (p *ProcessCheck) Endpoint() string { return "/api/v1/collector" }
 // RealTime indicates if this check only runs in real-time mode.
 func (p *ProcessCheck) RealTime() bool { return false }
 
+// processSnapshot is the processes read by a run of the ProcessCheck, with
+// their supplementary groups. It must not be modified.
+type processSnapshot struct {
+	procs  map[int32]*process.FilledProcess
+	groups map[int32][]int32
+	time   time.Time
+}
+
+// lastSnapshot returns the processes of the last run that reported them, after
+// their command lines were scrubbed, or nil.
+func (p *ProcessCheck) lastSnapshot() *processSnapshot {
+	p.Lock()
+	defer p.Unlock()
+	return p.snapshot
+}
+
 // Run runs the ProcessCheck to collect a list of running processes and relevant
 // stats for each. On most POSIX systems this will use a mix of procfs and other
 // OS-specific APIs to collect this information. The bulk of this collection is
@@ -65,10 +128,25 @@ func (p *ProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Mess
 	if err != nil {
 		return nil, err
 	}
+	groups := readAllGroups(procs)
 	ctrList, _ := util.GetContainers()
+	if p.perCPU != nil {
+		p.perCPU.update(cfg, procs)
+	}
+	if p.schedStats != nil {
+		p.schedStats.update(cfg, procs, time.Now())
+	}
+	if p.services != nil {
+		p.services.update(cfg, procs)
+	}
+	reparented := p.parents.update(procs)
 
 	// End check early if this is our first run.
 	if p.lastProcs == nil {
+		// Processes that exited so far were running before this run, they're not short-lived.
+		if p.procTracker != nil {
+			p.procTracker.drain()
+		}
 		p.lastProcs = procs
 		p.lastCPUTime = cpuTimes[0]
 		p.lastCtrRates = util.ExtractContainerRateMetric(ctrList)
@@ -76,7 +154,7 @@ func (p *ProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Mess
 		return nil, nil
 	}
 
-	chunkedProcs := fmtProcesses(cfg, procs, p.lastProcs,
+	chunkedProcs := fmtProcesses(cfg, procs, groups, p.isolation.update(procs), reparented, p.lastProcs,
 		ctrList, cpuTimes[0], p.lastCPUTime, p.lastRun)
 	// In case we skip every process..
 	if len(chunkedProcs) == 0 {
@@ -84,6 +162,7 @@ func (p *ProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Mess
 	}
 	groupSize := len(chunkedProcs)
 	chunkedContainers := fmtContainers(ctrList, p.lastCtrRates, p.lastRun, groupSize)
+	shortLived := p.shortLivedProcesses(cfg)
 	messages := make([]model.MessageBody, 0, groupSize)
 	totalProcs, totalContainers := float64(0), float64(0)
 	for i := 0; i < groupSize; i++ {
@@ -98,6 +177,48 @@ func (p *ProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Mess
 			GroupSize:  int32(groupSize),
 		})
 	}
+	if len(shortLived) > 0 {
+		messages[0].(*model.CollectorProc).ShortLivedProcesses = shortLived
+	}
+	if p.schedStats != nil {
+		for _, chunk := range chunkedProcs {
+			for _, proc := range chunk {
+				proc.SchedStat = p.schedStats.get(proc.Pid)
+			}
+		}
+	}
+	if p.services != nil {
+		for _, chunk := range chunkedProcs {
+			for _, proc := range chunk {
+				proc.Tags = p.services.tags(proc.Pid)
+			}
+		}
+	}
+	if p.fingerprints != nil {
+		p.fingerprints.update(cfg, procs)
+		for _, chunk := range chunkedProcs {
+			for _, proc := range chunk {
+				proc.Command.Fingerprint = p.fingerprints.get(proc.Pid)
+			}
+		}
+	}
+	if p.memDetails != nil {
+		p.memDetails.update(cfg, procs)
+		for _, chunk := range chunkedProcs {
+			for _, proc := range chunk {
+				p.memDetails.fill(proc.Pid, proc.Memory)
+			}
+		}
+	}
+	if p.perCPU != nil {
+		for _, chunk := range chunkedProcs {
+			for _, proc := range chunk {
+				p.perCPU.fill(proc.Pid, proc.Cpu)
+			}
+		}
+		// Host-wide, only sent in the first message of the group.
+		messages[0].(*model.CollectorProc).HostCpus = p.perCPU.hostCPUs
+	}
 
 	// Store the last state for comparison on the next run.
 	// Note: not storing the filtered in case there are new processes that haven't had a chance to show up twice.
@@ -105,6 +226,13 @@ func (p *ProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Mess
 	p.lastCtrRates = util.ExtractContainerRateMetric(ctrList)
 	p.lastCPUTime = cpuTimes[0]
 	p.lastRun = time.Now()
+	p.snapshot = &processSnapshot{procs: procs, groups: groups, time: p.lastRun}
+	p.lastReported = make(map[int32]struct{}, int(totalProcs))
+	for _, chunk := range chunkedProcs {
+		for _, proc := range chunk {
+			p.lastReported[proc.Pid] = struct{}{}
+		}
+	}
 
 	statsd.Client.Gauge("datadog.process.containers.host_count", totalContainers, []string{}, 1)
 	statsd.Client.Gauge("datadog.process.processes.host_count", totalProcs, []string{}, 1)
@@ -112,9 +240,40 @@ func (p *ProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Mess
 	return messages, nil
 }
 
+// shortLivedProcesses returns the processes that exited since the last run
+// without ever being reported, with the same filtering and scrubbing as the
+// other processes.
+func (p *ProcessCheck) shortLivedProcesses(cfg *config.AgentConfig) []*model.ShortLivedProcess {
+	if p.procTracker == nil {
+		return nil
+	}
+	exited, dropped := p.procTracker.drain()
+	if dropped > 0 {
+		log.Warnf("Dropped %d short-lived processes, too many processes exited since the last run", dropped)
+	}
+
+	shortLived := make([]*model.ShortLivedProcess, 0, len(exited))
+	for _, slp := range exited {
+		// Processes that lived long enough to be sent in the last run aren't short-lived.
+		if _, ok := p.lastReported[slp.Pid]; ok {
+			continue
+		}
+		if len(slp.Command.Args) == 0 || config.IsBlacklisted(slp.Command.Args, cfg.Blacklist) {
+			continue
+		}
+		slp.Command.Args = cfg.Scrubber.ScrubCommand(slp.Command.Args)
+		shortLived = append(shortLived, slp)
+	}
+	return shortLived
+}
+
 func fmtProcesses(
 	cfg *config.AgentConfig,
-	procs, lastProcs map[int32]*process.FilledProcess,
+	procs map[int32]*process.FilledProcess,
+	groups map[int32][]int32,
+	isolation map[int32]*procIsolation,
+	reparented map[int32]bool,
+	lastProcs map[int32]*process.FilledProcess,
 	ctrList []*containers.Container,
 	syst2, syst1 cpu.TimesStat,
 	lastRun time.Time,
@@ -135,11 +294,17 @@ func fmtProcesses(
 
 		// Hide blacklisted args if the Scrubber is enabled
 		fp.Cmdline = cfg.Scrubber.ScrubProcessCommand(fp)
+		limits := readLimits(fp.Pid)
+		iso := isolation[fp.Pid]
+		if iso == nil {
+			iso = &procIsolation{}
+		}
+		env, envTruncated := formatEnv(cfg, fp.Pid)
 
 		chunk = append(chunk, &model.Process{
 			Pid:                    fp.Pid,
 			Command:                formatCommand(fp),
-			User:                   formatUser(fp),
+			User:                   formatUser(fp, groups[fp.Pid]),
 			Memory:                 formatMemory(fp),
 			Cpu:                    formatCPU(fp, fp.CpuTime, lastProcs[fp.Pid].CpuTime, syst2, syst1),
 			CreateTime:             fp.CreateTime,
@@ -149,6 +314,16 @@ func fmtProcesses(
 			VoluntaryCtxSwitches:   uint64(fp.CtxSwitches.Voluntary),
 			InvoluntaryCtxSwitches: uint64(fp.CtxSwitches.Involuntary),
 			ContainerId:            cidByPid[fp.Pid],
+			Limits:                 limits,
+			FdUtilizationPct:       fdUtilizationPct(fp.OpenFdCount, limits),
+			FdStat:                 formatFdStat(cfg, fp.Pid),
+			Namespaces:             iso.namespaces,
+			Cgroup:                 iso.cgroup,
+			Security:               formatSecurity(cfg, fp.Pid),
+			Systemd:                systemdUnit(iso.systemdCgroup),
+			Reparented:             reparented[fp.Pid],
+			Env:                    env,
+			EnvTruncated:           envTruncated,
 		})
 		if len(chunk) == cfg.MaxPerMessage {
 			chunked = append(chunked, chunk)
@@ -163,14 +338,14 @@ func fmtProcesses(
 }
 
 func formatCommand(fp *process.FilledProcess) *model.Command {
-	return &model.Command{
-		Args:   fp.Cmdline,
-		Cwd:    fp.Cwd,
-		Root:   "",    // TODO
-		OnDisk: false, // TODO
-		Ppid:   fp.Ppid,
-		Exe:    fp.Exe,
+	cmd := &model.Command{
+		Args: fp.Cmdline,
+		Cwd:  fp.Cwd,
+		Ppid: fp.Ppid,
+		Exe:  fp.Exe,
 	}
+	fillCommandDetails(fp.Pid, cmd)
+	return cmd
 }
 
 func formatIO(fp *process.FilledProcess, lastIO *process.IOCountersStat, before time.Time) *model.IOStat {
@@ -244,12 +419,25 @@ func skipProcess(
 	}
 	if _, ok := lastProcs[fp.Pid]; !ok {
 		// Skipping any processes that didn't exist in the previous run.
-		// This means short-lived processes (<2s) will never be captured.
+		// This means short-lived processes (<2s) will never be captured here,
+		// see collect_short_lived_processes.
 		return true
 	}
 	return false
 }
 
+// readAllGroups reads the supplementary groups of the processes of a
+// snapshot, which gopsutil doesn't, so that they can be kept with it.
+func readAllGroups(procs map[int32]*process.FilledProcess) map[int32][]int32 {
+	groups := make(map[int32][]int32, len(procs))
+	for pid := range procs {
+		if g := readGroups(pid); g != nil {
+			groups[pid] = g
+		}
+	}
+	return groups
+}
+
 func (p *ProcessCheck) createTimesforPIDs(pids []uint32) map[uint32]int64 {
 	p.Lock()
 	defer p.Unlock()
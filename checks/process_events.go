@@ -0,0 +1,290 @@
+package checks
+
+import (
+	"time"
+
+	"github.com/DataDog/gopsutil/process"
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// ProcessEvents is a singleton ProcessEventsCheck.
+var ProcessEvents = &ProcessEventsCheck{}
+
+// ProcessEventsCheck reports the processes that started, exited, exec'ed a new
+// program or changed user between two runs, so that what ran on a host can be
+// audited over time. The instance stores the processes of the last run.
+//
+// When the ProcessCheck is enabled the processes it read are reused, rather
+// than walking procfs a second time, so events are at most as frequent as its
+// runs.
+type ProcessEventsCheck struct {
+	// Optional, the check whose processes are reused.
+	processCheck *ProcessCheck
+	lastSnapshot time.Time
+
+	lastProcs map[int32]*process.FilledProcess
+	// The supplementary groups of lastProcs, as they were then.
+	lastGroups map[int32][]int32
+	// Optional, the pids of the processes killed by the OOM killer.
+	oomKills *oomKillWatcher
+}
+
+// Init initializes the singleton ProcessEventsCheck.
+func (e *ProcessEventsCheck) Init(cfg *config.AgentConfig, info *model.SystemInfo) {
+	if cfg.CheckIsEnabled(Process.Name()) {
+		e.processCheck = Process
+	}
+	if e.oomKills != nil {
+		return
+	}
+	w, err := newOOMKillWatcher()
+	if err != nil {
+		log.Warnf("Unable to detect OOM killed processes: %s", err)
+		return
+	}
+	e.oomKills = w
+}
+
+// Name returns the name of the ProcessEventsCheck.
+func (e *ProcessEventsCheck) Name() string { return "process_events" }
+
+// Endpoint returns the endpoint where this check is submitted.
+func (e *ProcessEventsCheck) Endpoint() string { return "/api/v1/process_events" }
+
+// RealTime indicates if this check only runs in real-time mode.
+func (e *ProcessEventsCheck) RealTime() bool { return false }
+
+// Run runs the ProcessEventsCheck to compare the running processes with the
+// ones of the last run. Events are split up into chunks of at most
+// cfg.MaxPerMessage events per message.
+func (e *ProcessEventsCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.MessageBody, error) {
+	procs, groups, err := e.processes(cfg)
+	if err != nil || procs == nil {
+		return nil, err
+	}
+	var oomKilled map[int32]struct{}
+	if e.oomKills != nil {
+		oomKilled = e.oomKills.read()
+	}
+
+	// End check early if this is our first run.
+	if e.lastProcs == nil {
+		e.lastProcs, e.lastGroups = procs, groups
+		return nil, nil
+	}
+
+	ctrList, _ := util.GetContainers()
+	cidByPid := make(map[int32]string, len(ctrList))
+	for _, c := range ctrList {
+		for _, p := range c.Pids {
+			cidByPid[p] = c.ID
+		}
+	}
+
+	events := diffProcesses(cfg, procs, groups, e.lastProcs, e.lastGroups, oomKilled, time.Now())
+	for _, ev := range events {
+		ev.ContainerId = cidByPid[ev.Pid]
+	}
+	e.lastProcs, e.lastGroups = procs, groups
+
+	chunked := chunkProcessEvents(events, cfg.MaxPerMessage)
+	messages := make([]model.MessageBody, 0, len(chunked))
+	for _, c := range chunked {
+		messages = append(messages, &model.CollectorProcEvent{
+			HostName:  cfg.HostName,
+			Events:    c,
+			GroupId:   groupID,
+			GroupSize: int32(len(chunked)),
+		})
+	}
+	return messages, nil
+}
+
+// processes returns the running processes and their groups, or nil if the
+// ProcessCheck didn't run since the last call.
+func (e *ProcessEventsCheck) processes(cfg *config.AgentConfig) (map[int32]*process.FilledProcess, map[int32][]int32, error) {
+	if e.processCheck == nil {
+		procs, err := getAllProcesses(cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		return procs, readAllGroups(procs), nil
+	}
+	s := e.processCheck.lastSnapshot()
+	if s == nil || !s.time.After(e.lastSnapshot) {
+		return nil, nil, nil
+	}
+	e.lastSnapshot = s.time
+	return s.procs, s.groups, nil
+}
+
+// diffProcesses returns the events that explain the difference between the
+// processes of the last run and the current ones. Processes are described with
+// the groups read along with them, not with the groups of whatever process has
+// their pid now.
+func diffProcesses(
+	cfg *config.AgentConfig,
+	procs map[int32]*process.FilledProcess,
+	groups map[int32][]int32,
+	lastProcs map[int32]*process.FilledProcess,
+	lastGroups map[int32][]int32,
+	oomKilled map[int32]struct{},
+	now time.Time,
+) []*model.ProcessEvent {
+	ts := now.UnixNano() / int64(time.Millisecond)
+	events := make([]*model.ProcessEvent, 0)
+	for pid, fp := range procs {
+		last, ok := lastProcs[pid]
+		if ok && last.CreateTime != fp.CreateTime {
+			// The pid was reused, the process we knew about is gone.
+			if ev := exitEvent(cfg, last, lastGroups[pid], oomKilled, ts); ev != nil {
+				events = append(events, ev)
+			}
+			ok = false
+		}
+		if ok && fp.Status == "Z" {
+			// What's left of a zombie can't be compared, its exit is what matters.
+			if last.Status != "Z" && !skipEventProcess(cfg, last) {
+				ev := newProcessEvent(cfg, model.ProcessEventType_exit, last, lastGroups[pid], ts)
+				ev.Zombie = true
+				if status, ok := readExitStatus(pid); ok {
+					setExitStatus(ev, status)
+				}
+				events = append(events, ev)
+			}
+			continue
+		}
+		if skipEventProcess(cfg, fp) {
+			continue
+		}
+		if !ok {
+			events = append(events, newProcessEvent(cfg, model.ProcessEventType_start, fp, groups[pid], fp.CreateTime))
+			continue
+		}
+
+		if execChanged(last, fp) {
+			ev := newProcessEvent(cfg, model.ProcessEventType_exec, fp, groups[pid], ts)
+			ev.PreviousCommand = eventCommand(cfg, last)
+			events = append(events, ev)
+		}
+		if userChanged(last, fp) {
+			ev := newProcessEvent(cfg, model.ProcessEventType_userChange, fp, groups[pid], ts)
+			ev.PreviousUser = formatUser(last, lastGroups[pid])
+			events = append(events, ev)
+		}
+	}
+	for pid, last := range lastProcs {
+		if _, ok := procs[pid]; ok {
+			continue
+		}
+		if ev := exitEvent(cfg, last, lastGroups[pid], oomKilled, ts); ev != nil {
+			events = append(events, ev)
+		}
+	}
+	return events
+}
+
+// exitEvent returns the event for a process that is gone, if it should be
+// reported.
+func exitEvent(
+	cfg *config.AgentConfig,
+	last *process.FilledProcess,
+	groups []int32,
+	oomKilled map[int32]struct{},
+	ts int64,
+) *model.ProcessEvent {
+	// Zombies had their exit reported already.
+	if last.Status == "Z" || skipEventProcess(cfg, last) {
+		return nil
+	}
+	ev := newProcessEvent(cfg, model.ProcessEventType_exit, last, groups, ts)
+	if _, ok := oomKilled[last.Pid]; ok {
+		ev.OomKilled = true
+		ev.ExitStatusKnown = true
+		ev.ExitSignal = 9 // SIGKILL
+	}
+	return ev
+}
+
+func skipEventProcess(cfg *config.AgentConfig, fp *process.FilledProcess) bool {
+	return len(fp.Cmdline) == 0 || config.IsBlacklisted(fp.Cmdline, cfg.Blacklist)
+}
+
+func newProcessEvent(cfg *config.AgentConfig, t model.ProcessEventType, fp *process.FilledProcess, groups []int32, ts int64) *model.ProcessEvent {
+	return &model.ProcessEvent{
+		Type:       t,
+		Timestamp:  ts,
+		Pid:        fp.Pid,
+		CreateTime: fp.CreateTime,
+		Command:    eventCommand(cfg, fp),
+		User:       formatUser(fp, groups),
+	}
+}
+
+// eventCommand formats the command of a process without altering the
+// process, which is kept for the next run.
+func eventCommand(cfg *config.AgentConfig, fp *process.FilledProcess) *model.Command {
+	return &model.Command{
+		Args: cfg.Scrubber.ScrubCommand(fp.Cmdline),
+		Cwd:  fp.Cwd,
+		Ppid: fp.Ppid,
+		Exe:  fp.Exe,
+	}
+}
+
+// setExitStatus decodes a wait status, as returned by wait(2).
+func setExitStatus(ev *model.ProcessEvent, status uint32) {
+	ev.ExitStatusKnown = true
+	if sig := status & 0x7f; sig != 0 {
+		ev.ExitSignal = int32(sig)
+	} else {
+		ev.ExitCode = int32((status >> 8) & 0xff)
+	}
+}
+
+// execChanged tells if a process exec'ed a new program, from its executable
+// or, when we can't read it, its command line.
+func execChanged(last, fp *process.FilledProcess) bool {
+	if last.Exe != "" || fp.Exe != "" {
+		return last.Exe != fp.Exe
+	}
+	return !stringSlicesEqual(last.Cmdline, fp.Cmdline)
+}
+
+// userChanged tells if the real or effective uid of a process changed.
+func userChanged(last, fp *process.FilledProcess) bool {
+	for i := 0; i < 2; i++ {
+		if i < len(last.Uids) && i < len(fp.Uids) && last.Uids[i] != fp.Uids[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func chunkProcessEvents(events []*model.ProcessEvent, perChunk int) [][]*model.ProcessEvent {
+	chunked := make([][]*model.ProcessEvent, 0, len(events)/perChunk+1)
+	for len(events) > perChunk {
+		chunked = append(chunked, events[:perChunk])
+		events = events[perChunk:]
+	}
+	if len(events) > 0 {
+		chunked = append(chunked, events)
+	}
+	return chunked
+}
@@ -0,0 +1,103 @@
+// +build linux
+
+package checks
+
+import (
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// Matches the kernel log line of an OOM kill, from the global or a cgroup OOM
+// killer, e.g. "Out of memory: Killed process 4242 (java) total-vm:...".
+var oomKillPattern = regexp.MustCompile(`Killed process (\d+) \(`)
+
+// oomKillWatcher reads the kernel log to find the processes killed by the
+// OOM killer.
+type oomKillWatcher struct {
+	kmsg *os.File
+	buf  []byte
+}
+
+func newOOMKillWatcher() (*oomKillWatcher, error) {
+	f, err := os.OpenFile("/dev/kmsg", os.O_RDONLY|syscall.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, err
+	}
+	// Only the kills happening from now on are of interest.
+	if _, err := f.Seek(0, os.SEEK_END); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &oomKillWatcher{kmsg: f, buf: make([]byte, 8192)}, nil
+}
+
+// read returns the pids of the processes killed since the last call.
+func (w *oomKillWatcher) read() map[int32]struct{} {
+	killed := make(map[int32]struct{})
+	for {
+		// Each read returns a single record.
+		n, err := w.kmsg.Read(w.buf)
+		if err != nil {
+			if pe, ok := err.(*os.PathError); ok && pe.Err == syscall.EPIPE {
+				// Records were overwritten before we could read them.
+				continue
+			}
+			if pe, ok := err.(*os.PathError); !ok || pe.Err != syscall.EAGAIN {
+				log.Debugf("Unable to read the kernel log: %s", err)
+			}
+			return killed
+		}
+		if pid, ok := parseOOMKill(string(w.buf[:n])); ok {
+			killed[pid] = struct{}{}
+		}
+	}
+}
+
+// parseOOMKill returns the pid of the process killed by the OOM killer, if
+// the /dev/kmsg record is about one.
+func parseOOMKill(record string) (int32, bool) {
+	// The message follows the "<priority>,<sequence>,<timestamp>,<flags>;" prefix.
+	if i := strings.IndexByte(record, ';'); i >= 0 {
+		record = record[i+1:]
+	}
+	m := oomKillPattern.FindStringSubmatch(record)
+	if m == nil {
+		return 0, false
+	}
+	pid, err := strconv.ParseInt(m[1], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(pid), true
+}
+
+// readExitStatus returns the wait status of an exited (zombie) process.
+func readExitStatus(pid int32) (uint32, bool) {
+	data, err := ioutil.ReadFile(util.HostProc(strconv.Itoa(int(pid)), "stat"))
+	if err != nil {
+		return 0, false
+	}
+	return parseStatExitCode(string(data))
+}
+
+// parseStatExitCode extracts the exit_code field (the 52nd) of the content of
+// /proc/<pid>/stat, available since Linux 3.5.
+func parseStatExitCode(stat string) (uint32, bool) {
+	fields := statFields(stat)
+	if len(fields) < 52-2 {
+		return 0, false
+	}
+	code, err := strconv.ParseUint(fields[52-3], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(code), true
+}
@@ -0,0 +1,46 @@
+// +build linux
+
+package checks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOOMKill(t *testing.T) {
+	assert := assert.New(t)
+	for _, tc := range []struct {
+		record string
+		pid    int32
+		ok     bool
+	}{
+		{"3,1234,5678901,-;Out of memory: Killed process 4242 (java) total-vm:1234kB, anon-rss:1000kB\n", 4242, true},
+		{"3,1235,5678902,-;Memory cgroup out of memory: Killed process 31 (stress (1)) total-vm:10kB\n", 31, true},
+		{"3,1236,5678903,-;Killed process 7 (node), UID 1000, total-vm:10kB\n", 7, true},
+		{"6,1237,5678904,-;Out of memory: Kill process 4242 (java) score 900 or sacrifice child\n", 0, false},
+		{"6,1238,5678905,-;eth0: link up\n", 0, false},
+	} {
+		pid, ok := parseOOMKill(tc.record)
+		assert.Equal(tc.ok, ok, tc.record)
+		assert.Equal(tc.pid, pid, tc.record)
+	}
+}
+
+func TestParseStatExitCode(t *testing.T) {
+	assert := assert.New(t)
+	fields := make([]string, 50)
+	for i := range fields {
+		fields[i] = "0"
+	}
+	fields[0] = "Z"
+	fields[49] = "256"
+	code, ok := parseStatExitCode("42 (my (odd) cmd) " + strings.Join(fields, " ") + "\n")
+	assert.True(ok)
+	assert.Equal(uint32(256), code)
+
+	// Kernels older than 3.5.
+	_, ok = parseStatExitCode("42 (cmd) " + strings.Join(fields[:44], " ") + "\n")
+	assert.False(ok)
+}
@@ -0,0 +1,16 @@
+// +build !linux
+
+package checks
+
+import "errors"
+
+// oomKillWatcher is only supported on Linux.
+type oomKillWatcher struct{}
+
+func newOOMKillWatcher() (*oomKillWatcher, error) {
+	return nil, errors.New("OOM kill detection is only supported on Linux")
+}
+
+func (w *oomKillWatcher) read() map[int32]struct{} { return nil }
+
+func readExitStatus(pid int32) (uint32, bool) { return 0, false }
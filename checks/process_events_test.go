@@ -0,0 +1,194 @@
+package checks
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func makeEventProcess(pid int32, createTime int64, exe, cmdline string, uid int32, status string) *process.FilledProcess {
+	fp := makeProcess(pid, cmdline)
+	fp.CreateTime = createTime
+	fp.Exe = exe
+	fp.Uids = []int32{uid, uid, uid, uid}
+	fp.Status = status
+	return fp
+}
+
+func indexEvents(events []*model.ProcessEvent) map[int32][]*model.ProcessEvent {
+	byPid := make(map[int32][]*model.ProcessEvent)
+	for _, ev := range events {
+		byPid[ev.Pid] = append(byPid[ev.Pid], ev)
+	}
+	return byPid
+}
+
+func TestDiffProcesses(t *testing.T) {
+	assert := assert.New(t)
+	cfg := config.NewDefaultAgentConfig()
+	cfg.Blacklist = []*regexp.Regexp{regexp.MustCompile("secret-agent")}
+	now := time.Unix(2000, 0)
+
+	last := map[int32]*process.FilledProcess{
+		1: makeEventProcess(1, 100, "/sbin/init", "init", 0, "S"),
+		2: makeEventProcess(2, 200, "/bin/bash", "bash run.sh", 1000, "S"),
+		3: makeEventProcess(3, 300, "/usr/bin/sudo", "sudo make install", 1000, "S"),
+		4: makeEventProcess(4, 400, "/usr/bin/python", "python job.py", 1000, "S"),
+		5: makeEventProcess(5, 500, "/usr/bin/java", "java -jar app.jar", 1000, "S"),
+		6: makeEventProcess(6, 600, "/usr/bin/make", "make", 1000, "S"),
+		7: makeEventProcess(7, 700, "/usr/bin/secret-agent", "secret-agent", 0, "S"),
+	}
+	cur := map[int32]*process.FilledProcess{
+		1: makeEventProcess(1, 100, "/sbin/init", "init", 0, "S"),
+		// Exec'ed a new program.
+		2: makeEventProcess(2, 200, "/usr/bin/curl", "curl http://example.com", 1000, "S"),
+		// Became root.
+		3: makeEventProcess(3, 300, "/usr/bin/sudo", "sudo make install", 0, "S"),
+		// 4 exited and its pid was reused.
+		4: makeEventProcess(4, 1500, "/bin/ls", "ls -l", 1000, "S"),
+		// 5 exited, killed by the OOM killer.
+		// 6 exited but wasn't reaped yet.
+		6: makeEventProcess(6, 600, "", "", 1000, "Z"),
+		// 7 is blacklisted.
+		8: makeEventProcess(8, 1800, "/usr/bin/secret-agent", "secret-agent --new", 0, "S"),
+		9: makeEventProcess(9, 1900, "/bin/sleep", "sleep 10", 1000, "S"),
+	}
+
+	// The groups of the processes as they were read with each snapshot.
+	lastGroups := map[int32][]int32{3: {1000, 27}, 4: {1000, 4}, 6: {1000}}
+	groups := map[int32][]int32{3: {0}, 4: {1000}}
+
+	byPid := indexEvents(diffProcesses(cfg, cur, groups, last, lastGroups, map[int32]struct{}{5: {}}, now))
+	assert.Len(byPid, 6)
+	assert.Empty(byPid[1])
+
+	if assert.Len(byPid[2], 1) {
+		ev := byPid[2][0]
+		assert.Equal(model.ProcessEventType_exec, ev.Type)
+		assert.Equal(int64(2000000), ev.Timestamp)
+		assert.Equal("/usr/bin/curl", ev.Command.Exe)
+		assert.Equal("/bin/bash", ev.PreviousCommand.Exe)
+		assert.Equal([]string{"bash", "run.sh"}, ev.PreviousCommand.Args)
+	}
+	if assert.Len(byPid[3], 1) {
+		ev := byPid[3][0]
+		assert.Equal(model.ProcessEventType_userChange, ev.Type)
+		assert.Equal(int32(0), ev.User.Uid)
+		assert.Equal(int32(1000), ev.PreviousUser.Uid)
+		assert.Equal([]int32{0}, ev.User.Groups)
+		assert.Equal([]int32{1000, 27}, ev.PreviousUser.Groups)
+	}
+	if assert.Len(byPid[4], 2) {
+		exit, start := byPid[4][0], byPid[4][1]
+		assert.Equal(model.ProcessEventType_exit, exit.Type)
+		assert.Equal(int64(400), exit.CreateTime)
+		assert.Equal([]int32{1000, 4}, exit.User.Groups)
+		assert.Equal(model.ProcessEventType_start, start.Type)
+		assert.Equal([]int32{1000}, start.User.Groups)
+		assert.Equal(int64(1500), start.Timestamp)
+	}
+	if assert.Len(byPid[5], 1) {
+		ev := byPid[5][0]
+		assert.Equal(model.ProcessEventType_exit, ev.Type)
+		assert.True(ev.OomKilled)
+		assert.True(ev.ExitStatusKnown)
+		assert.Equal(int32(9), ev.ExitSignal)
+	}
+	if assert.Len(byPid[6], 1) {
+		ev := byPid[6][0]
+		assert.Equal(model.ProcessEventType_exit, ev.Type)
+		assert.True(ev.Zombie)
+		assert.False(ev.OomKilled)
+		assert.Equal([]string{"make"}, ev.Command.Args)
+		assert.Equal([]int32{1000}, ev.User.Groups)
+	}
+	assert.Empty(byPid[7])
+	assert.Empty(byPid[8])
+	if assert.Len(byPid[9], 1) {
+		assert.Equal(model.ProcessEventType_start, byPid[9][0].Type)
+	}
+
+	// The zombie's exit is only reported once.
+	last, cur = cur, map[int32]*process.FilledProcess{
+		6: makeEventProcess(6, 600, "", "", 1000, "Z"),
+	}
+	byPid = indexEvents(diffProcesses(cfg, cur, nil, last, groups, nil, now))
+	assert.Empty(byPid[6])
+	assert.Len(byPid[9], 1)
+}
+
+func TestSetExitStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	ev := &model.ProcessEvent{}
+	setExitStatus(ev, 3<<8)
+	assert.True(ev.ExitStatusKnown)
+	assert.Equal(int32(3), ev.ExitCode)
+	assert.Equal(int32(0), ev.ExitSignal)
+
+	ev = &model.ProcessEvent{}
+	setExitStatus(ev, 15)
+	assert.Equal(int32(0), ev.ExitCode)
+	assert.Equal(int32(15), ev.ExitSignal)
+}
+
+func TestChunkProcessEvents(t *testing.T) {
+	assert := assert.New(t)
+	events := make([]*model.ProcessEvent, 5)
+	for i := range events {
+		events[i] = &model.ProcessEvent{Pid: int32(i)}
+	}
+
+	chunked := chunkProcessEvents(events, 2)
+	assert.Len(chunked, 3)
+	assert.Len(chunked[2], 1)
+	assert.Equal(int32(4), chunked[2][0].Pid)
+	assert.Len(chunkProcessEvents(events, 5), 1)
+	assert.Empty(chunkProcessEvents(nil, 5))
+}
+
+func TestProcessEventsReuseProcessSnapshot(t *testing.T) {
+	assert := assert.New(t)
+	cfg := config.NewDefaultAgentConfig()
+	p := &ProcessCheck{}
+	e := &ProcessEventsCheck{processCheck: p}
+
+	// The process check didn't run yet.
+	msgs, err := e.Run(cfg, 1)
+	assert.NoError(err)
+	assert.Nil(msgs)
+	assert.Nil(e.lastProcs)
+
+	now := time.Now()
+	first := map[int32]*process.FilledProcess{1: makeEventProcess(1, 1000, "/sbin/init", "init", 0, "S")}
+	p.snapshot = &processSnapshot{procs: first, time: now}
+	msgs, err = e.Run(cfg, 1)
+	assert.NoError(err)
+	assert.Nil(msgs)
+	assert.Equal(first, e.lastProcs)
+
+	// Nothing new since the last run.
+	msgs, err = e.Run(cfg, 1)
+	assert.NoError(err)
+	assert.Nil(msgs)
+
+	second := map[int32]*process.FilledProcess{
+		1: first[1],
+		2: makeEventProcess(2, 2000, "/bin/sleep", "sleep 1", 0, "S"),
+	}
+	p.snapshot = &processSnapshot{procs: second, time: now.Add(10 * time.Second)}
+	msgs, err = e.Run(cfg, 1)
+	assert.NoError(err)
+	assert.Len(msgs, 1)
+	events := msgs[0].(*model.CollectorProcEvent).Events
+	assert.Len(events, 1)
+	assert.Equal(int32(2), events[0].Pid)
+	assert.Equal(model.ProcessEventType_start, events[0].Type)
+	assert.Equal(second, e.lastProcs)
+}
@@ -3,9 +3,7 @@
 package checks
 
 import (
-	"os/user"
 	"runtime"
-	"strconv"
 
 	"github.com/DataDog/gopsutil/cpu"
 	"github.com/DataDog/gopsutil/process"
@@ -13,25 +11,26 @@ import (
 	"github.com/DataDog/datadog-process-agent/model"
 )
 
-func formatUser(fp *process.FilledProcess) *model.ProcessUser {
-	var username string
-	var uid, gid int32
+// formatUser formats the user of a process, with the supplementary groups read
+// along with the process, see readAllGroups.
+func formatUser(fp *process.FilledProcess, groups []int32) *model.ProcessUser {
+	u := &model.ProcessUser{Groups: groups}
+	// The ids are ordered as in /proc/<pid>/status: real, effective, saved
+	// and filesystem.
 	if len(fp.Uids) > 0 {
-		u, err := user.LookupId(strconv.Itoa(int(fp.Uids[0])))
-		if err == nil {
-			username = u.Username
-		}
-		uid = int32(fp.Uids[0])
+		u.Name = usernames.get(fp.Uids[0])
+		u.Uid = fp.Uids[0]
+	}
+	if len(fp.Uids) >= 4 {
+		u.Euid, u.Suid, u.Fsuid = fp.Uids[1], fp.Uids[2], fp.Uids[3]
 	}
 	if len(fp.Gids) > 0 {
-		gid = int32(fp.Gids[0])
+		u.Gid = fp.Gids[0]
 	}
-
-	return &model.ProcessUser{
-		Name: username,
-		Uid:  uid,
-		Gid:  gid,
+	if len(fp.Gids) >= 4 {
+		u.Egid, u.Sgid, u.Fsgid = fp.Gids[1], fp.Gids[2], fp.Gids[3]
 	}
+	return u
 }
 
 func formatCPU(fp *process.FilledProcess, t2, t1, syst2, syst1 cpu.TimesStat) *model.CPUStat {
@@ -23,11 +23,21 @@ type RTProcessCheck struct {
 	lastProcs    map[int32]*process.FilledProcess
 	lastCtrRates map[string]util.ContainerRateMetrics
 	lastRun      time.Time
+	// Optional, breaks down the CPU usage by CPU.
+	perCPU *perCPUTracker
+	// Optional, reads scheduling latency and delays.
+	schedStats *schedStatsCollector
 }
 
 // Init initializes a new RTProcessCheck instance.
 func (r *RTProcessCheck) Init(cfg *config.AgentConfig, info *model.SystemInfo) {
 	r.sysInfo = info
+	if cfg.CollectPerCPUStats {
+		r.perCPU = newPerCPUTracker()
+	}
+	if cfg.CollectSchedStats {
+		r.schedStats = newSchedStatsCollector()
+	}
 }
 
 // Name returns the name of the RTProcessCheck.
@@ -55,6 +65,12 @@ func (r *RTProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Me
 		return nil, err
 	}
 	ctrList, _ := util.GetContainers()
+	if r.perCPU != nil {
+		r.perCPU.update(cfg, procs)
+	}
+	if r.schedStats != nil {
+		r.schedStats.update(cfg, procs, time.Now())
+	}
 
 	// End check early if this is our first run.
 	if r.lastProcs == nil {
@@ -71,6 +87,21 @@ func (r *RTProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Me
 	chunkedCtrStats := fmtContainerStats(ctrList, r.lastCtrRates, r.lastRun, groupSize)
 	messages := make([]model.MessageBody, 0, groupSize)
 	for i := 0; i < groupSize; i++ {
+		if r.schedStats != nil {
+			for _, stat := range chunkedStats[i] {
+				stat.SchedStat = r.schedStats.get(stat.Pid)
+			}
+		}
+		var hostCPUs []*model.SingleCPUStat
+		if r.perCPU != nil {
+			for _, stat := range chunkedStats[i] {
+				r.perCPU.fill(stat.Pid, stat.Cpu)
+			}
+			// Host-wide, only sent in the first message of the group.
+			if i == 0 {
+				hostCPUs = r.perCPU.hostCPUs
+			}
+		}
 		messages = append(messages, &model.CollectorRealTime{
 			HostName:       cfg.HostName,
 			Stats:          chunkedStats[i],
@@ -79,6 +110,7 @@ func (r *RTProcessCheck) Run(cfg *config.AgentConfig, groupID int32) ([]model.Me
 			GroupSize:      int32(groupSize),
 			NumCpus:        int32(len(r.sysInfo.Cpus)),
 			TotalMemory:    r.sysInfo.TotalMemory,
+			HostCpus:       hostCPUs,
 		})
 	}
 
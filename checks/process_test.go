@@ -92,7 +92,7 @@ func TestProcessChunking(t *testing.T) {
 			last[c.Pid] = c
 		}
 
-		chunked := fmtProcesses(cfg, cur, last, containers, syst2, syst1, lastRun)
+		chunked := fmtProcesses(cfg, cur, nil, nil, nil, last, containers, syst2, syst1, lastRun)
 		assert.Len(t, chunked, tc.expectedChunks, "len %d", i)
 		total := 0
 		for _, c := range chunked {
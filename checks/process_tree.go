@@ -0,0 +1,147 @@
+package checks
+
+import (
+	"sort"
+
+	"github.com/DataDog/gopsutil/process"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// ProcessNode is a process in a process tree, with the resources used by it
+// and all its descendants.
+type ProcessNode struct {
+	Process  *model.Process
+	Children []*ProcessNode
+
+	SubtreeProcs  int
+	SubtreeCPUPct float32
+	SubtreeRss    uint64
+
+	// The parent of the process isn't among the processes: it is filtered
+	// out, or started after the previous run and not reported yet. It doesn't
+	// mean the process was orphaned, see Reparented for that.
+	ParentMissing bool
+	// The process is no longer a child of the parent it was first seen with,
+	// e.g. adopted by init or a subreaper once its parent exited.
+	Reparented bool
+}
+
+// BuildProcessTree arranges processes by parent, and returns the roots of the
+// trees sorted by pid: the processes without a parent, like init, and the
+// ones whose parent is missing.
+func BuildProcessTree(procs []*model.Process) []*ProcessNode {
+	nodes := make(map[int32]*ProcessNode, len(procs))
+	for _, p := range procs {
+		nodes[p.Pid] = &ProcessNode{Process: p, Reparented: p.Reparented}
+	}
+
+	roots := make([]*ProcessNode, 0)
+	for _, n := range nodes {
+		ppid := processPpid(n.Process)
+		parent, ok := nodes[ppid]
+		switch {
+		case ok && ppid != n.Process.Pid:
+			parent.Children = append(parent.Children, n)
+		case ppid > 0:
+			n.ParentMissing = true
+			roots = append(roots, n)
+		default:
+			roots = append(roots, n)
+		}
+	}
+
+	visited := make(map[int32]bool, len(nodes))
+	for _, n := range roots {
+		aggregateSubtree(n, visited)
+	}
+	// Processes that are their own ancestors, from a pid reused between two
+	// reads, can't be reached from a root.
+	for _, n := range nodes {
+		if !visited[n.Process.Pid] {
+			n.ParentMissing = true
+			roots = append(roots, n)
+			aggregateSubtree(n, visited)
+		}
+	}
+	sortNodes(roots)
+	return roots
+}
+
+// aggregateSubtree sums up the resources used by the processes of a subtree,
+// and sorts the children by pid.
+func aggregateSubtree(n *ProcessNode, visited map[int32]bool) {
+	visited[n.Process.Pid] = true
+	n.SubtreeProcs = 1
+	if n.Process.Cpu != nil {
+		n.SubtreeCPUPct = n.Process.Cpu.TotalPct
+	}
+	if n.Process.Memory != nil {
+		n.SubtreeRss = n.Process.Memory.Rss
+	}
+
+	children := n.Children[:0]
+	for _, c := range n.Children {
+		if visited[c.Process.Pid] {
+			// Breaks a cycle.
+			continue
+		}
+		aggregateSubtree(c, visited)
+		n.SubtreeProcs += c.SubtreeProcs
+		n.SubtreeCPUPct += c.SubtreeCPUPct
+		n.SubtreeRss += c.SubtreeRss
+		children = append(children, c)
+	}
+	n.Children = children
+	sortNodes(n.Children)
+}
+
+func sortNodes(nodes []*ProcessNode) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Process.Pid < nodes[j].Process.Pid })
+}
+
+func processPpid(p *model.Process) int32 {
+	if p.Command == nil {
+		return 0
+	}
+	return p.Command.Ppid
+}
+
+// parentHistory remembers the parent each process was first seen with, to
+// tell the processes orphaned and adopted by init or a subreaper since.
+type parentHistory struct {
+	byPid map[int32]parentRecord
+}
+
+type parentRecord struct {
+	createTime int64
+	ppid       int32
+}
+
+func newParentHistory() *parentHistory {
+	return &parentHistory{byPid: make(map[int32]parentRecord)}
+}
+
+// update records the parent of the processes started since the last update,
+// forgets the ones that exited and returns the pids of the processes whose
+// parent changed since they were first seen. Processes already orphaned when
+// first seen can't be told apart from the ones started by their new parent.
+func (h *parentHistory) update(procs map[int32]*process.FilledProcess) map[int32]bool {
+	reparented := make(map[int32]bool)
+	for pid := range h.byPid {
+		if _, ok := procs[pid]; !ok {
+			delete(h.byPid, pid)
+		}
+	}
+	for pid, fp := range procs {
+		first, ok := h.byPid[pid]
+		if !ok || first.createTime != fp.CreateTime {
+			h.byPid[pid] = parentRecord{createTime: fp.CreateTime, ppid: fp.Ppid}
+			continue
+		}
+		if fp.Ppid != first.ppid {
+			reparented[pid] = true
+		}
+	}
+	return reparented
+}
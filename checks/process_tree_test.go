@@ -0,0 +1,95 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func makeTreeProcess(pid, ppid int32, cpu float32, rss uint64) *model.Process {
+	return &model.Process{
+		Pid:     pid,
+		Command: &model.Command{Ppid: ppid},
+		Cpu:     &model.CPUStat{TotalPct: cpu},
+		Memory:  &model.MemoryStat{Rss: rss},
+	}
+}
+
+func TestBuildProcessTree(t *testing.T) {
+	reparented := makeTreeProcess(30, 1, 1, 10)
+	reparented.Reparented = true
+	procs := []*model.Process{
+		makeTreeProcess(1, 0, 0.5, 5),
+		makeTreeProcess(12, 10, 2, 100),
+		makeTreeProcess(10, 1, 1, 300),
+		makeTreeProcess(11, 10, 3, 200),
+		reparented,
+		// The parent is filtered out.
+		makeTreeProcess(50, 40, 4, 20),
+	}
+
+	roots := BuildProcessTree(procs)
+	require.Len(t, roots, 2)
+
+	init := roots[0]
+	assert.Equal(t, int32(1), init.Process.Pid)
+	assert.False(t, init.ParentMissing)
+	assert.Equal(t, 5, init.SubtreeProcs)
+	assert.InDelta(t, 7.5, init.SubtreeCPUPct, 0.001)
+	assert.Equal(t, uint64(615), init.SubtreeRss)
+	require.Len(t, init.Children, 2)
+
+	master := init.Children[0]
+	assert.Equal(t, int32(10), master.Process.Pid)
+	assert.Equal(t, 3, master.SubtreeProcs)
+	assert.InDelta(t, 6, master.SubtreeCPUPct, 0.001)
+	assert.Equal(t, uint64(600), master.SubtreeRss)
+	require.Len(t, master.Children, 2)
+	assert.Equal(t, int32(11), master.Children[0].Process.Pid)
+	assert.Equal(t, int32(12), master.Children[1].Process.Pid)
+
+	assert.True(t, init.Children[1].Reparented)
+	assert.False(t, init.Children[1].ParentMissing)
+
+	orphan := roots[1]
+	assert.Equal(t, int32(50), orphan.Process.Pid)
+	assert.True(t, orphan.ParentMissing)
+	assert.Equal(t, 1, orphan.SubtreeProcs)
+}
+
+func TestBuildProcessTreeCycle(t *testing.T) {
+	roots := BuildProcessTree([]*model.Process{
+		makeTreeProcess(2, 3, 1, 1),
+		makeTreeProcess(3, 2, 1, 1),
+	})
+	require.Len(t, roots, 1)
+	assert.True(t, roots[0].ParentMissing)
+	assert.Equal(t, 2, roots[0].SubtreeProcs)
+}
+
+func TestParentHistory(t *testing.T) {
+	h := newParentHistory()
+	procs := map[int32]*process.FilledProcess{
+		10: {Pid: 10, Ppid: 1, CreateTime: 100},
+		11: {Pid: 11, Ppid: 10, CreateTime: 110},
+		// Already adopted by init when first seen.
+		20: {Pid: 20, Ppid: 1, CreateTime: 200},
+	}
+	assert.Empty(t, h.update(procs))
+
+	// The parent of 11 exited.
+	delete(procs, 10)
+	procs[11] = &process.FilledProcess{Pid: 11, Ppid: 1, CreateTime: 110}
+	assert.Equal(t, map[int32]bool{11: true}, h.update(procs))
+	// Still flagged on the next runs.
+	assert.Equal(t, map[int32]bool{11: true}, h.update(procs))
+	assert.Len(t, h.byPid, 2)
+
+	// The pid was reused.
+	procs[11] = &process.FilledProcess{Pid: 11, Ppid: 1, CreateTime: 300}
+	assert.Empty(t, h.update(procs))
+}
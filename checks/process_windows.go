@@ -10,7 +10,7 @@ import (
 	"github.com/DataDog/gopsutil/process"
 )
 
-func formatUser(fp *process.FilledProcess) *model.ProcessUser {
+func formatUser(fp *process.FilledProcess, groups []int32) *model.ProcessUser {
 	return &model.ProcessUser{
 		Name: fp.Username,
 	}
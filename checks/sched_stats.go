@@ -0,0 +1,94 @@
+package checks
+
+import (
+	"time"
+
+	"github.com/DataDog/gopsutil/process"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// schedStats are the cumulative scheduling stats of all the threads of a
+// process, in nanoseconds.
+type schedStats struct {
+	runTime      uint64
+	runQueueWait uint64
+	timeslices   uint64
+
+	// Only set when delay accounting is available.
+	delayAcct   bool
+	blkioDelay  uint64
+	swapinDelay uint64
+}
+
+type schedSample struct {
+	createTime int64
+	stats      *schedStats
+}
+
+// schedStatsCollector computes how long processes waited for a CPU, on block
+// IO and on swap since the last run.
+type schedStatsCollector struct {
+	last    map[int32]schedSample
+	lastRun time.Time
+
+	// Results of the last update.
+	stats map[int32]*model.SchedStat
+
+	read func(pid int32) (*schedStats, error)
+}
+
+func newSchedStatsCollector() *schedStatsCollector {
+	return &schedStatsCollector{read: newSchedStatsReader()}
+}
+
+// update reads the scheduling stats of the processes.
+func (c *schedStatsCollector) update(cfg *config.AgentConfig, procs map[int32]*process.FilledProcess, now time.Time) {
+	elapsed := now.Sub(c.lastRun)
+	last := make(map[int32]schedSample, len(procs))
+	c.stats = make(map[int32]*model.SchedStat, len(procs))
+	for pid, fp := range procs {
+		if len(fp.Cmdline) == 0 || config.IsBlacklisted(fp.Cmdline, cfg.Blacklist) {
+			continue
+		}
+		s, err := c.read(pid)
+		if err != nil {
+			continue
+		}
+		last[pid] = schedSample{createTime: fp.CreateTime, stats: s}
+
+		stat := &model.SchedStat{
+			RunTime:      s.runTime,
+			RunQueueWait: s.runQueueWait,
+			Timeslices:   s.timeslices,
+			DelayAcct:    s.delayAcct,
+			BlkioDelay:   s.blkioDelay,
+			SwapinDelay:  s.swapinDelay,
+		}
+		if prev, ok := c.last[pid]; ok && prev.createTime == fp.CreateTime && !c.lastRun.IsZero() {
+			stat.RunQueueWaitPct = delayPct(s.runQueueWait, prev.stats.runQueueWait, elapsed)
+			if s.delayAcct && prev.stats.delayAcct {
+				stat.BlkioDelayPct = delayPct(s.blkioDelay, prev.stats.blkioDelay, elapsed)
+				stat.SwapinDelayPct = delayPct(s.swapinDelay, prev.stats.swapinDelay, elapsed)
+			}
+		}
+		c.stats[pid] = stat
+	}
+	c.last = last
+	c.lastRun = now
+}
+
+// get returns the scheduling stats of a process, if they could be read.
+func (c *schedStatsCollector) get(pid int32) *model.SchedStat {
+	return c.stats[pid]
+}
+
+// delayPct returns the share of the elapsed time spent in a delay, which can
+// go over 100% for a process with several threads waiting.
+func delayPct(cur, last uint64, elapsed time.Duration) float32 {
+	if cur < last || elapsed <= 0 {
+		return 0
+	}
+	return float32(float64(cur-last) / float64(elapsed) * 100)
+}
@@ -0,0 +1,101 @@
+// +build linux
+
+package checks
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// newSchedStatsReader returns a function reading the scheduling stats of a
+// process, along with its delays when delay accounting is available.
+func newSchedStatsReader() func(pid int32) (*schedStats, error) {
+	var ts *taskstatsClient
+	if delayAcctEnabled() {
+		var err error
+		if ts, err = newTaskstatsClient(); err != nil {
+			log.Warnf("Unable to collect IO and swap delays, delay accounting not available: %s", err)
+			ts = nil
+		}
+	} else {
+		log.Info("Delay accounting is disabled, set the kernel.task_delayacct sysctl to collect IO and swap delays")
+	}
+
+	return func(pid int32) (*schedStats, error) {
+		s, err := readSchedstat(pid)
+		if err != nil {
+			return nil, err
+		}
+		if ts != nil {
+			if d, err := ts.delays(pid); err == nil {
+				s.delayAcct = true
+				s.blkioDelay = d.blkio
+				s.swapinDelay = d.swapin
+			}
+		}
+		return s, nil
+	}
+}
+
+// delayAcctEnabled tells if the kernel accounts for delays, which can be
+// toggled at runtime since Linux 5.14.
+func delayAcctEnabled() bool {
+	data, err := ioutil.ReadFile(util.HostProc("sys", "kernel", "task_delayacct"))
+	if err != nil {
+		// Older kernels account for delays unless booted with nodelayacct.
+		return true
+	}
+	return strings.TrimSpace(string(data)) == "1"
+}
+
+// readSchedstat sums the content of /proc/<pid>/task/<tid>/schedstat over the
+// threads of a process.
+func readSchedstat(pid int32) (*schedStats, error) {
+	dir := util.HostProc(strconv.Itoa(int(pid)), "task")
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	s := &schedStats{}
+	for _, e := range entries {
+		data, err := ioutil.ReadFile(filepath.Join(dir, e.Name(), "schedstat"))
+		if err != nil {
+			// The thread exited.
+			continue
+		}
+		run, wait, slices, ok := parseSchedstat(string(data))
+		if !ok {
+			continue
+		}
+		s.runTime += run
+		s.runQueueWait += wait
+		s.timeslices += slices
+	}
+	return s, nil
+}
+
+// parseSchedstat parses the time spent on a CPU, the time spent waiting on a
+// run queue, both in nanoseconds, and the number of timeslices run.
+func parseSchedstat(data string) (run, wait, slices uint64, ok bool) {
+	fields := strings.Fields(data)
+	if len(fields) < 3 {
+		return 0, 0, 0, false
+	}
+	var err error
+	if run, err = strconv.ParseUint(fields[0], 10, 64); err != nil {
+		return 0, 0, 0, false
+	}
+	if wait, err = strconv.ParseUint(fields[1], 10, 64); err != nil {
+		return 0, 0, 0, false
+	}
+	if slices, err = strconv.ParseUint(fields[2], 10, 64); err != nil {
+		return 0, 0, 0, false
+	}
+	return run, wait, slices, true
+}
@@ -0,0 +1,67 @@
+// +build linux
+
+package checks
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSchedstat(t *testing.T) {
+	if _, err := os.Stat("/proc/self/schedstat"); err != nil {
+		t.Skip("schedstat not available")
+	}
+	s, err := readSchedstat(int32(os.Getpid()))
+	assert.NoError(t, err)
+	assert.NotZero(t, s.runTime)
+	assert.NotZero(t, s.timeslices)
+}
+
+func TestParseSchedstat(t *testing.T) {
+	run, wait, slices, ok := parseSchedstat("127446455 5217823 158\n")
+	assert.True(t, ok)
+	assert.Equal(t, uint64(127446455), run)
+	assert.Equal(t, uint64(5217823), wait)
+	assert.Equal(t, uint64(158), slices)
+
+	_, _, _, ok = parseSchedstat("127446455\n")
+	assert.False(t, ok)
+}
+
+func TestParseNetlinkAttrs(t *testing.T) {
+	assert := assert.New(t)
+	attr := func(t uint16, data []byte) []byte {
+		b := make([]byte, nlaAlign(4+len(data)))
+		nativeEndian.PutUint16(b[0:], uint16(4+len(data)))
+		nativeEndian.PutUint16(b[2:], t)
+		copy(b[4:], data)
+		return b
+	}
+	stats := make([]byte, taskstatsMinLen)
+	nativeEndian.PutUint64(stats[taskstatsBlkioDelayTotal:], 42)
+	pid := []byte{1, 0, 0, 0}
+	// A nested attribute, with the NLA_F_NESTED flag.
+	nested := append(attr(taskstatsCmdAttrTGID, pid), attr(taskstatsTypeStats, stats)...)
+	data := append(attr(0x8000|taskstatsTypeAggrTGID, nested), attr(7, []byte("odd"))...)
+
+	attrs := parseNetlinkAttrs(data)
+	assert.Len(attrs, 2)
+	assert.Equal([]byte("odd"), attrs[7])
+	inner := parseNetlinkAttrs(attrs[taskstatsTypeAggrTGID])
+	assert.Equal(pid, inner[taskstatsCmdAttrTGID])
+	assert.Equal(uint64(42), nativeEndian.Uint64(inner[taskstatsTypeStats][taskstatsBlkioDelayTotal:]))
+}
+
+func TestTaskstatsClient(t *testing.T) {
+	c, err := newTaskstatsClient()
+	if err != nil {
+		t.Skipf("taskstats not available: %s", err)
+	}
+	defer c.close()
+	_, err = c.delays(int32(os.Getpid()))
+	if err != nil {
+		t.Skipf("taskstats not permitted: %s", err)
+	}
+}
@@ -0,0 +1,13 @@
+// +build !linux
+
+package checks
+
+import "errors"
+
+// newSchedStatsReader returns a reader always failing, scheduling stats are
+// only supported on Linux.
+func newSchedStatsReader() func(pid int32) (*schedStats, error) {
+	return func(pid int32) (*schedStats, error) {
+		return nil, errors.New("scheduling stats are only supported on Linux")
+	}
+}
@@ -0,0 +1,55 @@
+package checks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+)
+
+func TestSchedStatsCollector(t *testing.T) {
+	assert := assert.New(t)
+	cfg := config.NewDefaultAgentConfig()
+	stats := map[int32]*schedStats{
+		1: {runTime: 1e9, runQueueWait: 1e9, timeslices: 10, delayAcct: true, blkioDelay: 0, swapinDelay: 0},
+		2: {runTime: 1e9, runQueueWait: 0},
+	}
+	c := &schedStatsCollector{read: func(pid int32) (*schedStats, error) {
+		s := *stats[pid]
+		return &s, nil
+	}}
+	procs := map[int32]*process.FilledProcess{
+		1: makeProcess(1, "db"),
+		2: makeProcess(2, "batch"),
+	}
+	now := time.Unix(1000, 0)
+
+	c.update(cfg, procs, now)
+	s := c.get(1)
+	assert.Equal(uint64(1e9), s.RunQueueWait)
+	assert.Equal(uint64(10), s.Timeslices)
+	assert.Equal(float32(0), s.RunQueueWaitPct)
+
+	// Waited 5s for a CPU and 15s on IO over 10s, with several threads.
+	stats[1] = &schedStats{runTime: 2e9, runQueueWait: 6e9, timeslices: 20, delayAcct: true, blkioDelay: 15e9, swapinDelay: 1e9}
+	stats[2] = &schedStats{runTime: 11e9, runQueueWait: 1e9}
+	c.update(cfg, procs, now.Add(10*time.Second))
+	s = c.get(1)
+	assert.Equal(float32(50), s.RunQueueWaitPct)
+	assert.True(s.DelayAcct)
+	assert.Equal(float32(150), s.BlkioDelayPct)
+	assert.Equal(float32(10), s.SwapinDelayPct)
+	s = c.get(2)
+	assert.Equal(float32(10), s.RunQueueWaitPct)
+	assert.False(s.DelayAcct)
+	assert.Equal(float32(0), s.BlkioDelayPct)
+
+	// A reused pid starts over.
+	procs[1].CreateTime = 42
+	c.update(cfg, procs, now.Add(20*time.Second))
+	assert.Equal(float32(0), c.get(1).RunQueueWaitPct)
+	assert.Nil(c.get(3))
+}
@@ -0,0 +1,83 @@
+package checks
+
+import (
+	"fmt"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// capabilityNames are the names of the Linux capabilities, by bit number (see
+// linux/capability.h).
+var capabilityNames = []string{
+	"CAP_CHOWN",
+	"CAP_DAC_OVERRIDE",
+	"CAP_DAC_READ_SEARCH",
+	"CAP_FOWNER",
+	"CAP_FSETID",
+	"CAP_KILL",
+	"CAP_SETGID",
+	"CAP_SETUID",
+	"CAP_SETPCAP",
+	"CAP_LINUX_IMMUTABLE",
+	"CAP_NET_BIND_SERVICE",
+	"CAP_NET_BROADCAST",
+	"CAP_NET_ADMIN",
+	"CAP_NET_RAW",
+	"CAP_IPC_LOCK",
+	"CAP_IPC_OWNER",
+	"CAP_SYS_MODULE",
+	"CAP_SYS_RAWIO",
+	"CAP_SYS_CHROOT",
+	"CAP_SYS_PTRACE",
+	"CAP_SYS_PACCT",
+	"CAP_SYS_ADMIN",
+	"CAP_SYS_BOOT",
+	"CAP_SYS_NICE",
+	"CAP_SYS_RESOURCE",
+	"CAP_SYS_TIME",
+	"CAP_SYS_TTY_CONFIG",
+	"CAP_MKNOD",
+	"CAP_LEASE",
+	"CAP_AUDIT_WRITE",
+	"CAP_AUDIT_CONTROL",
+	"CAP_SETFCAP",
+	"CAP_MAC_OVERRIDE",
+	"CAP_MAC_ADMIN",
+	"CAP_SYSLOG",
+	"CAP_WAKE_ALARM",
+	"CAP_BLOCK_SUSPEND",
+	"CAP_AUDIT_READ",
+	"CAP_PERFMON",
+	"CAP_BPF",
+	"CAP_CHECKPOINT_RESTORE",
+}
+
+// formatSecurity returns the security context of a process, when enabled.
+func formatSecurity(cfg *config.AgentConfig, pid int32) *model.ProcessSecurity {
+	if !cfg.CollectSecurityContext {
+		return nil
+	}
+	sec := readSecurity(pid)
+	if sec != nil {
+		sec.EffectiveCaps = capabilities(sec.CapEffective)
+	}
+	return sec
+}
+
+// capabilities returns the names of the capabilities set in a bitmask, the
+// ones unknown to us by number.
+func capabilities(mask uint64) []string {
+	var names []string
+	for bit := uint(0); bit < 64; bit++ {
+		if mask&(1<<bit) == 0 {
+			continue
+		}
+		if int(bit) < len(capabilityNames) {
+			names = append(names, capabilityNames[bit])
+		} else {
+			names = append(names, fmt.Sprintf("CAP_%d", bit))
+		}
+	}
+	return names
+}
@@ -0,0 +1,71 @@
+// +build linux
+
+package checks
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// readSecurity reads the security context of a process from
+// /proc/<pid>/status and its LSM label from /proc/<pid>/attr.
+func readSecurity(pid int32) *model.ProcessSecurity {
+	f, err := os.Open(util.HostProc(strconv.Itoa(int(pid)), "status"))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	sec := parseStatusSecurity(f)
+	sec.LsmLabel = readLSMLabel(pid)
+	return sec
+}
+
+// parseStatusSecurity extracts the capabilities, seccomp mode and no_new_privs
+// flag from the content of /proc/<pid>/status.
+func parseStatusSecurity(r io.Reader) *model.ProcessSecurity {
+	sec := &model.ProcessSecurity{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(parts[1])
+		switch parts[0] {
+		case "CapEff":
+			sec.CapEffective, _ = strconv.ParseUint(value, 16, 64)
+		case "CapPrm":
+			sec.CapPermitted, _ = strconv.ParseUint(value, 16, 64)
+		case "CapBnd":
+			sec.CapBounding, _ = strconv.ParseUint(value, 16, 64)
+		case "NoNewPrivs":
+			sec.NoNewPrivs = value == "1"
+		case "Seccomp":
+			if mode, err := strconv.Atoi(value); err == nil {
+				sec.Seccomp = model.SeccompMode(mode)
+			}
+		}
+	}
+	return sec
+}
+
+// readLSMLabel reads the SELinux context or AppArmor profile of a process,
+// e.g. "system_u:system_r:httpd_t:s0" or "docker-default (enforce)".
+func readLSMLabel(pid int32) string {
+	attr := util.HostProc(strconv.Itoa(int(pid)), "attr")
+	data, err := ioutil.ReadFile(attr + "/current")
+	if err != nil || len(data) == 0 {
+		// With several LSMs stacked, AppArmor has its own directory.
+		if data, err = ioutil.ReadFile(attr + "/apparmor/current"); err != nil {
+			return ""
+		}
+	}
+	return strings.TrimSpace(strings.TrimRight(string(data), "\x00"))
+}
@@ -0,0 +1,51 @@
+// +build linux
+
+package checks
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestParseStatusSecurity(t *testing.T) {
+	status := `Name:	nginx
+Uid:	0	0	0	0
+CapInh:	0000000000000000
+CapPrm:	00000000a80425fb
+CapEff:	00000000a80425fb
+CapBnd:	00000000a80425fb
+CapAmb:	0000000000000000
+NoNewPrivs:	1
+Seccomp:	2
+Seccomp_filters:	1
+`
+	assert.Equal(t, &model.ProcessSecurity{
+		CapEffective: 0xa80425fb,
+		CapPermitted: 0xa80425fb,
+		CapBounding:  0xa80425fb,
+		Seccomp:      model.SeccompMode_seccompFilter,
+		NoNewPrivs:   true,
+	}, parseStatusSecurity(strings.NewReader(status)))
+
+	assert.Equal(t, &model.ProcessSecurity{}, parseStatusSecurity(strings.NewReader("Name:\tinit\n")))
+}
+
+func TestFormatSecurity(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	pid := int32(os.Getpid())
+	assert.Nil(t, formatSecurity(cfg, pid))
+
+	cfg.CollectSecurityContext = true
+	sec := formatSecurity(cfg, pid)
+	require.NotNil(t, sec)
+	assert.NotZero(t, sec.CapBounding)
+	assert.Len(t, sec.EffectiveCaps, len(capabilities(sec.CapEffective)))
+	assert.Nil(t, formatSecurity(cfg, -1))
+}
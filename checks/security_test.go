@@ -0,0 +1,16 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilities(t *testing.T) {
+	assert.Nil(t, capabilities(0))
+	// CAP_NET_BIND_SERVICE, CAP_NET_RAW and CAP_SYS_ADMIN.
+	assert.Equal(t, []string{"CAP_NET_BIND_SERVICE", "CAP_NET_RAW", "CAP_SYS_ADMIN"},
+		capabilities(1<<10|1<<13|1<<21))
+	assert.Len(t, capabilities(0x000001ffffffffff), 41)
+	assert.Equal(t, []string{"CAP_CHECKPOINT_RESTORE", "CAP_41"}, capabilities(1<<40|1<<41))
+}
@@ -0,0 +1,320 @@
+package checks
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/DataDog/gopsutil/process"
+
+	"github.com/DataDog/datadog-process-agent/config"
+)
+
+// serviceEnvVars are the environment variables naming the service of a
+// process, by order of precedence.
+var serviceEnvVars = []string{"DD_SERVICE", "OTEL_SERVICE_NAME"}
+
+type cachedServiceTags struct {
+	createTime int64
+	exe        string
+	tags       []string
+}
+
+// serviceDetector detects the runtime and service of processes, once per
+// program a process runs.
+type serviceDetector struct {
+	cache map[int32]*cachedServiceTags
+
+	// Overridden in tests.
+	classify func(cfg *config.AgentConfig, fp *process.FilledProcess) []string
+}
+
+func newServiceDetector() *serviceDetector {
+	return &serviceDetector{
+		cache:    make(map[int32]*cachedServiceTags),
+		classify: classifyProcess,
+	}
+}
+
+// update classifies the processes started or exec'ed since the last update.
+func (d *serviceDetector) update(cfg *config.AgentConfig, procs map[int32]*process.FilledProcess) {
+	for pid := range d.cache {
+		if _, ok := procs[pid]; !ok {
+			delete(d.cache, pid)
+		}
+	}
+	for pid, fp := range procs {
+		if len(fp.Cmdline) == 0 || config.IsBlacklisted(fp.Cmdline, cfg.Blacklist) {
+			continue
+		}
+		if cached, ok := d.cache[pid]; ok && cached.createTime == fp.CreateTime && cached.exe == fp.Exe {
+			continue
+		}
+		d.cache[pid] = &cachedServiceTags{createTime: fp.CreateTime, exe: fp.Exe, tags: d.classify(cfg, fp)}
+	}
+}
+
+// tags returns the runtime and service tags of a process.
+func (d *serviceDetector) tags(pid int32) []string {
+	if cached, ok := d.cache[pid]; ok {
+		return cached.tags
+	}
+	return nil
+}
+
+// classifiedProcess is what the classifiers look at to detect the runtime and
+// service of a process. The expensive parts are read on demand.
+type classifiedProcess struct {
+	pid     int32
+	exe     string
+	cmdline []string
+
+	libs     []string
+	libsRead bool
+	goBinary *bool
+}
+
+// exeName returns the name of the executable of a process, from its command
+// line when the executable couldn't be read.
+func (p *classifiedProcess) exeName() string {
+	if p.exe != "" {
+		return filepath.Base(p.exe)
+	}
+	if len(p.cmdline) > 0 {
+		return filepath.Base(p.cmdline[0])
+	}
+	return ""
+}
+
+// mapsLibrary tells if a process mapped a library in memory, e.g. "libjvm".
+func (p *classifiedProcess) mapsLibrary(name string) bool {
+	if !p.libsRead {
+		p.libs = readMappedFiles(p.pid)
+		p.libsRead = true
+	}
+	for _, lib := range p.libs {
+		if strings.HasPrefix(filepath.Base(lib), name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *classifiedProcess) isGoBinary() bool {
+	if p.goBinary == nil {
+		isGo := isGoExecutable(p.pid)
+		p.goBinary = &isGo
+	}
+	return *p.goBinary
+}
+
+// serviceClassifier detects whether a process runs on a language runtime, and
+// the service it runs when it can tell. The runtime is empty for the processes
+// using another one.
+type serviceClassifier func(p *classifiedProcess) (runtime, service string)
+
+// serviceClassifiers are tried in order until one detects the runtime of a
+// process. New runtimes are supported by adding their classifier here.
+var serviceClassifiers = []serviceClassifier{
+	classifyPHPFPM,
+	classifyJVM,
+	classifyPython,
+	classifyNode,
+	classifyRuby,
+	classifyDotnet,
+	classifyGo,
+}
+
+// classifyProcess returns the runtime and service tags of a process. The
+// classifiers only see the command line as it is reported, so that no
+// argument hidden by the scrubber, or by strip_proc_arguments, ends up in a
+// service name.
+func classifyProcess(cfg *config.AgentConfig, fp *process.FilledProcess) []string {
+	p := &classifiedProcess{pid: fp.Pid, exe: fp.Exe, cmdline: cfg.Scrubber.ScrubCommand(fp.Cmdline)}
+	var runtime, service string
+	for _, classify := range serviceClassifiers {
+		if runtime, service = classify(p); runtime != "" {
+			break
+		}
+	}
+	if cfg.DetectServicesFromEnv {
+		env := readEnvVars(fp.Pid, serviceEnvVars)
+		for _, name := range serviceEnvVars {
+			if v := env[name]; v != "" {
+				service = v
+				break
+			}
+		}
+	}
+
+	var tags []string
+	if runtime != "" {
+		tags = append(tags, "runtime:"+runtime)
+	}
+	if service != "" {
+		tags = append(tags, "service:"+service)
+	}
+	return tags
+}
+
+// phpFPMTitle matches the titles PHP-FPM gives to its processes, e.g.
+// "php-fpm: master process (/etc/php/fpm/php-fpm.conf)" or "php-fpm: pool www".
+var phpFPMTitle = regexp.MustCompile(`^php-fpm[\d.]*: (?:pool (\S+)|master process)`)
+
+func classifyPHPFPM(p *classifiedProcess) (string, string) {
+	if len(p.cmdline) > 0 {
+		if m := phpFPMTitle.FindStringSubmatch(strings.Join(p.cmdline, " ")); m != nil {
+			return "php-fpm", m[1]
+		}
+	}
+	if strings.HasPrefix(p.exeName(), "php-fpm") {
+		return "php-fpm", ""
+	}
+	return "", ""
+}
+
+func classifyJVM(p *classifiedProcess) (string, string) {
+	if p.exeName() != "java" && !p.mapsLibrary("libjvm.so") {
+		return "", ""
+	}
+	args := argsAfterExe(p.cmdline)
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; arg {
+		case "-jar":
+			if i+1 < len(args) {
+				return "jvm", strings.TrimSuffix(filepath.Base(args[i+1]), ".jar")
+			}
+		case "-m", "--module":
+			if i+1 < len(args) {
+				module := args[i+1]
+				if idx := strings.IndexByte(module, '/'); idx >= 0 {
+					return "jvm", module[idx+1:]
+				}
+				return "jvm", module
+			}
+		case "-cp", "-classpath", "--class-path", "-p", "--module-path", "--add-modules",
+			"--add-opens", "--add-exports", "--add-reads", "--patch-module":
+			i++
+		default:
+			if !strings.HasPrefix(arg, "-") {
+				return "jvm", arg
+			}
+		}
+	}
+	return "jvm", ""
+}
+
+var pythonExe = regexp.MustCompile(`^python[\d.]*m?$`)
+
+func classifyPython(p *classifiedProcess) (string, string) {
+	if !pythonExe.MatchString(p.exeName()) && !p.mapsLibrary("libpython") {
+		return "", ""
+	}
+	args := argsAfterExe(p.cmdline)
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; arg {
+		case "-m":
+			if i+1 < len(args) {
+				return "cpython", args[i+1]
+			}
+		case "-c":
+			return "cpython", ""
+		case "-W", "-X", "-Q":
+			i++
+		default:
+			if !strings.HasPrefix(arg, "-") {
+				return "cpython", scriptName(arg, ".py")
+			}
+		}
+	}
+	return "cpython", ""
+}
+
+func classifyNode(p *classifiedProcess) (string, string) {
+	if name := p.exeName(); name != "node" && name != "nodejs" {
+		return "", ""
+	}
+	args := argsAfterExe(p.cmdline)
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; arg {
+		case "-e", "--eval", "-p", "--print":
+			return "node", ""
+		case "-r", "--require", "--loader", "--import":
+			i++
+		default:
+			if !strings.HasPrefix(arg, "-") {
+				return "node", scriptName(arg, ".js", ".mjs", ".cjs")
+			}
+		}
+	}
+	return "node", ""
+}
+
+var rubyExe = regexp.MustCompile(`^ruby[\d.]*$`)
+
+func classifyRuby(p *classifiedProcess) (string, string) {
+	if !rubyExe.MatchString(p.exeName()) && !p.mapsLibrary("libruby") {
+		return "", ""
+	}
+	args := argsAfterExe(p.cmdline)
+	for i := 0; i < len(args); i++ {
+		switch arg := args[i]; arg {
+		case "-e":
+			return "ruby", ""
+		case "-I", "-r", "-C":
+			i++
+		default:
+			if !strings.HasPrefix(arg, "-") {
+				return "ruby", scriptName(arg, ".rb")
+			}
+		}
+	}
+	return "ruby", ""
+}
+
+func classifyDotnet(p *classifiedProcess) (string, string) {
+	isHost := p.exeName() == "dotnet"
+	if !isHost && !p.mapsLibrary("libcoreclr.so") {
+		return "", ""
+	}
+	for _, arg := range argsAfterExe(p.cmdline) {
+		if strings.HasSuffix(arg, ".dll") {
+			return "dotnet", strings.TrimSuffix(filepath.Base(arg), ".dll")
+		}
+	}
+	if isHost {
+		return "dotnet", ""
+	}
+	// An application host, named after the application.
+	return "dotnet", p.exeName()
+}
+
+func classifyGo(p *classifiedProcess) (string, string) {
+	if !p.isGoBinary() {
+		return "", ""
+	}
+	return "go", p.exeName()
+}
+
+func argsAfterExe(cmdline []string) []string {
+	if len(cmdline) < 2 {
+		return nil
+	}
+	return cmdline[1:]
+}
+
+// scriptName names a service after the script it runs, or the directory of the
+// script for generic names like "index.js".
+func scriptName(path string, exts ...string) string {
+	name := filepath.Base(path)
+	for _, ext := range exts {
+		name = strings.TrimSuffix(name, ext)
+	}
+	switch name {
+	case "index", "main", "app", "server", "__main__":
+		if dir := filepath.Base(filepath.Dir(path)); dir != "." && dir != "/" {
+			return dir
+		}
+	}
+	return name
+}
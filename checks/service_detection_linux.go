@@ -0,0 +1,91 @@
+// +build linux
+
+package checks
+
+import (
+	"bufio"
+	"bytes"
+	"debug/elf"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// readMappedFiles returns the files a process mapped in memory, from
+// /proc/<pid>/maps.
+func readMappedFiles(pid int32) []string {
+	f, err := os.Open(util.HostProc(strconv.Itoa(int(pid)), "maps"))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	seen := make(map[string]struct{})
+	files := make([]string, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// address perms offset dev inode pathname
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 6 || !strings.HasPrefix(fields[5], "/") {
+			continue
+		}
+		if _, ok := seen[fields[5]]; ok {
+			continue
+		}
+		seen[fields[5]] = struct{}{}
+		files = append(files, fields[5])
+	}
+	return files
+}
+
+// isGoExecutable tells if the executable of a process was built by the Go
+// toolchain, which leaves its build id or build info in the ELF sections.
+func isGoExecutable(pid int32) bool {
+	f, err := elf.Open(util.HostProc(strconv.Itoa(int(pid)), "exe"))
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	return f.Section(".go.buildinfo") != nil || f.Section(".note.go.buildid") != nil
+}
+
+// readEnvVars reads the values of some environment variables of a process.
+func readEnvVars(pid int32, names []string) map[string]string {
+	return readEnviron(pid, func(name string) bool {
+		for _, n := range names {
+			if n == name {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// readEnviron reads the environment variables of a process matching keep,
+// from /proc/<pid>/environ.
+func readEnviron(pid int32, keep func(name string) bool) map[string]string {
+	data, err := ioutil.ReadFile(util.HostProc(strconv.Itoa(int(pid)), "environ"))
+	if err != nil {
+		return nil
+	}
+	return parseEnviron(data, keep)
+}
+
+// parseEnviron parses the NUL separated NAME=value pairs of
+// /proc/<pid>/environ, keeping the variables matching keep.
+func parseEnviron(data []byte, keep func(name string) bool) map[string]string {
+	env := make(map[string]string)
+	for _, kv := range bytes.Split(data, []byte{0}) {
+		idx := bytes.IndexByte(kv, '=')
+		if idx <= 0 {
+			continue
+		}
+		if name := string(kv[:idx]); keep(name) {
+			env[name] = string(kv[idx+1:])
+		}
+	}
+	return env
+}
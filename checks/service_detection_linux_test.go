@@ -0,0 +1,57 @@
+// +build linux
+
+package checks
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/config"
+)
+
+func TestParseEnviron(t *testing.T) {
+	data := []byte("PATH=/usr/bin\x00DD_SERVICE=billing\x00EMPTY=\x00=bogus\x00OTEL_SERVICE_NAME=a=b\x00")
+	env := parseEnviron(data, func(name string) bool { return name != "PATH" })
+	assert.Equal(t, map[string]string{
+		"DD_SERVICE":        "billing",
+		"EMPTY":             "",
+		"OTEL_SERVICE_NAME": "a=b",
+	}, env)
+}
+
+func TestClassifyOwnProcess(t *testing.T) {
+	pid := int32(os.Getpid())
+	assert.True(t, isGoExecutable(pid))
+	assert.NotEmpty(t, readMappedFiles(pid))
+
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	cfg := config.NewDefaultAgentConfig()
+	tags := classifyProcess(cfg, &process.FilledProcess{Pid: pid, Exe: exe, Cmdline: os.Args})
+	assert.Contains(t, tags, "runtime:go")
+}
+
+func TestClassifyFromEnv(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	cmd.Env = []string{"OTEL_SERVICE_NAME=other", "DD_SERVICE=billing"}
+	require.NoError(t, cmd.Start())
+	defer cmd.Process.Kill()
+	pid := int32(cmd.Process.Pid)
+	// Until the child exec'ed, it has our environment.
+	for i := 0; i < 100 && readEnvVars(pid, serviceEnvVars)["DD_SERVICE"] == ""; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cfg := config.NewDefaultAgentConfig()
+	fp := &process.FilledProcess{Pid: pid, Exe: "/bin/sleep", Cmdline: []string{"sleep", "10"}}
+	assert.Nil(t, classifyProcess(cfg, fp))
+
+	cfg.DetectServicesFromEnv = true
+	assert.Equal(t, []string{"service:billing"}, classifyProcess(cfg, fp))
+}
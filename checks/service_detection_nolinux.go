@@ -0,0 +1,15 @@
+// +build !linux
+
+package checks
+
+// readMappedFiles is only supported on Linux.
+func readMappedFiles(pid int32) []string { return nil }
+
+// isGoExecutable is only supported on Linux.
+func isGoExecutable(pid int32) bool { return false }
+
+// readEnvVars is only supported on Linux.
+func readEnvVars(pid int32, names []string) map[string]string { return nil }
+
+// readEnviron is only supported on Linux.
+func readEnviron(pid int32, keep func(name string) bool) map[string]string { return nil }
@@ -0,0 +1,164 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/config"
+)
+
+func TestClassifyProcess(t *testing.T) {
+	for _, tc := range []struct {
+		exe     string
+		cmdline []string
+		libs    []string
+		goExe   bool
+		runtime string
+		service string
+	}{
+		{
+			exe:     "/usr/lib/jvm/java-11/bin/java",
+			cmdline: []string{"java", "-Xmx2g", "-cp", "lib/*", "-jar", "/opt/billing/billing-1.2.jar"},
+			runtime: "jvm",
+			service: "billing-1.2",
+		},
+		{
+			exe:     "/usr/bin/java",
+			cmdline: []string{"java", "-classpath", "/opt/app", "-Dfoo=bar", "com.example.Main", "--port", "80"},
+			runtime: "jvm",
+			service: "com.example.Main",
+		},
+		{
+			exe:     "/usr/bin/java",
+			cmdline: []string{"java", "-m", "com.example.app/com.example.app.Main"},
+			runtime: "jvm",
+			service: "com.example.app.Main",
+		},
+		{
+			// An embedded JVM.
+			exe:     "/opt/elasticsearch/bin/elasticsearch",
+			cmdline: []string{"/opt/elasticsearch/bin/elasticsearch"},
+			libs:    []string{"/usr/lib/jvm/lib/server/libjvm.so"},
+			runtime: "jvm",
+		},
+		{
+			exe:     "/usr/bin/python3.8",
+			cmdline: []string{"/usr/bin/python3", "-u", "-m", "celery", "worker"},
+			runtime: "cpython",
+			service: "celery",
+		},
+		{
+			exe:     "/usr/bin/python2.7",
+			cmdline: []string{"python", "-W", "ignore", "/srv/jobs/cleanup.py"},
+			runtime: "cpython",
+			service: "cleanup",
+		},
+		{
+			exe:     "/usr/bin/python3",
+			cmdline: []string{"python3", "-c", "print(1)"},
+			runtime: "cpython",
+		},
+		{
+			exe:     "/usr/bin/node",
+			cmdline: []string{"node", "--max-old-space-size=4096", "-r", "dotenv/config", "/srv/checkout/index.js"},
+			runtime: "node",
+			service: "checkout",
+		},
+		{
+			exe:     "/usr/bin/ruby2.5",
+			cmdline: []string{"ruby", "-I", "lib", "bin/sidekiq"},
+			runtime: "ruby",
+			service: "sidekiq",
+		},
+		{
+			exe:     "/usr/share/dotnet/dotnet",
+			cmdline: []string{"dotnet", "/app/Orders.Api.dll"},
+			runtime: "dotnet",
+			service: "Orders.Api",
+		},
+		{
+			exe:     "/app/Orders.Api",
+			cmdline: []string{"/app/Orders.Api"},
+			libs:    []string{"/usr/share/dotnet/shared/Microsoft.NETCore.App/libcoreclr.so"},
+			runtime: "dotnet",
+			service: "Orders.Api",
+		},
+		{
+			exe:     "/usr/sbin/php-fpm7.4",
+			cmdline: []string{"php-fpm: pool www"},
+			runtime: "php-fpm",
+			service: "www",
+		},
+		{
+			exe:     "/usr/sbin/php-fpm7.4",
+			cmdline: []string{"php-fpm: master process (/etc/php/7.4/fpm/php-fpm.conf)"},
+			runtime: "php-fpm",
+		},
+		{
+			exe:     "/usr/bin/dockerd",
+			cmdline: []string{"/usr/bin/dockerd", "-H", "fd://"},
+			goExe:   true,
+			runtime: "go",
+			service: "dockerd",
+		},
+		{
+			exe:     "/usr/sbin/nginx",
+			cmdline: []string{"nginx: master process /usr/sbin/nginx"},
+		},
+	} {
+		goExe := tc.goExe
+		p := &classifiedProcess{exe: tc.exe, cmdline: tc.cmdline, libs: tc.libs, libsRead: true, goBinary: &goExe}
+		var runtime, service string
+		for _, classify := range serviceClassifiers {
+			if runtime, service = classify(p); runtime != "" {
+				break
+			}
+		}
+		assert.Equal(t, tc.runtime, runtime, "%v", tc.cmdline)
+		assert.Equal(t, tc.service, service, "%v", tc.cmdline)
+	}
+}
+
+func TestClassifyProcessStripArguments(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	fp := &process.FilledProcess{Pid: -1, Exe: "/usr/bin/java", Cmdline: []string{"java", "-jar", "/opt/billing/billing.jar"}}
+	assert.Equal(t, []string{"runtime:jvm", "service:billing"}, classifyProcess(cfg, fp))
+
+	// Arguments that aren't reported don't end up in a service name either.
+	cfg.Scrubber.StripAllArguments = true
+	assert.Equal(t, []string{"runtime:jvm"}, classifyProcess(cfg, fp))
+	assert.Equal(t, []string{"java", "-jar", "/opt/billing/billing.jar"}, fp.Cmdline)
+}
+
+func TestServiceDetectorCache(t *testing.T) {
+	cfg := config.NewDefaultAgentConfig()
+	d := newServiceDetector()
+	calls := 0
+	d.classify = func(cfg *config.AgentConfig, fp *process.FilledProcess) []string {
+		calls++
+		return []string{"service:" + fp.Exe}
+	}
+
+	procs := map[int32]*process.FilledProcess{
+		1: {Pid: 1, CreateTime: 10, Exe: "a", Cmdline: []string{"a"}},
+		2: {Pid: 2, CreateTime: 20, Exe: "b", Cmdline: []string{"b"}},
+		3: {Pid: 3, CreateTime: 30},
+	}
+	d.update(cfg, procs)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []string{"service:a"}, d.tags(1))
+	assert.Nil(t, d.tags(3))
+
+	d.update(cfg, procs)
+	assert.Equal(t, 2, calls)
+
+	// Exec'ed a new program.
+	procs[1] = &process.FilledProcess{Pid: 1, CreateTime: 10, Exe: "c", Cmdline: []string{"c"}}
+	delete(procs, 2)
+	d.update(cfg, procs)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, []string{"service:c"}, d.tags(1))
+	assert.Nil(t, d.tags(2))
+}
@@ -0,0 +1,30 @@
+package checks
+
+import (
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// systemdUnit finds the systemd unit, slice and scope of a process from the
+// cgroup systemd placed it in, e.g. "/system.slice/nginx.service" or
+// "/user.slice/user-1000.slice/session-3.scope". The innermost unit is kept,
+// e.g. the service of a user manager rather than the manager itself.
+func systemdUnit(path string) *model.SystemdUnit {
+	unit := &model.SystemdUnit{}
+	for _, part := range strings.Split(path, "/") {
+		switch {
+		case strings.HasSuffix(part, ".slice"):
+			unit.Slice = part
+		case strings.HasSuffix(part, ".scope"):
+			unit.Unit = part
+			unit.Scope = part
+		case strings.HasSuffix(part, ".service"):
+			unit.Unit = part
+		}
+	}
+	if unit.Unit == "" && unit.Slice == "" {
+		return nil
+	}
+	return unit
+}
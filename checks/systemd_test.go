@@ -0,0 +1,42 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestSystemdUnit(t *testing.T) {
+	for _, tc := range []struct {
+		path     string
+		expected *model.SystemdUnit
+	}{
+		{
+			path:     "/system.slice/nginx.service",
+			expected: &model.SystemdUnit{Unit: "nginx.service", Slice: "system.slice"},
+		},
+		{
+			path:     "/user.slice/user-1000.slice/session-3.scope",
+			expected: &model.SystemdUnit{Unit: "session-3.scope", Slice: "user-1000.slice", Scope: "session-3.scope"},
+		},
+		{
+			path:     "/user.slice/user-1000.slice/user@1000.service/app.slice/syncthing.service",
+			expected: &model.SystemdUnit{Unit: "syncthing.service", Slice: "app.slice"},
+		},
+		{
+			path:     "/system.slice/docker-2b6d.scope",
+			expected: &model.SystemdUnit{Unit: "docker-2b6d.scope", Slice: "system.slice", Scope: "docker-2b6d.scope"},
+		},
+		{
+			path:     "/system.slice",
+			expected: &model.SystemdUnit{Slice: "system.slice"},
+		},
+		{path: "/", expected: nil},
+		{path: "/lxc/web", expected: nil},
+		{path: "", expected: nil},
+	} {
+		assert.Equal(t, tc.expected, systemdUnit(tc.path), tc.path)
+	}
+}
@@ -0,0 +1,172 @@
+// +build linux
+
+package checks
+
+import (
+	"fmt"
+	"syscall"
+	"time"
+)
+
+// Generic netlink and taskstats constants (see linux/genetlink.h and
+// linux/taskstats.h).
+const (
+	netlinkGeneric = 16
+
+	genlIDCtrl            = 0x10
+	ctrlCmdGetFamily      = 3
+	ctrlAttrFamilyID      = 1
+	ctrlAttrFamilyName    = 2
+	genlHeaderLen         = 4
+	taskstatsCmdGet       = 1
+	taskstatsCmdAttrTGID  = 2
+	taskstatsTypeStats    = 3
+	taskstatsTypeAggrTGID = 5
+
+	// Offsets in struct taskstats.
+	taskstatsCPUDelayTotal    = 24
+	taskstatsBlkioDelayTotal  = 40
+	taskstatsSwapinDelayTotal = 56
+	taskstatsMinLen           = 64
+)
+
+// taskstatsClient queries the delay accounting of processes from the kernel
+// over generic netlink. It requires the CAP_NET_ADMIN capability.
+type taskstatsClient struct {
+	fd     int
+	family uint16
+	seq    uint32
+	buf    []byte
+}
+
+// taskstatsDelays are the cumulative delays of all the threads of a process,
+// in nanoseconds.
+type taskstatsDelays struct {
+	cpu, blkio, swapin uint64
+}
+
+func newTaskstatsClient() (*taskstatsClient, error) {
+	fd, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_RAW|syscall.SOCK_CLOEXEC, netlinkGeneric)
+	if err != nil {
+		return nil, err
+	}
+	c := &taskstatsClient{fd: fd, buf: make([]byte, 4096)}
+	tv := syscall.NsecToTimeval(int64(time.Second))
+	if err := syscall.SetsockoptTimeval(fd, syscall.SOL_SOCKET, syscall.SO_RCVTIMEO, &tv); err != nil {
+		c.close()
+		return nil, err
+	}
+	if err := syscall.Bind(fd, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		c.close()
+		return nil, err
+	}
+
+	attrs, err := c.request(genlIDCtrl, ctrlCmdGetFamily, ctrlAttrFamilyName, []byte("TASKSTATS\x00"))
+	if err != nil {
+		c.close()
+		return nil, fmt.Errorf("taskstats family not found: %s", err)
+	}
+	id, ok := attrs[ctrlAttrFamilyID]
+	if !ok || len(id) < 2 {
+		c.close()
+		return nil, fmt.Errorf("taskstats family not found")
+	}
+	c.family = nativeEndian.Uint16(id)
+	return c, nil
+}
+
+// delays returns the delays of a process, summed over its threads.
+func (c *taskstatsClient) delays(pid int32) (taskstatsDelays, error) {
+	tgid := make([]byte, 4)
+	nativeEndian.PutUint32(tgid, uint32(pid))
+	attrs, err := c.request(c.family, taskstatsCmdGet, taskstatsCmdAttrTGID, tgid)
+	if err != nil {
+		return taskstatsDelays{}, err
+	}
+	stats := parseNetlinkAttrs(attrs[taskstatsTypeAggrTGID])[taskstatsTypeStats]
+	if len(stats) < taskstatsMinLen {
+		return taskstatsDelays{}, fmt.Errorf("invalid taskstats reply")
+	}
+	return taskstatsDelays{
+		cpu:    nativeEndian.Uint64(stats[taskstatsCPUDelayTotal:]),
+		blkio:  nativeEndian.Uint64(stats[taskstatsBlkioDelayTotal:]),
+		swapin: nativeEndian.Uint64(stats[taskstatsSwapinDelayTotal:]),
+	}, nil
+}
+
+// request sends a generic netlink command with a single attribute, and
+// returns the attributes of the reply.
+func (c *taskstatsClient) request(family uint16, cmd uint8, attrType uint16, attr []byte) (map[uint16][]byte, error) {
+	c.seq++
+	attrLen := syscall.NLA_HDRLEN + len(attr)
+	msg := make([]byte, syscall.NLMSG_HDRLEN+genlHeaderLen+nlaAlign(attrLen))
+	nativeEndian.PutUint32(msg[0:], uint32(len(msg)))
+	nativeEndian.PutUint16(msg[4:], family)
+	nativeEndian.PutUint16(msg[6:], syscall.NLM_F_REQUEST)
+	nativeEndian.PutUint32(msg[8:], c.seq)
+	msg[syscall.NLMSG_HDRLEN] = cmd
+	msg[syscall.NLMSG_HDRLEN+1] = 1 // version
+	a := msg[syscall.NLMSG_HDRLEN+genlHeaderLen:]
+	nativeEndian.PutUint16(a[0:], uint16(attrLen))
+	nativeEndian.PutUint16(a[2:], attrType)
+	copy(a[syscall.NLA_HDRLEN:], attr)
+
+	if err := syscall.Sendto(c.fd, msg, 0, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		return nil, err
+	}
+	for {
+		n, _, err := syscall.Recvfrom(c.fd, c.buf, 0)
+		if err != nil {
+			return nil, err
+		}
+		msgs, err := syscall.ParseNetlinkMessage(c.buf[:n])
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range msgs {
+			if m.Header.Seq != c.seq {
+				// A late reply to a request that timed out.
+				continue
+			}
+			if m.Header.Type == syscall.NLMSG_ERROR {
+				if len(m.Data) >= 4 {
+					if errno := int32(nativeEndian.Uint32(m.Data)); errno != 0 {
+						return nil, syscall.Errno(-errno)
+					}
+				}
+				return nil, fmt.Errorf("empty reply")
+			}
+			if len(m.Data) < genlHeaderLen {
+				return nil, fmt.Errorf("invalid reply")
+			}
+			return parseNetlinkAttrs(m.Data[genlHeaderLen:]), nil
+		}
+	}
+}
+
+func (c *taskstatsClient) close() {
+	syscall.Close(c.fd)
+}
+
+// parseNetlinkAttrs splits a list of netlink attributes by type.
+func parseNetlinkAttrs(data []byte) map[uint16][]byte {
+	attrs := make(map[uint16][]byte)
+	for len(data) >= syscall.NLA_HDRLEN {
+		l := int(nativeEndian.Uint16(data[0:]))
+		// The top bits are flags, e.g. for nested attributes.
+		t := nativeEndian.Uint16(data[2:]) & 0x3fff
+		if l < syscall.NLA_HDRLEN || l > len(data) {
+			break
+		}
+		attrs[t] = data[syscall.NLA_HDRLEN:l]
+		if nlaAlign(l) >= len(data) {
+			break
+		}
+		data = data[nlaAlign(l):]
+	}
+	return attrs
+}
+
+func nlaAlign(l int) int {
+	return (l + syscall.NLA_ALIGNTO - 1) & ^(syscall.NLA_ALIGNTO - 1)
+}
@@ -0,0 +1,58 @@
+package checks
+
+import (
+	"net"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// connTuple identifies a connection by its local and remote endpoints.
+type connTuple struct {
+	laddr, raddr string
+	lport, rport int32
+}
+
+// newConnTuple normalizes the addresses so that an IPv4 address mapped in
+// IPv6 matches its IPv4 form.
+func newConnTuple(laddr, raddr *model.Addr) connTuple {
+	return connTuple{
+		laddr: normalizeIP(laddr.Ip),
+		lport: laddr.Port,
+		raddr: normalizeIP(raddr.Ip),
+		rport: raddr.Port,
+	}
+}
+
+func normalizeIP(ip string) string {
+	if parsed := net.ParseIP(ip); parsed != nil {
+		return parsed.String()
+	}
+	return ip
+}
+
+// tcpDiag is the state and the stats of a TCP socket, as read from sock_diag.
+type tcpDiag struct {
+	state uint8
+	stats model.TCPStats
+}
+
+// addTCPStats sets the state and the stats of the TCP connections found in
+// diags. The tuples of diags are only unique within a network namespace, so
+// only the connections of the processes sameNetNs accepts are joined.
+func addTCPStats(cxs []*model.Connection, diags map[connTuple]tcpDiag, sameNetNs func(pid int32) bool) {
+	if len(diags) == 0 {
+		return
+	}
+	for _, cx := range cxs {
+		if cx.Type != model.ConnectionType_tcp || !sameNetNs(cx.Pid) {
+			continue
+		}
+		d, ok := diags[newConnTuple(cx.Laddr, cx.Raddr)]
+		if !ok {
+			continue
+		}
+		stats := d.stats
+		cx.TcpState = model.TCPState(d.state)
+		cx.TcpStats = &stats
+	}
+}
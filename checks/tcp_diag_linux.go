@@ -0,0 +1,181 @@
+// +build linux
+
+package checks
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// sock_diag and inet_diag constants (see linux/sock_diag.h, linux/inet_diag.h
+// and linux/tcp.h).
+const (
+	netlinkSockDiag  = 4
+	sockDiagByFamily = 20
+	inetDiagInfo     = 2
+
+	inetDiagReqLen = 56
+	inetDiagMsgLen = 72
+
+	// Offsets in struct inet_diag_msg.
+	inetDiagMsgState  = 1
+	inetDiagMsgSport  = 4
+	inetDiagMsgDport  = 6
+	inetDiagMsgSrc    = 8
+	inetDiagMsgDst    = 24
+	inetDiagMsgRqueue = 56
+	inetDiagMsgWqueue = 60
+
+	// Offsets in struct tcp_info.
+	tcpInfoRtt          = 68
+	tcpInfoRttVar       = 72
+	tcpInfoSndCwnd      = 80
+	tcpInfoTotalRetrans = 100
+	tcpInfoMinLen       = 104
+)
+
+// readTCPDiag dumps the IPv4 and IPv6 TCP sockets of the network namespace of
+// the agent from sock_diag, with their tcp_info, by connection tuple.
+// Listening sockets are left out.
+func readTCPDiag() (map[connTuple]tcpDiag, error) {
+	fd, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_RAW|syscall.SOCK_CLOEXEC, netlinkSockDiag)
+	if err != nil {
+		return nil, err
+	}
+	defer syscall.Close(fd)
+	tv := syscall.NsecToTimeval(int64(time.Second))
+	if err := syscall.SetsockoptTimeval(fd, syscall.SOL_SOCKET, syscall.SO_RCVTIMEO, &tv); err != nil {
+		return nil, err
+	}
+
+	diags := make(map[connTuple]tcpDiag)
+	buf := make([]byte, 32*1024)
+	for i, family := range []uint8{syscall.AF_INET, syscall.AF_INET6} {
+		seq := uint32(i + 1)
+		if err := syscall.Sendto(fd, inetDiagRequest(family, seq), 0, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+			return nil, err
+		}
+		if err := receiveTCPDiag(fd, buf, seq, diags); err != nil {
+			return nil, err
+		}
+	}
+	return diags, nil
+}
+
+// inAgentNetNamespace returns a func telling whether a process is in the
+// network namespace of the agent, the only one whose sockets readTCPDiag
+// dumps. The namespace of each process is read once.
+func inAgentNetNamespace() func(pid int32) bool {
+	self := netNamespaceInode("/proc/self/ns/net")
+	namespaces := make(map[int32]uint64)
+	return func(pid int32) bool {
+		ns, ok := namespaces[pid]
+		if !ok {
+			ns = netNamespaceInode(util.HostProc(strconv.Itoa(int(pid)), "ns", "net"))
+			namespaces[pid] = ns
+		}
+		return self != 0 && ns == self
+	}
+}
+
+func netNamespaceInode(path string) uint64 {
+	link, err := os.Readlink(path)
+	if err != nil {
+		return 0
+	}
+	return parseNamespaceInode(link)
+}
+
+// inetDiagRequest builds a dump request of the TCP sockets of a family in
+// any state but listening, asking for their tcp_info.
+func inetDiagRequest(family uint8, seq uint32) []byte {
+	msg := make([]byte, syscall.NLMSG_HDRLEN+inetDiagReqLen)
+	nativeEndian.PutUint32(msg[0:], uint32(len(msg)))
+	nativeEndian.PutUint16(msg[4:], sockDiagByFamily)
+	nativeEndian.PutUint16(msg[6:], syscall.NLM_F_REQUEST|syscall.NLM_F_DUMP)
+	nativeEndian.PutUint32(msg[8:], seq)
+	req := msg[syscall.NLMSG_HDRLEN:]
+	req[0] = family
+	req[1] = syscall.IPPROTO_TCP
+	req[2] = 1 << (inetDiagInfo - 1)
+	nativeEndian.PutUint32(req[4:], ^uint32(1<<tcpListen))
+	return msg
+}
+
+// receiveTCPDiag reads the replies to a dump request until its end.
+func receiveTCPDiag(fd int, buf []byte, seq uint32, diags map[connTuple]tcpDiag) error {
+	for {
+		n, _, err := syscall.Recvfrom(fd, buf, 0)
+		if err != nil {
+			return err
+		}
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			return err
+		}
+		for _, m := range msgs {
+			if m.Header.Seq != seq {
+				continue
+			}
+			switch m.Header.Type {
+			case syscall.NLMSG_DONE:
+				return nil
+			case syscall.NLMSG_ERROR:
+				if len(m.Data) >= 4 {
+					if errno := int32(nativeEndian.Uint32(m.Data)); errno != 0 {
+						return syscall.Errno(-errno)
+					}
+				}
+				return fmt.Errorf("empty reply")
+			case sockDiagByFamily:
+				if tuple, d, ok := parseInetDiagMsg(m.Data); ok {
+					diags[tuple] = d
+				}
+			}
+		}
+	}
+}
+
+// parseInetDiagMsg parses a struct inet_diag_msg followed by its attributes.
+func parseInetDiagMsg(data []byte) (connTuple, tcpDiag, bool) {
+	if len(data) < inetDiagMsgLen {
+		return connTuple{}, tcpDiag{}, false
+	}
+	var src, dst net.IP
+	switch data[0] {
+	case syscall.AF_INET:
+		src = net.IP(data[inetDiagMsgSrc : inetDiagMsgSrc+4])
+		dst = net.IP(data[inetDiagMsgDst : inetDiagMsgDst+4])
+	case syscall.AF_INET6:
+		src = net.IP(data[inetDiagMsgSrc : inetDiagMsgSrc+16])
+		dst = net.IP(data[inetDiagMsgDst : inetDiagMsgDst+16])
+	default:
+		return connTuple{}, tcpDiag{}, false
+	}
+	tuple := newConnTuple(
+		&model.Addr{Ip: src.String(), Port: int32(binary.BigEndian.Uint16(data[inetDiagMsgSport:]))},
+		&model.Addr{Ip: dst.String(), Port: int32(binary.BigEndian.Uint16(data[inetDiagMsgDport:]))},
+	)
+	d := tcpDiag{
+		state: data[inetDiagMsgState],
+		stats: model.TCPStats{
+			RecvQueue: nativeEndian.Uint32(data[inetDiagMsgRqueue:]),
+			SendQueue: nativeEndian.Uint32(data[inetDiagMsgWqueue:]),
+		},
+	}
+	if info := parseNetlinkAttrs(data[inetDiagMsgLen:])[inetDiagInfo]; len(info) >= tcpInfoMinLen {
+		d.stats.Rtt = nativeEndian.Uint32(info[tcpInfoRtt:])
+		d.stats.RttVar = nativeEndian.Uint32(info[tcpInfoRttVar:])
+		d.stats.SndCwnd = nativeEndian.Uint32(info[tcpInfoSndCwnd:])
+		d.stats.Retransmits = nativeEndian.Uint32(info[tcpInfoTotalRetrans:])
+	}
+	return tuple, d, true
+}
@@ -0,0 +1,122 @@
+// +build linux
+
+package checks
+
+import (
+	"encoding/binary"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestParseInetDiagMsg(t *testing.T) {
+	data := make([]byte, inetDiagMsgLen+syscall.NLA_HDRLEN+tcpInfoMinLen)
+	data[0] = syscall.AF_INET
+	data[1] = tcpEstablished
+	binary.BigEndian.PutUint16(data[4:], 4242)
+	binary.BigEndian.PutUint16(data[6:], 80)
+	copy(data[8:], net.ParseIP("10.0.0.1").To4())
+	copy(data[24:], net.ParseIP("10.0.0.2").To4())
+	nativeEndian.PutUint32(data[56:], 11)   // idiag_rqueue
+	nativeEndian.PutUint32(data[60:], 22)   // idiag_wqueue
+	nativeEndian.PutUint32(data[64:], 1000) // idiag_uid
+	nativeEndian.PutUint32(data[68:], 4567) // idiag_inode
+	attr := data[inetDiagMsgLen:]
+	nativeEndian.PutUint16(attr[0:], uint16(syscall.NLA_HDRLEN+tcpInfoMinLen))
+	nativeEndian.PutUint16(attr[2:], inetDiagInfo)
+	info := attr[syscall.NLA_HDRLEN:]
+	nativeEndian.PutUint32(info[68:], 1500) // tcpi_rtt
+	nativeEndian.PutUint32(info[72:], 300)  // tcpi_rttvar
+	nativeEndian.PutUint32(info[80:], 10)   // tcpi_snd_cwnd
+	nativeEndian.PutUint32(info[100:], 3)   // tcpi_total_retrans
+
+	tuple, d, ok := parseInetDiagMsg(data)
+	require.True(t, ok)
+	assert.Equal(t, newConnTuple(&model.Addr{Ip: "10.0.0.1", Port: 4242}, &model.Addr{Ip: "10.0.0.2", Port: 80}), tuple)
+	assert.Equal(t, uint8(tcpEstablished), d.state)
+	assert.Equal(t, model.TCPStats{Rtt: 1500, RttVar: 300, Retransmits: 3, SndCwnd: 10, RecvQueue: 11, SendQueue: 22}, d.stats)
+
+	_, _, ok = parseInetDiagMsg(data[:inetDiagMsgLen-1])
+	assert.False(t, ok)
+}
+
+func TestInAgentNetNamespace(t *testing.T) {
+	if netNamespaceInode("/proc/self/ns/net") == 0 {
+		t.Skip("network namespaces unavailable")
+	}
+	sameNetNs := inAgentNetNamespace()
+	assert.True(t, sameNetNs(int32(os.Getpid())))
+	assert.False(t, sameNetNs(-1))
+}
+
+func TestReadTCPDiag(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+
+	diags, err := readTCPDiag()
+	if err != nil {
+		t.Skipf("sock_diag unavailable: %s", err)
+	}
+
+	laddr := conn.LocalAddr().(*net.TCPAddr)
+	raddr := conn.RemoteAddr().(*net.TCPAddr)
+	d, ok := diags[newConnTuple(
+		&model.Addr{Ip: laddr.IP.String(), Port: int32(laddr.Port)},
+		&model.Addr{Ip: raddr.IP.String(), Port: int32(raddr.Port)},
+	)]
+	require.True(t, ok)
+	assert.Equal(t, uint8(tcpEstablished), d.state)
+	assert.NotZero(t, d.stats.SndCwnd)
+
+	_, ok = diags[newConnTuple(&model.Addr{Ip: raddr.IP.String(), Port: int32(raddr.Port)}, &model.Addr{Ip: "0.0.0.0"})]
+	assert.False(t, ok, "listening socket reported")
+}
+
+func TestProcfsConnectionsTCPStats(t *testing.T) {
+	if _, err := readTCPDiag(); err != nil {
+		t.Skipf("sock_diag unavailable: %s", err)
+	}
+	ln, err := net.Listen("tcp4", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	conn, err := net.Dial("tcp4", ln.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	port := int32(conn.LocalAddr().(*net.TCPAddr).Port)
+
+	pid := int32(os.Getpid())
+	lastProcs := Process.lastProcs
+	defer func() { Process.lastProcs = lastProcs }()
+	Process.lastProcs = map[int32]*process.FilledProcess{pid: {Pid: pid, CreateTime: 1234}}
+
+	c := &ConnectionsCheck{useProcfs: true, collectTCPStats: true}
+	msgs, err := c.Run(config.NewDefaultAgentConfig(), 1)
+	require.NoError(t, err)
+
+	var found *model.Connection
+	for _, m := range msgs {
+		for _, cx := range m.(*model.CollectorConnections).Connections {
+			if cx.Laddr.Port == port {
+				found = cx
+			}
+		}
+	}
+	require.NotNil(t, found)
+	require.NotNil(t, found.TcpStats)
+	assert.Equal(t, model.TCPState_established, found.TcpState)
+	assert.NotZero(t, found.TcpStats.SndCwnd)
+}
@@ -0,0 +1,15 @@
+// +build !linux
+
+package checks
+
+import (
+	"github.com/DataDog/tcptracer-bpf/pkg/tracer"
+)
+
+func inAgentNetNamespace() func(pid int32) bool {
+	return func(int32) bool { return false }
+}
+
+func readTCPDiag() (map[connTuple]tcpDiag, error) {
+	return nil, tracer.ErrNotImplemented
+}
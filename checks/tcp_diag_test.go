@@ -0,0 +1,53 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func TestAddTCPStats(t *testing.T) {
+	diags := map[connTuple]tcpDiag{
+		newConnTuple(&model.Addr{Ip: "::ffff:10.0.0.1", Port: 4242}, &model.Addr{Ip: "10.0.0.2", Port: 80}): {
+			state: 1, // TCP_ESTABLISHED
+			stats: model.TCPStats{Rtt: 1500, RttVar: 300, Retransmits: 2, SndCwnd: 10, RecvQueue: 1, SendQueue: 20},
+		},
+	}
+	matching := &model.Connection{
+		Pid:   1,
+		Type:  model.ConnectionType_tcp,
+		Laddr: &model.Addr{Ip: "10.0.0.1", Port: 4242},
+		Raddr: &model.Addr{Ip: "10.0.0.2", Port: 80},
+	}
+	udp := &model.Connection{
+		Pid:   1,
+		Type:  model.ConnectionType_udp,
+		Laddr: &model.Addr{Ip: "10.0.0.1", Port: 4242},
+		Raddr: &model.Addr{Ip: "10.0.0.2", Port: 80},
+	}
+	other := &model.Connection{
+		Pid:   1,
+		Type:  model.ConnectionType_tcp,
+		Laddr: &model.Addr{Ip: "10.0.0.1", Port: 4243},
+		Raddr: &model.Addr{Ip: "10.0.0.2", Port: 80},
+	}
+
+	// Same tuple, but in another network namespace.
+	otherNs := &model.Connection{
+		Pid:   2,
+		Type:  model.ConnectionType_tcp,
+		Laddr: &model.Addr{Ip: "10.0.0.1", Port: 4242},
+		Raddr: &model.Addr{Ip: "10.0.0.2", Port: 80},
+	}
+
+	sameNetNs := func(pid int32) bool { return pid == 1 }
+	addTCPStats([]*model.Connection{matching, udp, other, otherNs}, diags, sameNetNs)
+	assert.Equal(t, model.TCPState_established, matching.TcpState)
+	assert.Equal(t, &model.TCPStats{Rtt: 1500, RttVar: 300, Retransmits: 2, SndCwnd: 10, RecvQueue: 1, SendQueue: 20}, matching.TcpStats)
+	assert.Nil(t, udp.TcpStats)
+	assert.Nil(t, other.TcpStats)
+	assert.Nil(t, otherNs.TcpStats)
+	assert.Equal(t, model.TCPState_tcpStateUnknown, other.TcpState)
+}
@@ -0,0 +1,86 @@
+package checks
+
+import (
+	"os/user"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// usernameCacheMaxSize bounds how many user names are kept in memory.
+	usernameCacheMaxSize = 4096
+	// usernameCacheTTL is how long a name is kept, users may be created or
+	// renamed while the agent runs.
+	usernameCacheTTL = 10 * time.Minute
+)
+
+// usernames is shared by the checks looking up the name of process users.
+var usernames = newUsernameCache(usernameCacheMaxSize, usernameCacheTTL)
+
+type usernameEntry struct {
+	name    string
+	expires time.Time
+}
+
+// usernameCache caches the name of users by uid, so that they're not looked
+// up for every process on every run. Failed lookups are cached as well: uids
+// that only exist in a container are common and the most expensive to miss.
+type usernameCache struct {
+	sync.Mutex
+
+	entries map[int32]usernameEntry
+	maxSize int
+	ttl     time.Duration
+
+	// Overridden in tests.
+	now    func() time.Time
+	lookup func(uid int32) (string, error)
+}
+
+func newUsernameCache(maxSize int, ttl time.Duration) *usernameCache {
+	return &usernameCache{
+		entries: make(map[int32]usernameEntry),
+		maxSize: maxSize,
+		ttl:     ttl,
+		now:     time.Now,
+		lookup:  lookupUsername,
+	}
+}
+
+// get returns the name of the user, or an empty string if it is unknown.
+func (c *usernameCache) get(uid int32) string {
+	c.Lock()
+	defer c.Unlock()
+
+	now := c.now()
+	if e, ok := c.entries[uid]; ok && now.Before(e.expires) {
+		return e.name
+	}
+	name, _ := c.lookup(uid)
+	if len(c.entries) >= c.maxSize {
+		c.evict(now)
+	}
+	c.entries[uid] = usernameEntry{name: name, expires: now.Add(c.ttl)}
+	return name
+}
+
+// evict drops the expired entries, or all of them if none expired.
+func (c *usernameCache) evict(now time.Time) {
+	for uid, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, uid)
+		}
+	}
+	if len(c.entries) >= c.maxSize {
+		c.entries = make(map[int32]usernameEntry)
+	}
+}
+
+func lookupUsername(uid int32) (string, error) {
+	u, err := user.LookupId(strconv.Itoa(int(uid)))
+	if err != nil {
+		return "", err
+	}
+	return u.Username, nil
+}
@@ -0,0 +1,50 @@
+package checks
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsernameCache(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Unix(1000, 0)
+	lookups := 0
+	c := newUsernameCache(3, time.Minute)
+	c.now = func() time.Time { return now }
+	c.lookup = func(uid int32) (string, error) {
+		lookups++
+		if uid >= 1000 {
+			return "", fmt.Errorf("unknown user %d", uid)
+		}
+		return fmt.Sprintf("user%d", uid), nil
+	}
+
+	assert.Equal("user0", c.get(0))
+	assert.Equal("user0", c.get(0))
+	assert.Equal(1, lookups)
+
+	// Failures are cached too.
+	assert.Equal("", c.get(1000))
+	assert.Equal("", c.get(1000))
+	assert.Equal(2, lookups)
+
+	// Expired.
+	now = now.Add(time.Minute)
+	assert.Equal("user0", c.get(0))
+	assert.Equal(3, lookups)
+
+	// Full, the expired entry is evicted first.
+	assert.Equal("user1", c.get(1))
+	assert.Len(c.entries, 3)
+	assert.Equal("user2", c.get(2))
+	assert.Len(c.entries, 3)
+	_, ok := c.entries[1000]
+	assert.False(ok)
+
+	// Full without expired entries, everything is evicted.
+	assert.Equal("user3", c.get(3))
+	assert.Len(c.entries, 1)
+}
@@ -0,0 +1,44 @@
+// +build linux
+
+package checks
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// readGroups returns the supplementary groups of a process.
+func readGroups(pid int32) []int32 {
+	f, err := os.Open(util.HostProc(strconv.Itoa(int(pid)), "status"))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	return parseStatusGroups(f)
+}
+
+// parseStatusGroups extracts the supplementary groups from the content of
+// /proc/<pid>/status.
+func parseStatusGroups(r io.Reader) []int32 {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "Groups:") {
+			continue
+		}
+		fields := strings.Fields(line[len("Groups:"):])
+		groups := make([]int32, 0, len(fields))
+		for _, f := range fields {
+			if gid, err := strconv.ParseInt(f, 10, 32); err == nil {
+				groups = append(groups, int32(gid))
+			}
+		}
+		return groups
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// +build linux
+
+package checks
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/gopsutil/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatUser(t *testing.T) {
+	assert := assert.New(t)
+	fp := makeProcess(int32(os.Getpid()), "setuid-binary")
+	fp.Uids = []int32{1000, 0, 0, 0}
+	fp.Gids = []int32{100, 100, 50, 100}
+
+	groups := readAllGroups(map[int32]*process.FilledProcess{fp.Pid: fp})
+	u := formatUser(fp, groups[fp.Pid])
+	assert.Equal(int32(1000), u.Uid)
+	assert.Equal(int32(0), u.Euid)
+	assert.Equal(int32(0), u.Suid)
+	assert.Equal(int32(0), u.Fsuid)
+	assert.Equal(int32(100), u.Gid)
+	assert.Equal(int32(100), u.Egid)
+	assert.Equal(int32(50), u.Sgid)
+	assert.Equal(int32(100), u.Fsgid)
+	gids, err := os.Getgroups()
+	assert.NoError(err)
+	assert.Len(u.Groups, len(gids))
+}
+
+func TestParseStatusGroups(t *testing.T) {
+	status := "Uid:\t1000\t1000\t1000\t1000\nGid:\t1000\t1000\t1000\t1000\nFDSize:\t64\nGroups:\t4 24 27 1000 \nNStgid:\t42\n"
+	assert.Equal(t, []int32{4, 24, 27, 1000}, parseStatusGroups(strings.NewReader(status)))
+	assert.Equal(t, []int32{}, parseStatusGroups(strings.NewReader("Groups:\n")))
+	assert.Nil(t, parseStatusGroups(strings.NewReader("Uid:\t0\t0\t0\t0\n")))
+}
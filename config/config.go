@@ -57,6 +57,38 @@ type APIEndpoint struct {
 	Endpoint *url.URL
 }
 
+// Sink types supported in SinkConfig.
+const (
+	SinkTypeFile       = "file"
+	SinkTypeStdout     = "stdout"
+	SinkTypeWebhook    = "webhook"
+	SinkTypePrometheus = "prometheus"
+	SinkTypeOTLP       = "otlp"
+)
+
+// SinkConfig is an output, other than the Datadog intake, where check results
+// are written to as JSON.
+type SinkConfig struct {
+	Type string
+
+	// File sink: the file to write to, its maximum size in bytes before it is
+	// rotated and how many rotated files to keep.
+	Path     string
+	MaxSize  int64
+	MaxFiles int
+
+	// Webhook and OTLP sinks: the URL payloads are POSTed to, with optional extra headers.
+	URL     *url.URL
+	Headers map[string]string
+	Timeout time.Duration
+
+	// Prometheus sink: the address metrics are served on, the labels to keep
+	// and how many processes and containers to export at most.
+	Address string
+	Labels  []string
+	TopN    int
+}
+
 // AgentConfig is the global config for the process-agent. This information
 // is sourced from config files and the environment variables.
 type AgentConfig struct {
@@ -67,6 +99,9 @@ type AgentConfig struct {
 	LogLevel      string
 	LogToConsole  bool
 	QueueSize     int
+	QueueDir      string
+	QueueMaxBytes int64
+	QueueMaxAge   time.Duration
 	Blacklist     []*regexp.Regexp
 	Scrubber      *DataScrubber
 	MaxProcFDs    int
@@ -80,6 +115,52 @@ type AgentConfig struct {
 	StatsdHost    string
 	StatsdPort    int
 
+	// Listen to the kernel proc connector (Linux only) to report processes
+	// that start and exit between two runs of the process check.
+	CollectShortLivedProcesses bool
+	// Break down the CPU usage of the host and of each process (Linux only)
+	// by CPU, reading the stats of every thread.
+	CollectPerCPUStats bool
+	// Read the PSS, USS and anonymous memory of processes (Linux only), for at
+	// most MemoryDetailsBudget processes per run.
+	CollectMemoryDetails bool
+	MemoryDetailsBudget  int
+	// Read how long processes wait for a CPU, on IO and on swap (Linux only).
+	CollectSchedStats bool
+	// Break down the file descriptors of processes by type (Linux only),
+	// looking at MaxProcFDs of them at most, and report the FdTopPaths files
+	// opened the most times.
+	CollectFdTypes bool
+	FdTopPaths     int
+	// Read the capabilities, seccomp mode and SELinux or AppArmor label of
+	// processes (Linux only).
+	CollectSecurityContext bool
+	// Detect the language runtime of processes and the service they run,
+	// optionally from their DD_SERVICE or OTEL_SERVICE_NAME variable.
+	DetectServices        bool
+	DetectServicesFromEnv bool
+	// The environment variables to report for each process, as glob patterns
+	// of their names, and how many bytes of them at most.
+	EnvAllowlist []string
+	EnvMaxBytes  int
+	// Fingerprint the executables of processes: build id, hash and owning
+	// package (Linux only).
+	CollectExeFingerprints bool
+	// Read the state, round trip time, retransmits and queues of the TCP
+	// connections from sock_diag (Linux only).
+	CollectTCPStats bool
+
+	// Payload delivery retries and per-endpoint circuit breaking
+	MaxRetries              int
+	RetryBaseDelay          time.Duration
+	RetryMaxDelay           time.Duration
+	CircuitBreakerThreshold int
+	CircuitBreakerCooldown  time.Duration
+
+	// Outputs. The Datadog intake can be disabled when sinks are used instead.
+	DisableIntake bool
+	Sinks         []SinkConfig
+
 	// Network collection configuration
 	EnableLocalNetworkTracer bool
 	NetworkTracerSocketPath  string
@@ -119,6 +200,7 @@ func (a AgentConfig) CheckInterval(checkName string) time.Duration {
 const (
 	defaultEndpoint = "https://process.datadoghq.com"
 	maxMessageBatch = 100
+	maxEnvBytes     = 8192
 )
 
 // NewDefaultTransport provides a http transport configuration with sane default timeouts
@@ -156,6 +238,8 @@ func NewDefaultAgentConfig() *AgentConfig {
 		LogLevel:      "info",
 		LogToConsole:  false,
 		QueueSize:     20,
+		QueueMaxBytes: 50 * 1024 * 1024,
+		QueueMaxAge:   6 * time.Hour,
 		MaxProcFDs:    200,
 		MaxPerMessage: 100,
 		AllowRealTime: true,
@@ -166,6 +250,19 @@ func NewDefaultAgentConfig() *AgentConfig {
 		StatsdHost: "127.0.0.1",
 		StatsdPort: 8125,
 
+		// Payload delivery
+		MaxRetries:              3,
+		RetryBaseDelay:          1 * time.Second,
+		RetryMaxDelay:           10 * time.Second,
+		CircuitBreakerThreshold: 5,
+		CircuitBreakerCooldown:  1 * time.Minute,
+
+		// Process memory details
+		MemoryDetailsBudget: 200,
+
+		// Process environment variables
+		EnvMaxBytes: 1024,
+
 		// Path and environment for the dd-agent embedded python
 		DDAgentPy:    defaultDDAgentPy,
 		DDAgentPyEnv: []string{defaultDDAgentPyEnv},
@@ -177,11 +274,13 @@ func NewDefaultAgentConfig() *AgentConfig {
 		// Check config
 		EnabledChecks: containerChecks,
 		CheckIntervals: map[string]time.Duration{
-			"process":     10 * time.Second,
-			"rtprocess":   2 * time.Second,
-			"container":   10 * time.Second,
-			"rtcontainer": 2 * time.Second,
-			"connections": 10 * time.Second,
+			"process":        10 * time.Second,
+			"rtprocess":      2 * time.Second,
+			"container":      10 * time.Second,
+			"rtcontainer":    2 * time.Second,
+			"connections":    10 * time.Second,
+			"process_events": 10 * time.Second,
+			"listeners":      30 * time.Second,
 		},
 
 		// Docker
@@ -290,9 +389,45 @@ func NewAgentConfig(agentIni *File, agentYaml *YamlAgentConfig) (*AgentConfig, e
 			cfg.APIEndpoints[i].Endpoint = u
 		}
 
-		cfg.QueueSize = agentIni.GetIntDefault(ns, "queue_size", cfg.QueueSize)
+		// Like with datadog.yaml, the queue can't be disabled.
+		if size := agentIni.GetIntDefault(ns, "queue_size", cfg.QueueSize); size > 0 {
+			cfg.QueueSize = size
+		}
+		cfg.QueueDir = agentIni.GetDefault(ns, "queue_dir", cfg.QueueDir)
+		cfg.QueueMaxBytes = int64(agentIni.GetIntDefault(ns, "queue_max_bytes", int(cfg.QueueMaxBytes)))
+		cfg.QueueMaxAge = agentIni.GetDurationDefault(ns, "queue_max_age", time.Second, cfg.QueueMaxAge)
+		cfg.MaxRetries = agentIni.GetIntDefault(ns, "max_retries", cfg.MaxRetries)
+		cfg.RetryMaxDelay = agentIni.GetDurationDefault(ns, "retry_max_delay", time.Second, cfg.RetryMaxDelay)
+		cfg.CircuitBreakerThreshold = agentIni.GetIntDefault(ns, "circuit_breaker_threshold", cfg.CircuitBreakerThreshold)
+		cfg.CircuitBreakerCooldown = agentIni.GetDurationDefault(ns, "circuit_breaker_cooldown", time.Second, cfg.CircuitBreakerCooldown)
 		cfg.MaxProcFDs = agentIni.GetIntDefault(ns, "max_proc_fds", cfg.MaxProcFDs)
 		cfg.AllowRealTime = agentIni.GetBool(ns, "allow_real_time", cfg.AllowRealTime)
+		cfg.CollectShortLivedProcesses = agentIni.GetBool(ns, "collect_short_lived_processes", cfg.CollectShortLivedProcesses)
+		cfg.CollectPerCPUStats = agentIni.GetBool(ns, "collect_per_cpu_stats", cfg.CollectPerCPUStats)
+		cfg.CollectMemoryDetails = agentIni.GetBool(ns, "collect_memory_details", cfg.CollectMemoryDetails)
+		cfg.MemoryDetailsBudget = agentIni.GetIntDefault(ns, "memory_details_budget", cfg.MemoryDetailsBudget)
+		cfg.CollectSchedStats = agentIni.GetBool(ns, "collect_sched_stats", cfg.CollectSchedStats)
+		cfg.CollectFdTypes = agentIni.GetBool(ns, "collect_fd_types", cfg.CollectFdTypes)
+		cfg.FdTopPaths = agentIni.GetIntDefault(ns, "fd_top_paths", cfg.FdTopPaths)
+		cfg.CollectSecurityContext = agentIni.GetBool(ns, "collect_security_context", cfg.CollectSecurityContext)
+		cfg.DetectServices = agentIni.GetBool(ns, "detect_services", cfg.DetectServices)
+		cfg.DetectServicesFromEnv = agentIni.GetBool(ns, "detect_services_from_env", cfg.DetectServicesFromEnv)
+		cfg.EnvAllowlist = agentIni.GetStrArrayDefault(ns, "env_allowlist", ",", cfg.EnvAllowlist)
+		cfg.CollectExeFingerprints = agentIni.GetBool(ns, "collect_exe_fingerprints", cfg.CollectExeFingerprints)
+		cfg.CollectTCPStats = agentIni.GetBool(ns, "collect_tcp_stats", cfg.CollectTCPStats)
+		envMaxBytes := agentIni.GetIntDefault(ns, "env_max_bytes", cfg.EnvMaxBytes)
+		if envMaxBytes <= maxEnvBytes {
+			cfg.EnvMaxBytes = envMaxBytes
+		} else {
+			log.Warn("Overriding the configured environment bytes per process because it exceeds maximum")
+			cfg.EnvMaxBytes = maxEnvBytes
+		}
+		if agentIni.GetBool(ns, "process_events_enabled", false) {
+			cfg.EnabledChecks = append(cfg.EnabledChecks, "process_events")
+		}
+		if agentIni.GetBool(ns, "listeners_enabled", false) {
+			cfg.EnabledChecks = append(cfg.EnabledChecks, "listeners")
+		}
 		cfg.LogFile = agentIni.GetDefault(ns, "log_file", cfg.LogFile)
 		cfg.DDAgentPy = agentIni.GetDefault(ns, "dd_agent_py", cfg.DDAgentPy)
 		cfg.DDAgentPyEnv = agentIni.GetStrArrayDefault(ns, "dd_agent_py_env", ",", cfg.DDAgentPyEnv)
@@ -466,6 +601,10 @@ func mergeEnvironmentVariables(c *AgentConfig) *AgentConfig {
 		c.Scrubber.StripAllArguments = true
 	}
 
+	if v := os.Getenv("DD_PROCESS_AGENT_QUEUE_DIR"); v != "" {
+		c.QueueDir = v
+	}
+
 	if v := os.Getenv("DD_AGENT_PY"); v != "" {
 		c.DDAgentPy = v
 	}
@@ -512,6 +651,14 @@ func mergeEnvironmentVariables(c *AgentConfig) *AgentConfig {
 		c.EnabledChecks = append(c.EnabledChecks, "connections")
 	}
 
+	if ok, _ := isAffirmative(os.Getenv("DD_PROCESS_EVENTS_ENABLED")); ok {
+		c.EnabledChecks = append(c.EnabledChecks, "process_events")
+	}
+
+	if ok, _ := isAffirmative(os.Getenv("DD_LISTENERS_ENABLED")); ok {
+		c.EnabledChecks = append(c.EnabledChecks, "listeners")
+	}
+
 	return c
 }
 
@@ -248,6 +248,19 @@ func TestDDAgentConfigWithNewOpts(t *testing.T) {
 	assert.Equal(true, agentConfig.Scrubber.Enabled)
 }
 
+func TestDDAgentConfigZeroQueueSize(t *testing.T) {
+	dd, _ := ini.Load([]byte(strings.Join([]string{
+		"[Main]",
+		"api_key = apikey_12",
+		"[process.config]",
+		"queue_size = 0",
+	}, "\n")))
+
+	agentConfig, err := NewAgentConfig(&File{instance: dd, Path: "whatever"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, NewDefaultAgentConfig().QueueSize, agentConfig.QueueSize)
+}
+
 func TestDDAgentConfigBothVersions(t *testing.T) {
 	assert := assert.New(t)
 	// Check that providing process.* options in the dd-agent conf file works
@@ -297,6 +310,8 @@ func TestDDAgentConfigYamlOnly(t *testing.T) {
 		"  enabled: 'true'",
 		"  process_dd_url: http://my-process-app.datadoghq.com",
 		"  queue_size: 10",
+		"  queue_dir: /var/lib/datadog/process-queue",
+		"  queue_max_age: 600",
 		"  intervals:",
 		"    container: 8",
 		"    process: 30",
@@ -314,6 +329,9 @@ func TestDDAgentConfigYamlOnly(t *testing.T) {
 	assert.Equal("apikey_20", ep.APIKey)
 	assert.Equal("my-process-app.datadoghq.com", ep.Endpoint.Hostname())
 	assert.Equal(10, agentConfig.QueueSize)
+	assert.Equal("/var/lib/datadog/process-queue", agentConfig.QueueDir)
+	assert.Equal(10*time.Minute, agentConfig.QueueMaxAge)
+	assert.Equal(int64(50*1024*1024), agentConfig.QueueMaxBytes)
 	assert.Equal(true, agentConfig.AllowRealTime)
 	assert.Equal(true, agentConfig.Enabled)
 	assert.Equal(processChecks, agentConfig.EnabledChecks)
@@ -412,6 +430,51 @@ func TestDDAgentConfigYamlOnly(t *testing.T) {
 	assert.Equal(true, agentConfig.Scrubber.Enabled)
 }
 
+func TestDDAgentConfigYamlSinks(t *testing.T) {
+	assert := assert.New(t)
+	var ddy YamlAgentConfig
+	err := yaml.Unmarshal([]byte(strings.Join([]string{
+		"api_key: apikey_20",
+		"process_config:",
+		"  disable_intake: true",
+		"  sinks:",
+		"    - type: file",
+		"      path: /var/log/datadog/processes.json",
+		"      max_size: 1024",
+		"    - type: stdout",
+		"    - type: webhook",
+		"      url: http://localhost:8080/processes",
+		"      timeout: 5",
+		"      headers:",
+		"        Authorization: Bearer token",
+	}, "\n")), &ddy)
+	assert.NoError(err)
+
+	agentConfig, err := NewAgentConfig(nil, &ddy)
+	assert.NoError(err)
+	assert.True(agentConfig.DisableIntake)
+	sinks := agentConfig.Sinks
+	assert.Len(sinks, 3)
+	assert.Equal(SinkTypeFile, sinks[0].Type)
+	assert.Equal("/var/log/datadog/processes.json", sinks[0].Path)
+	assert.Equal(int64(1024), sinks[0].MaxSize)
+	assert.Equal(SinkTypeStdout, sinks[1].Type)
+	assert.Equal(SinkTypeWebhook, sinks[2].Type)
+	assert.Equal("localhost:8080", sinks[2].URL.Host)
+	assert.Equal(5*time.Second, sinks[2].Timeout)
+	assert.Equal("Bearer token", sinks[2].Headers["Authorization"])
+
+	ddy = YamlAgentConfig{}
+	err = yaml.Unmarshal([]byte(strings.Join([]string{
+		"process_config:",
+		"  sinks:",
+		"    - type: kafka",
+	}, "\n")), &ddy)
+	assert.NoError(err)
+	_, err = NewAgentConfig(nil, &ddy)
+	assert.Error(err)
+}
+
 func TestProxyEnv(t *testing.T) {
 	assert := assert.New(t)
 	for i, tc := range []struct {
@@ -141,6 +141,63 @@ func (ds *DataScrubber) ScrubProcessCommand(p *process.FilledProcess) []string {
 	return p.Cmdline
 }
 
+// ScrubCommand scrubs a command line without going through the cache, for
+// processes that won't be seen again.
+func (ds *DataScrubber) ScrubCommand(cmdline []string) []string {
+	if ds.StripAllArguments {
+		return ds.stripArguments(cmdline)
+	}
+	if !ds.Enabled {
+		return cmdline
+	}
+	scrubbed, _ := ds.scrubCommand(cmdline)
+	return scrubbed
+}
+
+// ScrubPath hides what follows a part of a file path matching a sensitive
+// word, the way the value of a sensitive argument is hidden: e.g.
+// /run/secret/db.key becomes /run/secret/********.
+func (ds *DataScrubber) ScrubPath(path string) string {
+	if !ds.Enabled {
+		return path
+	}
+	parts := strings.Split(path, "/")
+	for i, part := range parts[:len(parts)-1] {
+		for _, pattern := range ds.SensitivePatterns {
+			if pattern.MatchString(" " + part + "=") {
+				return strings.Join(append(parts[:i+1], "********"), "/")
+			}
+		}
+	}
+	return path
+}
+
+// ScrubEnvVar hides the value of an environment variable named after a
+// sensitive word, e.g. DB_PASSWORD or AWS_SECRET_ACCESS_KEY, and the values of
+// the sensitive arguments it holds, e.g. in JAVA_OPTS.
+func (ds *DataScrubber) ScrubEnvVar(name, value string) string {
+	if !ds.Enabled {
+		return value
+	}
+	// Any run of the words of the name can be the sensitive one.
+	words := strings.Split(name, "_")
+	for i := range words {
+		for j := i + 1; j <= len(words); j++ {
+			key := " " + strings.Join(words[i:j], "_") + "="
+			for _, pattern := range ds.SensitivePatterns {
+				if pattern.MatchString(key) {
+					return "********"
+				}
+			}
+		}
+	}
+	scrubbed, changed := ds.scrubCommand(strings.Split(value, " "))
+	if !changed {
+		return value
+	}
+	return strings.Join(scrubbed, " ")
+}
+
 // IncrementCacheAge increments one cycle of cache memory age. If it reaches
 // cacheMaxCycles, the cache is restarted
 func (ds *DataScrubber) IncrementCacheAge() {
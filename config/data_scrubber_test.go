@@ -387,6 +387,47 @@ func TestScrubWithCache(t *testing.T) {
 	assert.Equal(t, sensible, len(scrubber.scrubbedCmdlines))
 }
 
+func TestScrubPath(t *testing.T) {
+	scrubber := NewDefaultDataScrubber()
+	scrubber.AddCustomSensitiveWords([]string{"vault*"})
+
+	for _, tc := range []struct {
+		path, expected string
+	}{
+		{"/var/log/app.log", "/var/log/app.log"},
+		{"/run/secret/db.key", "/run/secret/********"},
+		{"/etc/Credentials/aws/config", "/etc/Credentials/********"},
+		{"/var/lib/vault-data/token", "/var/lib/vault-data/********"},
+		{"/home/user/.aws/credentials", "/home/user/.aws/credentials"},
+	} {
+		assert.Equal(t, tc.expected, scrubber.ScrubPath(tc.path))
+	}
+
+	scrubber.Enabled = false
+	assert.Equal(t, "/run/secret/db.key", scrubber.ScrubPath("/run/secret/db.key"))
+}
+
+func TestScrubEnvVar(t *testing.T) {
+	scrubber := NewDefaultDataScrubber()
+	scrubber.AddCustomSensitiveWords([]string{"consul_token"})
+
+	for _, tc := range []struct {
+		name, value, expected string
+	}{
+		{"SERVICE_NAME", "billing", "billing"},
+		{"DB_PASSWORD", "hunter2", "********"},
+		{"AWS_SECRET_ACCESS_KEY", "abc", "********"},
+		{"STRIPE_API_KEY", "abc", "********"},
+		{"CONSUL_TOKEN", "abc", "********"},
+		{"JAVA_OPTS", "-Xmx1g -Dfoo=bar --password=hunter2", "-Xmx1g -Dfoo=bar --password=********"},
+	} {
+		assert.Equal(t, tc.expected, scrubber.ScrubEnvVar(tc.name, tc.value), tc.name)
+	}
+
+	scrubber.Enabled = false
+	assert.Equal(t, "hunter2", scrubber.ScrubEnvVar("DB_PASSWORD", "hunter2"))
+}
+
 func BenchmarkRegexMatching1(b *testing.B)    { benchmarkRegexMatching(1, b) }
 func BenchmarkRegexMatching10(b *testing.B)   { benchmarkRegexMatching(10, b) }
 func BenchmarkRegexMatching100(b *testing.B)  { benchmarkRegexMatching(100, b) }
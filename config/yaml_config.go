@@ -39,6 +39,8 @@ type YamlAgentConfig struct {
 			Process           int `yaml:"process"`
 			ProcessRealTime   int `yaml:"process_realtime"`
 			Connections       int `yaml:"connections"`
+			ProcessEvents     int `yaml:"process_events"`
+			Listeners         int `yaml:"listeners"`
 		} `yaml:"intervals"`
 		// A list of regex patterns that will exclude a process if matched.
 		BlacklistPatterns []string `yaml:"blacklist_patterns"`
@@ -49,8 +51,76 @@ type YamlAgentConfig struct {
 		CustomSensitiveWords []string `yaml:"custom_sensitive_words"`
 		// Strips all process arguments
 		StripProcessArguments bool `yaml:"strip_proc_arguments"`
-		// How many check results to buffer in memory when POST fails. The default is usually fine.
+		// Report processes that start and exit between two process check runs, from the
+		// Linux proc connector. Requires the CAP_NET_ADMIN capability.
+		CollectShortLivedProcesses bool `yaml:"collect_short_lived_processes"`
+		// Break down the CPU usage of the host and of each process by CPU, to find processes
+		// stuck on a hot core or running on the wrong CPU set. Reads the stats of every thread.
+		CollectPerCPUStats bool `yaml:"collect_per_cpu_stats"`
+		// Report PSS, USS, swap PSS and anonymous vs file-backed memory, which unlike RSS
+		// don't count shared pages several times. Reading them is expensive, so only a few
+		// processes are read per run and the others report the last values read.
+		CollectMemoryDetails bool `yaml:"collect_memory_details"`
+		// The maximum number of processes whose memory details are read per run. Defaults to 200.
+		MemoryDetailsBudget int `yaml:"memory_details_budget"`
+		// Report the time processes spend waiting for a CPU (from schedstat) and, when delay
+		// accounting is available, on block IO and swap-in. Delays require CAP_NET_ADMIN.
+		CollectSchedStats bool `yaml:"collect_sched_stats"`
+		// Break down the open file descriptors of processes by type: files, sockets, pipes,
+		// eventfd, epoll... At most max_proc_fds descriptors are looked at per process.
+		CollectFdTypes bool `yaml:"collect_fd_types"`
+		// With collect_fd_types, how many of the files opened the most times are reported per
+		// process. Their paths go through the scrubber. Disabled by default.
+		FdTopPaths int `yaml:"fd_top_paths"`
+		// Report the security context of processes: their capabilities, whether seccomp and
+		// no_new_privs are enforced, and their SELinux or AppArmor label.
+		CollectSecurityContext bool `yaml:"collect_security_context"`
+		// Tag processes with their language runtime (JVM, CPython, Node, Ruby, Go, .NET, PHP-FPM)
+		// and the service they run, e.g. the main class or jar of a JVM.
+		DetectServices bool `yaml:"detect_services"`
+		// With detect_services, read the service of processes from their DD_SERVICE or
+		// OTEL_SERVICE_NAME environment variable when set.
+		DetectServicesFromEnv bool `yaml:"detect_services_from_env"`
+		// The environment variables to report for each process, by name. Names can use glob
+		// patterns, e.g. "DD_*". The values go through the scrubber.
+		EnvAllowlist []string `yaml:"env_allowlist"`
+		// How many bytes of environment variables to report per process at most, 1024 by
+		// default and 8192 at most.
+		EnvMaxBytes int `yaml:"env_max_bytes"`
+		// Fingerprint the executables of processes: their GNU build id, SHA-256 and, when a
+		// dpkg or rpm database is present, the package and version they belong to.
+		CollectExeFingerprints bool `yaml:"collect_exe_fingerprints"`
+		// Report the state, round trip time, retransmits, congestion window and queues of the
+		// TCP connections of the connections check, from the sock_diag netlink interface.
+		CollectTCPStats bool `yaml:"collect_tcp_stats"`
+		// Enables the process_events check, reporting processes starting, exiting, exec'ing
+		// or changing user between two runs.
+		ProcessEventsEnabled bool `yaml:"process_events_enabled"`
+		// Enables the listeners check, reporting the processes listening on TCP, UDP and unix
+		// sockets from procfs. It doesn't require the network tracer.
+		ListenersEnabled bool `yaml:"listeners_enabled"`
+		// How many check results to buffer in memory, per endpoint, when POST fails. The default is usually fine.
 		QueueSize int `yaml:"queue_size"`
+		// A directory where payloads that could not be delivered are spooled to disk and
+		// retried in order, also across restarts. Each endpoint gets its own sub-directory. Disabled when empty.
+		QueueDir string `yaml:"queue_dir"`
+		// The maximum size, in bytes, of the on-disk queue. Oldest payloads are dropped first.
+		QueueMaxBytes int64 `yaml:"queue_max_bytes"`
+		// The maximum age, in seconds, of a spooled payload before it is discarded.
+		QueueMaxAge int `yaml:"queue_max_age"`
+		// How many times a failed POST is retried (with jittered exponential backoff) when
+		// the failure is transient: timeouts, 5xx or 429 responses. Set to -1 to disable retries.
+		MaxRetries int `yaml:"max_retries"`
+		// The maximum delay, in seconds, between two retries.
+		RetryMaxDelay int `yaml:"retry_max_delay"`
+		// The number of consecutive failed deliveries after which an endpoint is skipped.
+		CircuitBreakerThreshold int `yaml:"circuit_breaker_threshold"`
+		// How long, in seconds, an endpoint is skipped before trying it again.
+		CircuitBreakerCooldown int `yaml:"circuit_breaker_cooldown"`
+		// Don't submit anything to the Datadog intake, only write to the sinks.
+		DisableIntake bool `yaml:"disable_intake"`
+		// Additional outputs check results are written to as JSON.
+		Sinks []YamlSinkConfig `yaml:"sinks"`
 		// The maximum number of file descriptors to open when collecting net connections.
 		// Only change if you are running out of file descriptors from the Agent.
 		MaxProcFDs int `yaml:"max_proc_fds"`
@@ -80,6 +150,70 @@ type YamlAgentConfig struct {
 	} `yaml:"process_config"`
 }
 
+// YamlSinkConfig is the configuration of a single output sink in datadog.yaml.
+type YamlSinkConfig struct {
+	// One of "file", "stdout", "webhook", "prometheus" or "otlp".
+	Type string `yaml:"type"`
+	// The file written to by a "file" sink.
+	Path string `yaml:"path"`
+	// The size, in bytes, after which the file is rotated. Defaults to 100MB.
+	MaxSize int64 `yaml:"max_size"`
+	// How many rotated files to keep. Defaults to 5.
+	MaxFiles int `yaml:"max_files"`
+	// The URL a "webhook" sink POSTs to, or the OTLP/HTTP metrics endpoint of an "otlp"
+	// sink (defaults to http://localhost:4318/v1/metrics).
+	URL string `yaml:"url"`
+	// Extra headers added to webhook and OTLP requests, e.g. for authentication.
+	Headers map[string]string `yaml:"headers"`
+	// The webhook and OTLP request timeout, in seconds. Defaults to 10.
+	Timeout int `yaml:"timeout"`
+	// The address a "prometheus" sink serves /metrics on. Defaults to localhost:9109.
+	Address string `yaml:"address"`
+	// The labels exported by a "prometheus" sink, among pid, exe, user, container_id,
+	// container_name and image. Series left with the same labels are summed. Defaults to all.
+	Labels []string `yaml:"labels"`
+	// Only export the top N processes and containers by CPU then memory usage. 0 means all.
+	TopN int `yaml:"top_n"`
+}
+
+func (ys YamlSinkConfig) toSinkConfig() (SinkConfig, error) {
+	sc := SinkConfig{
+		Type:     ys.Type,
+		Path:     ys.Path,
+		MaxSize:  ys.MaxSize,
+		MaxFiles: ys.MaxFiles,
+		Headers:  ys.Headers,
+		Timeout:  time.Duration(ys.Timeout) * time.Second,
+		Address:  ys.Address,
+		Labels:   ys.Labels,
+		TopN:     ys.TopN,
+	}
+	switch ys.Type {
+	case SinkTypeFile:
+		if ys.Path == "" {
+			return sc, fmt.Errorf("file sink requires a path")
+		}
+	case SinkTypeStdout, SinkTypePrometheus:
+	case SinkTypeOTLP:
+		if ys.URL != "" {
+			u, err := url.Parse(ys.URL)
+			if err != nil {
+				return sc, fmt.Errorf("invalid otlp sink url '%s'", ys.URL)
+			}
+			sc.URL = u
+		}
+	case SinkTypeWebhook:
+		u, err := url.Parse(ys.URL)
+		if err != nil || ys.URL == "" {
+			return sc, fmt.Errorf("invalid webhook sink url '%s'", ys.URL)
+		}
+		sc.URL = u
+	default:
+		return sc, fmt.Errorf("unknown sink type '%s'", ys.Type)
+	}
+	return sc, nil
+}
+
 // NewYamlIfExists returns a new YamlAgentConfig if the given configPath is exists.
 func NewYamlIfExists(configPath string) (*YamlAgentConfig, error) {
 	var yamlConf YamlAgentConfig
@@ -148,6 +282,14 @@ func mergeYamlConfig(agentConf *AgentConfig, yc *YamlAgentConfig) (*AgentConfig,
 		log.Infof("Overriding connections check interval to %ds", yc.Process.Intervals.Connections)
 		agentConf.CheckIntervals["connections"] = time.Duration(yc.Process.Intervals.Connections) * time.Second
 	}
+	if yc.Process.Intervals.ProcessEvents != 0 {
+		log.Infof("Overriding process events check interval to %ds", yc.Process.Intervals.ProcessEvents)
+		agentConf.CheckIntervals["process_events"] = time.Duration(yc.Process.Intervals.ProcessEvents) * time.Second
+	}
+	if yc.Process.Intervals.Listeners != 0 {
+		log.Infof("Overriding listeners check interval to %ds", yc.Process.Intervals.Listeners)
+		agentConf.CheckIntervals["listeners"] = time.Duration(yc.Process.Intervals.Listeners) * time.Second
+	}
 	blacklist := make([]*regexp.Regexp, 0, len(yc.Process.BlacklistPatterns))
 	for _, b := range yc.Process.BlacklistPatterns {
 		r, err := regexp.Compile(b)
@@ -163,6 +305,53 @@ func mergeYamlConfig(agentConf *AgentConfig, yc *YamlAgentConfig) (*AgentConfig,
 		agentConf.Scrubber.Enabled = *yc.Process.ScrubArgs
 	}
 	agentConf.Scrubber.AddCustomSensitiveWords(yc.Process.CustomSensitiveWords)
+	if yc.Process.CollectShortLivedProcesses {
+		agentConf.CollectShortLivedProcesses = true
+	}
+	if yc.Process.CollectPerCPUStats {
+		agentConf.CollectPerCPUStats = true
+	}
+	if yc.Process.CollectMemoryDetails {
+		agentConf.CollectMemoryDetails = true
+	}
+	if yc.Process.MemoryDetailsBudget > 0 {
+		agentConf.MemoryDetailsBudget = yc.Process.MemoryDetailsBudget
+	}
+	if yc.Process.CollectSchedStats {
+		agentConf.CollectSchedStats = true
+	}
+	if yc.Process.CollectFdTypes {
+		agentConf.CollectFdTypes = true
+	}
+	if yc.Process.FdTopPaths > 0 {
+		agentConf.FdTopPaths = yc.Process.FdTopPaths
+	}
+	if yc.Process.CollectSecurityContext {
+		agentConf.CollectSecurityContext = true
+	}
+	if yc.Process.DetectServices {
+		agentConf.DetectServices = true
+	}
+	if yc.Process.DetectServicesFromEnv {
+		agentConf.DetectServicesFromEnv = true
+	}
+	if yc.Process.CollectExeFingerprints {
+		agentConf.CollectExeFingerprints = true
+	}
+	if yc.Process.CollectTCPStats {
+		agentConf.CollectTCPStats = true
+	}
+	if len(yc.Process.EnvAllowlist) > 0 {
+		agentConf.EnvAllowlist = yc.Process.EnvAllowlist
+	}
+	if yc.Process.EnvMaxBytes > 0 {
+		if yc.Process.EnvMaxBytes <= maxEnvBytes {
+			agentConf.EnvMaxBytes = yc.Process.EnvMaxBytes
+		} else {
+			log.Warn("Overriding the configured environment bytes per process because it exceeds maximum")
+			agentConf.EnvMaxBytes = maxEnvBytes
+		}
+	}
 	if yc.Process.StripProcessArguments {
 		agentConf.Scrubber.StripAllArguments = yc.Process.StripProcessArguments
 	}
@@ -170,6 +359,27 @@ func mergeYamlConfig(agentConf *AgentConfig, yc *YamlAgentConfig) (*AgentConfig,
 	if yc.Process.QueueSize > 0 {
 		agentConf.QueueSize = yc.Process.QueueSize
 	}
+	if yc.Process.QueueDir != "" {
+		agentConf.QueueDir = yc.Process.QueueDir
+	}
+	if yc.Process.QueueMaxBytes > 0 {
+		agentConf.QueueMaxBytes = yc.Process.QueueMaxBytes
+	}
+	if yc.Process.QueueMaxAge > 0 {
+		agentConf.QueueMaxAge = time.Duration(yc.Process.QueueMaxAge) * time.Second
+	}
+	if yc.Process.MaxRetries != 0 {
+		agentConf.MaxRetries = yc.Process.MaxRetries
+	}
+	if yc.Process.RetryMaxDelay > 0 {
+		agentConf.RetryMaxDelay = time.Duration(yc.Process.RetryMaxDelay) * time.Second
+	}
+	if yc.Process.CircuitBreakerThreshold > 0 {
+		agentConf.CircuitBreakerThreshold = yc.Process.CircuitBreakerThreshold
+	}
+	if yc.Process.CircuitBreakerCooldown > 0 {
+		agentConf.CircuitBreakerCooldown = time.Duration(yc.Process.CircuitBreakerCooldown) * time.Second
+	}
 	if yc.Process.MaxProcFDs > 0 {
 		agentConf.MaxProcFDs = yc.Process.MaxProcFDs
 	}
@@ -205,9 +415,24 @@ func mergeYamlConfig(agentConf *AgentConfig, yc *YamlAgentConfig) (*AgentConfig,
 		}
 	}
 
+	agentConf.DisableIntake = yc.Process.DisableIntake
+	for _, ys := range yc.Process.Sinks {
+		sc, err := ys.toSinkConfig()
+		if err != nil {
+			return nil, fmt.Errorf("invalid sink: %s", err)
+		}
+		agentConf.Sinks = append(agentConf.Sinks, sc)
+	}
+
 	if enabled, _ := isAffirmative(yc.Process.NetworkTracingEnabled); enabled {
 		agentConf.EnabledChecks = append(agentConf.EnabledChecks, "connections")
 	}
+	if yc.Process.ProcessEventsEnabled {
+		agentConf.EnabledChecks = append(agentConf.EnabledChecks, "process_events")
+	}
+	if yc.Process.ListenersEnabled {
+		agentConf.EnabledChecks = append(agentConf.EnabledChecks, "listeners")
+	}
 	if socketPath := yc.Process.UnixSocketPath; socketPath != "" {
 		agentConf.NetworkTracerSocketPath = socketPath
 	}
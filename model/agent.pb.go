@@ -12,13 +12,18 @@
 		ResCollector
 		CollectorProc
 		CollectorConnections
+		CollectorListeners
 		CollectorRealTime
 		CollectorContainer
 		CollectorContainerRealTime
+		CollectorProcEvent
 		CollectorReqStatus
 		CollectorStatus
 		Process
+		ShortLivedProcess
+		ProcessEvent
 		Command
+		ExeFingerprint
 		ProcessUser
 		Container
 		ProcessStat
@@ -27,8 +32,19 @@
 		OSInfo
 		IOStat
 		Connection
+		TCPStats
+		Listener
 		Addr
 		MemoryStat
+		FdStat
+		FdPath
+		EnvVar
+		SystemdUnit
+		ProcessSecurity
+		Namespaces
+		ResourceLimit
+		ProcessLimits
+		SchedStat
 		CPUStat
 		SingleCPUStat
 		CPUInfo
@@ -55,6 +71,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type ProcessEventType int32
+
+const (
+	ProcessEventType_unknownEvent ProcessEventType = 0
+	ProcessEventType_start        ProcessEventType = 1
+	ProcessEventType_exit         ProcessEventType = 2
+	ProcessEventType_exec         ProcessEventType = 3
+	ProcessEventType_userChange   ProcessEventType = 4
+)
+
+var ProcessEventType_name = map[int32]string{
+	0: "unknownEvent",
+	1: "start",
+	2: "exit",
+	3: "exec",
+	4: "userChange",
+}
+var ProcessEventType_value = map[string]int32{
+	"unknownEvent": 0,
+	"start":        1,
+	"exit":         2,
+	"exec":         3,
+	"userChange":   4,
+}
+
+func (x ProcessEventType) String() string {
+	return proto.EnumName(ProcessEventType_name, int32(x))
+}
+func (ProcessEventType) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{0} }
+
 // status section in https://docs.docker.com/engine/api/v1.29/#tag/Container
 type ContainerState int32
 
@@ -90,7 +136,7 @@ var ContainerState_value = map[string]int32{
 func (x ContainerState) String() string {
 	return proto.EnumName(ContainerState_name, int32(x))
 }
-func (ContainerState) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{0} }
+func (ContainerState) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{1} }
 
 // https://blog.couchbase.com/docker-health-check-keeping-containers-healthy/
 // health can be: starting, healthy, unhealthy
@@ -119,7 +165,7 @@ var ContainerHealth_value = map[string]int32{
 func (x ContainerHealth) String() string {
 	return proto.EnumName(ContainerHealth_name, int32(x))
 }
-func (ContainerHealth) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{1} }
+func (ContainerHealth) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{2} }
 
 // Process state codes in http://wiki.preshweb.co.uk/doku.php?id=linux:psflags
 type ProcessState int32
@@ -159,7 +205,7 @@ var ProcessState_value = map[string]int32{
 func (x ProcessState) String() string {
 	return proto.EnumName(ProcessState_name, int32(x))
 }
-func (ProcessState) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{2} }
+func (ProcessState) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{3} }
 
 type ConnectionType int32
 
@@ -180,7 +226,7 @@ var ConnectionType_value = map[string]int32{
 func (x ConnectionType) String() string {
 	return proto.EnumName(ConnectionType_name, int32(x))
 }
-func (ConnectionType) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{3} }
+func (ConnectionType) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{4} }
 
 type ConnectionFamily int32
 
@@ -201,7 +247,110 @@ var ConnectionFamily_value = map[string]int32{
 func (x ConnectionFamily) String() string {
 	return proto.EnumName(ConnectionFamily_name, int32(x))
 }
-func (ConnectionFamily) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{4} }
+func (ConnectionFamily) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{5} }
+
+// TCPState is the state of a TCP connection, as numbered by Linux.
+type TCPState int32
+
+const (
+	TCPState_tcpStateUnknown TCPState = 0
+	TCPState_established     TCPState = 1
+	TCPState_synSent         TCPState = 2
+	TCPState_synRecv         TCPState = 3
+	TCPState_finWait1        TCPState = 4
+	TCPState_finWait2        TCPState = 5
+	TCPState_timeWait        TCPState = 6
+	TCPState_close           TCPState = 7
+	TCPState_closeWait       TCPState = 8
+	TCPState_lastAck         TCPState = 9
+	TCPState_listen          TCPState = 10
+	TCPState_closing         TCPState = 11
+	TCPState_newSynRecv      TCPState = 12
+)
+
+var TCPState_name = map[int32]string{
+	0:  "tcpStateUnknown",
+	1:  "established",
+	2:  "synSent",
+	3:  "synRecv",
+	4:  "finWait1",
+	5:  "finWait2",
+	6:  "timeWait",
+	7:  "close",
+	8:  "closeWait",
+	9:  "lastAck",
+	10: "listen",
+	11: "closing",
+	12: "newSynRecv",
+}
+var TCPState_value = map[string]int32{
+	"tcpStateUnknown": 0,
+	"established":     1,
+	"synSent":         2,
+	"synRecv":         3,
+	"finWait1":        4,
+	"finWait2":        5,
+	"timeWait":        6,
+	"close":           7,
+	"closeWait":       8,
+	"lastAck":         9,
+	"listen":          10,
+	"closing":         11,
+	"newSynRecv":      12,
+}
+
+func (x TCPState) String() string {
+	return proto.EnumName(TCPState_name, int32(x))
+}
+func (TCPState) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{6} }
+
+type ListenerProtocol int32
+
+const (
+	ListenerProtocol_listenTcp  ListenerProtocol = 0
+	ListenerProtocol_listenUdp  ListenerProtocol = 1
+	ListenerProtocol_listenUnix ListenerProtocol = 2
+)
+
+var ListenerProtocol_name = map[int32]string{
+	0: "listenTcp",
+	1: "listenUdp",
+	2: "listenUnix",
+}
+var ListenerProtocol_value = map[string]int32{
+	"listenTcp":  0,
+	"listenUdp":  1,
+	"listenUnix": 2,
+}
+
+func (x ListenerProtocol) String() string {
+	return proto.EnumName(ListenerProtocol_name, int32(x))
+}
+func (ListenerProtocol) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{7} }
+
+type SeccompMode int32
+
+const (
+	SeccompMode_seccompDisabled SeccompMode = 0
+	SeccompMode_seccompStrict   SeccompMode = 1
+	SeccompMode_seccompFilter   SeccompMode = 2
+)
+
+var SeccompMode_name = map[int32]string{
+	0: "seccompDisabled",
+	1: "seccompStrict",
+	2: "seccompFilter",
+}
+var SeccompMode_value = map[string]int32{
+	"seccompDisabled": 0,
+	"seccompStrict":   1,
+	"seccompFilter":   2,
+}
+
+func (x SeccompMode) String() string {
+	return proto.EnumName(SeccompMode_name, int32(x))
+}
+func (SeccompMode) EnumDescriptor() ([]byte, []int) { return fileDescriptorAgent, []int{8} }
 
 type ResCollector struct {
 	Header  *ResCollector_Header `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -251,6 +400,10 @@ type CollectorProc struct {
 	Kubernetes *datadog_agentpayload.KubeMetadataPayload `protobuf:"bytes,8,opt,name=kubernetes" json:"kubernetes,omitempty"`
 	Ecs        *datadog_agentpayload.ECSMetadataPayload  `protobuf:"bytes,9,opt,name=ecs" json:"ecs,omitempty"`
 	Containers []*Container                              `protobuf:"bytes,10,rep,name=containers" json:"containers,omitempty"`
+	// Processes that started and exited between two check runs, only sent in the first message of a group.
+	ShortLivedProcesses []*ShortLivedProcess `protobuf:"bytes,11,rep,name=shortLivedProcesses" json:"shortLivedProcesses,omitempty"`
+	// Usage of each host CPU since the last run, when per-CPU stats are collected, only sent in the first message of a group.
+	HostCpus []*SingleCPUStat `protobuf:"bytes,12,rep,name=hostCpus" json:"hostCpus,omitempty"`
 }
 
 func (m *CollectorProc) Reset()                    { *m = CollectorProc{} }
@@ -300,6 +453,20 @@ func (m *CollectorProc) GetContainers() []*Container {
 	return nil
 }
 
+func (m *CollectorProc) GetShortLivedProcesses() []*ShortLivedProcess {
+	if m != nil {
+		return m.ShortLivedProcesses
+	}
+	return nil
+}
+
+func (m *CollectorProc) GetHostCpus() []*SingleCPUStat {
+	if m != nil {
+		return m.HostCpus
+	}
+	return nil
+}
+
 type CollectorConnections struct {
 	HostName    string        `protobuf:"bytes,2,opt,name=hostName,proto3" json:"hostName,omitempty"`
 	Connections []*Connection `protobuf:"bytes,3,rep,name=connections" json:"connections,omitempty"`
@@ -329,6 +496,25 @@ func (m *CollectorConnections) GetHost() *Host {
 	return nil
 }
 
+type CollectorListeners struct {
+	HostName  string      `protobuf:"bytes,1,opt,name=hostName,proto3" json:"hostName,omitempty"`
+	Listeners []*Listener `protobuf:"bytes,2,rep,name=listeners" json:"listeners,omitempty"`
+	GroupId   int32       `protobuf:"varint,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupSize int32       `protobuf:"varint,4,opt,name=groupSize,proto3" json:"groupSize,omitempty"`
+}
+
+func (m *CollectorListeners) Reset()                    { *m = CollectorListeners{} }
+func (m *CollectorListeners) String() string            { return proto.CompactTextString(m) }
+func (*CollectorListeners) ProtoMessage()               {}
+func (*CollectorListeners) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{3} }
+
+func (m *CollectorListeners) GetListeners() []*Listener {
+	if m != nil {
+		return m.Listeners
+	}
+	return nil
+}
+
 type CollectorRealTime struct {
 	HostName string         `protobuf:"bytes,2,opt,name=hostName,proto3" json:"hostName,omitempty"`
 	Stats    []*ProcessStat `protobuf:"bytes,3,rep,name=stats" json:"stats,omitempty"`
@@ -340,12 +526,14 @@ type CollectorRealTime struct {
 	NumCpus        int32            `protobuf:"varint,8,opt,name=numCpus,proto3" json:"numCpus,omitempty"`
 	TotalMemory    int64            `protobuf:"varint,9,opt,name=totalMemory,proto3" json:"totalMemory,omitempty"`
 	ContainerStats []*ContainerStat `protobuf:"bytes,10,rep,name=containerStats" json:"containerStats,omitempty"`
+	// Usage of each host CPU since the last run, when per-CPU stats are collected, only sent in the first message of a group.
+	HostCpus []*SingleCPUStat `protobuf:"bytes,11,rep,name=hostCpus" json:"hostCpus,omitempty"`
 }
 
 func (m *CollectorRealTime) Reset()                    { *m = CollectorRealTime{} }
 func (m *CollectorRealTime) String() string            { return proto.CompactTextString(m) }
 func (*CollectorRealTime) ProtoMessage()               {}
-func (*CollectorRealTime) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{3} }
+func (*CollectorRealTime) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{4} }
 
 func (m *CollectorRealTime) GetStats() []*ProcessStat {
 	if m != nil {
@@ -361,6 +549,13 @@ func (m *CollectorRealTime) GetContainerStats() []*ContainerStat {
 	return nil
 }
 
+func (m *CollectorRealTime) GetHostCpus() []*SingleCPUStat {
+	if m != nil {
+		return m.HostCpus
+	}
+	return nil
+}
+
 type CollectorContainer struct {
 	HostName   string       `protobuf:"bytes,1,opt,name=hostName,proto3" json:"hostName,omitempty"`
 	Info       *SystemInfo  `protobuf:"bytes,2,opt,name=info" json:"info,omitempty"`
@@ -377,7 +572,7 @@ type CollectorContainer struct {
 func (m *CollectorContainer) Reset()                    { *m = CollectorContainer{} }
 func (m *CollectorContainer) String() string            { return proto.CompactTextString(m) }
 func (*CollectorContainer) ProtoMessage()               {}
-func (*CollectorContainer) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{4} }
+func (*CollectorContainer) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{5} }
 
 func (m *CollectorContainer) GetInfo() *SystemInfo {
 	if m != nil {
@@ -429,7 +624,7 @@ type CollectorContainerRealTime struct {
 func (m *CollectorContainerRealTime) Reset()                    { *m = CollectorContainerRealTime{} }
 func (m *CollectorContainerRealTime) String() string            { return proto.CompactTextString(m) }
 func (*CollectorContainerRealTime) ProtoMessage()               {}
-func (*CollectorContainerRealTime) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{5} }
+func (*CollectorContainerRealTime) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{6} }
 
 func (m *CollectorContainerRealTime) GetStats() []*ContainerStat {
 	if m != nil {
@@ -438,6 +633,25 @@ func (m *CollectorContainerRealTime) GetStats() []*ContainerStat {
 	return nil
 }
 
+type CollectorProcEvent struct {
+	HostName  string          `protobuf:"bytes,1,opt,name=hostName,proto3" json:"hostName,omitempty"`
+	Events    []*ProcessEvent `protobuf:"bytes,2,rep,name=events" json:"events,omitempty"`
+	GroupId   int32           `protobuf:"varint,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupSize int32           `protobuf:"varint,4,opt,name=groupSize,proto3" json:"groupSize,omitempty"`
+}
+
+func (m *CollectorProcEvent) Reset()                    { *m = CollectorProcEvent{} }
+func (m *CollectorProcEvent) String() string            { return proto.CompactTextString(m) }
+func (*CollectorProcEvent) ProtoMessage()               {}
+func (*CollectorProcEvent) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{7} }
+
+func (m *CollectorProcEvent) GetEvents() []*ProcessEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 type CollectorReqStatus struct {
 	HostName string `protobuf:"bytes,2,opt,name=hostName,proto3" json:"hostName,omitempty"`
 }
@@ -445,7 +659,7 @@ type CollectorReqStatus struct {
 func (m *CollectorReqStatus) Reset()                    { *m = CollectorReqStatus{} }
 func (m *CollectorReqStatus) String() string            { return proto.CompactTextString(m) }
 func (*CollectorReqStatus) ProtoMessage()               {}
-func (*CollectorReqStatus) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{6} }
+func (*CollectorReqStatus) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{8} }
 
 type CollectorStatus struct {
 	ActiveClients int32 `protobuf:"varint,1,opt,name=activeClients,proto3" json:"activeClients,omitempty"`
@@ -455,7 +669,7 @@ type CollectorStatus struct {
 func (m *CollectorStatus) Reset()                    { *m = CollectorStatus{} }
 func (m *CollectorStatus) String() string            { return proto.CompactTextString(m) }
 func (*CollectorStatus) ProtoMessage()               {}
-func (*CollectorStatus) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{7} }
+func (*CollectorStatus) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{9} }
 
 type Process struct {
 	Key     uint32       `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -464,25 +678,37 @@ type Process struct {
 	Command *Command     `protobuf:"bytes,4,opt,name=command" json:"command,omitempty"`
 	User    *ProcessUser `protobuf:"bytes,5,opt,name=user" json:"user,omitempty"`
 	// 6 is deprecated
-	Memory                 *MemoryStat  `protobuf:"bytes,7,opt,name=memory" json:"memory,omitempty"`
-	Cpu                    *CPUStat     `protobuf:"bytes,8,opt,name=cpu" json:"cpu,omitempty"`
-	CreateTime             int64        `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	Container              *Container   `protobuf:"bytes,10,opt,name=container" json:"container,omitempty"`
-	OpenFdCount            int32        `protobuf:"varint,11,opt,name=openFdCount,proto3" json:"openFdCount,omitempty"`
-	State                  ProcessState `protobuf:"varint,12,opt,name=state,proto3,enum=datadog.process_agent.ProcessState" json:"state,omitempty"`
-	IoStat                 *IOStat      `protobuf:"bytes,13,opt,name=ioStat" json:"ioStat,omitempty"`
-	ContainerId            string       `protobuf:"bytes,14,opt,name=containerId,proto3" json:"containerId,omitempty"`
-	ContainerKey           uint32       `protobuf:"varint,15,opt,name=containerKey,proto3" json:"containerKey,omitempty"`
-	VoluntaryCtxSwitches   uint64       `protobuf:"varint,16,opt,name=voluntaryCtxSwitches,proto3" json:"voluntaryCtxSwitches,omitempty"`
-	InvoluntaryCtxSwitches uint64       `protobuf:"varint,17,opt,name=involuntaryCtxSwitches,proto3" json:"involuntaryCtxSwitches,omitempty"`
-	ByteKey                []byte       `protobuf:"bytes,18,opt,name=byteKey,proto3" json:"byteKey,omitempty"`
-	ContainerByteKey       []byte       `protobuf:"bytes,19,opt,name=containerByteKey,proto3" json:"containerByteKey,omitempty"`
+	Memory                 *MemoryStat      `protobuf:"bytes,7,opt,name=memory" json:"memory,omitempty"`
+	Cpu                    *CPUStat         `protobuf:"bytes,8,opt,name=cpu" json:"cpu,omitempty"`
+	CreateTime             int64            `protobuf:"varint,9,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	Container              *Container       `protobuf:"bytes,10,opt,name=container" json:"container,omitempty"`
+	OpenFdCount            int32            `protobuf:"varint,11,opt,name=openFdCount,proto3" json:"openFdCount,omitempty"`
+	State                  ProcessState     `protobuf:"varint,12,opt,name=state,proto3,enum=datadog.process_agent.ProcessState" json:"state,omitempty"`
+	IoStat                 *IOStat          `protobuf:"bytes,13,opt,name=ioStat" json:"ioStat,omitempty"`
+	ContainerId            string           `protobuf:"bytes,14,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	ContainerKey           uint32           `protobuf:"varint,15,opt,name=containerKey,proto3" json:"containerKey,omitempty"`
+	VoluntaryCtxSwitches   uint64           `protobuf:"varint,16,opt,name=voluntaryCtxSwitches,proto3" json:"voluntaryCtxSwitches,omitempty"`
+	InvoluntaryCtxSwitches uint64           `protobuf:"varint,17,opt,name=involuntaryCtxSwitches,proto3" json:"involuntaryCtxSwitches,omitempty"`
+	ByteKey                []byte           `protobuf:"bytes,18,opt,name=byteKey,proto3" json:"byteKey,omitempty"`
+	ContainerByteKey       []byte           `protobuf:"bytes,19,opt,name=containerByteKey,proto3" json:"containerByteKey,omitempty"`
+	SchedStat              *SchedStat       `protobuf:"bytes,20,opt,name=schedStat" json:"schedStat,omitempty"`
+	Limits                 *ProcessLimits   `protobuf:"bytes,21,opt,name=limits" json:"limits,omitempty"`
+	FdUtilizationPct       float32          `protobuf:"fixed32,22,opt,name=fdUtilizationPct,proto3" json:"fdUtilizationPct,omitempty"`
+	FdStat                 *FdStat          `protobuf:"bytes,23,opt,name=fdStat" json:"fdStat,omitempty"`
+	Namespaces             *Namespaces      `protobuf:"bytes,24,opt,name=namespaces" json:"namespaces,omitempty"`
+	Cgroup                 string           `protobuf:"bytes,25,opt,name=cgroup,proto3" json:"cgroup,omitempty"`
+	Security               *ProcessSecurity `protobuf:"bytes,26,opt,name=security" json:"security,omitempty"`
+	Systemd                *SystemdUnit     `protobuf:"bytes,27,opt,name=systemd" json:"systemd,omitempty"`
+	Reparented             bool             `protobuf:"varint,28,opt,name=reparented,proto3" json:"reparented,omitempty"`
+	Tags                   []string         `protobuf:"bytes,29,rep,name=tags" json:"tags,omitempty"`
+	Env                    []*EnvVar        `protobuf:"bytes,30,rep,name=env" json:"env,omitempty"`
+	EnvTruncated           bool             `protobuf:"varint,31,opt,name=envTruncated,proto3" json:"envTruncated,omitempty"`
 }
 
 func (m *Process) Reset()                    { *m = Process{} }
 func (m *Process) String() string            { return proto.CompactTextString(m) }
 func (*Process) ProtoMessage()               {}
-func (*Process) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{8} }
+func (*Process) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{10} }
 
 func (m *Process) GetHost() *Host {
 	if m != nil {
@@ -533,35 +759,198 @@ func (m *Process) GetIoStat() *IOStat {
 	return nil
 }
 
+func (m *Process) GetSchedStat() *SchedStat {
+	if m != nil {
+		return m.SchedStat
+	}
+	return nil
+}
+
+func (m *Process) GetLimits() *ProcessLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *Process) GetFdStat() *FdStat {
+	if m != nil {
+		return m.FdStat
+	}
+	return nil
+}
+
+func (m *Process) GetNamespaces() *Namespaces {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *Process) GetSecurity() *ProcessSecurity {
+	if m != nil {
+		return m.Security
+	}
+	return nil
+}
+
+func (m *Process) GetSystemd() *SystemdUnit {
+	if m != nil {
+		return m.Systemd
+	}
+	return nil
+}
+
+func (m *Process) GetEnv() []*EnvVar {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+// ShortLivedProcess is a process that was seen by the proc connector but exited
+// before it could be collected by the process check.
+type ShortLivedProcess struct {
+	Pid         int32        `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Command     *Command     `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	User        *ProcessUser `protobuf:"bytes,3,opt,name=user" json:"user,omitempty"`
+	CreateTime  int64        `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	ExitTime    int64        `protobuf:"varint,5,opt,name=exitTime,proto3" json:"exitTime,omitempty"`
+	ExitCode    int32        `protobuf:"varint,6,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	ExitSignal  int32        `protobuf:"varint,7,opt,name=exitSignal,proto3" json:"exitSignal,omitempty"`
+	ContainerId string       `protobuf:"bytes,8,opt,name=containerId,proto3" json:"containerId,omitempty"`
+}
+
+func (m *ShortLivedProcess) Reset()                    { *m = ShortLivedProcess{} }
+func (m *ShortLivedProcess) String() string            { return proto.CompactTextString(m) }
+func (*ShortLivedProcess) ProtoMessage()               {}
+func (*ShortLivedProcess) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{11} }
+
+func (m *ShortLivedProcess) GetCommand() *Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *ShortLivedProcess) GetUser() *ProcessUser {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+// ProcessEvent is a change in the lifecycle of a process, found by comparing
+// two consecutive runs of the process_events check.
+type ProcessEvent struct {
+	Type        ProcessEventType `protobuf:"varint,1,opt,name=type,proto3,enum=datadog.process_agent.ProcessEventType" json:"type,omitempty"`
+	Timestamp   int64            `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Pid         int32            `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	CreateTime  int64            `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	Command     *Command         `protobuf:"bytes,5,opt,name=command" json:"command,omitempty"`
+	User        *ProcessUser     `protobuf:"bytes,6,opt,name=user" json:"user,omitempty"`
+	ContainerId string           `protobuf:"bytes,7,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	// Set for an exec, the command that was replaced.
+	PreviousCommand *Command `protobuf:"bytes,8,opt,name=previousCommand" json:"previousCommand,omitempty"`
+	// Set for a user change, the user the process used to run as.
+	PreviousUser *ProcessUser `protobuf:"bytes,9,opt,name=previousUser" json:"previousUser,omitempty"`
+	// Exit context, the exit status is only known for zombies and OOM kills.
+	ExitStatusKnown bool  `protobuf:"varint,10,opt,name=exitStatusKnown,proto3" json:"exitStatusKnown,omitempty"`
+	ExitCode        int32 `protobuf:"varint,11,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	ExitSignal      int32 `protobuf:"varint,12,opt,name=exitSignal,proto3" json:"exitSignal,omitempty"`
+	Zombie          bool  `protobuf:"varint,13,opt,name=zombie,proto3" json:"zombie,omitempty"`
+	OomKilled       bool  `protobuf:"varint,14,opt,name=oomKilled,proto3" json:"oomKilled,omitempty"`
+}
+
+func (m *ProcessEvent) Reset()                    { *m = ProcessEvent{} }
+func (m *ProcessEvent) String() string            { return proto.CompactTextString(m) }
+func (*ProcessEvent) ProtoMessage()               {}
+func (*ProcessEvent) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{12} }
+
+func (m *ProcessEvent) GetCommand() *Command {
+	if m != nil {
+		return m.Command
+	}
+	return nil
+}
+
+func (m *ProcessEvent) GetUser() *ProcessUser {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *ProcessEvent) GetPreviousCommand() *Command {
+	if m != nil {
+		return m.PreviousCommand
+	}
+	return nil
+}
+
+func (m *ProcessEvent) GetPreviousUser() *ProcessUser {
+	if m != nil {
+		return m.PreviousUser
+	}
+	return nil
+}
+
 type Command struct {
-	Args   []string `protobuf:"bytes,1,rep,name=args" json:"args,omitempty"`
-	Cwd    string   `protobuf:"bytes,3,opt,name=cwd,proto3" json:"cwd,omitempty"`
-	Root   string   `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
-	OnDisk bool     `protobuf:"varint,5,opt,name=onDisk,proto3" json:"onDisk,omitempty"`
-	Ppid   int32    `protobuf:"varint,6,opt,name=ppid,proto3" json:"ppid,omitempty"`
-	Pgroup int32    `protobuf:"varint,7,opt,name=pgroup,proto3" json:"pgroup,omitempty"`
-	Exe    string   `protobuf:"bytes,8,opt,name=exe,proto3" json:"exe,omitempty"`
+	Args        []string        `protobuf:"bytes,1,rep,name=args" json:"args,omitempty"`
+	Cwd         string          `protobuf:"bytes,3,opt,name=cwd,proto3" json:"cwd,omitempty"`
+	Root        string          `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	OnDisk      bool            `protobuf:"varint,5,opt,name=onDisk,proto3" json:"onDisk,omitempty"`
+	Ppid        int32           `protobuf:"varint,6,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	Pgroup      int32           `protobuf:"varint,7,opt,name=pgroup,proto3" json:"pgroup,omitempty"`
+	Exe         string          `protobuf:"bytes,8,opt,name=exe,proto3" json:"exe,omitempty"`
+	Fingerprint *ExeFingerprint `protobuf:"bytes,9,opt,name=fingerprint" json:"fingerprint,omitempty"`
 }
 
 func (m *Command) Reset()                    { *m = Command{} }
 func (m *Command) String() string            { return proto.CompactTextString(m) }
 func (*Command) ProtoMessage()               {}
-func (*Command) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{9} }
+func (*Command) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{13} }
+
+func (m *Command) GetFingerprint() *ExeFingerprint {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return nil
+}
+
+// ExeFingerprint identifies the build of an executable: its GNU build id, the
+// hash of its content and, when installed by the package manager of the host,
+// the package it belongs to.
+type ExeFingerprint struct {
+	Sha256         string `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	BuildId        string `protobuf:"bytes,2,opt,name=buildId,proto3" json:"buildId,omitempty"`
+	PackageManager string `protobuf:"bytes,3,opt,name=packageManager,proto3" json:"packageManager,omitempty"`
+	Package        string `protobuf:"bytes,4,opt,name=package,proto3" json:"package,omitempty"`
+	PackageVersion string `protobuf:"bytes,5,opt,name=packageVersion,proto3" json:"packageVersion,omitempty"`
+}
+
+func (m *ExeFingerprint) Reset()                    { *m = ExeFingerprint{} }
+func (m *ExeFingerprint) String() string            { return proto.CompactTextString(m) }
+func (*ExeFingerprint) ProtoMessage()               {}
+func (*ExeFingerprint) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{14} }
 
 type ProcessUser struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Uid  int32  `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Gid  int32  `protobuf:"varint,3,opt,name=gid,proto3" json:"gid,omitempty"`
-	Euid int32  `protobuf:"varint,4,opt,name=euid,proto3" json:"euid,omitempty"`
-	Egid int32  `protobuf:"varint,5,opt,name=egid,proto3" json:"egid,omitempty"`
-	Suid int32  `protobuf:"varint,6,opt,name=suid,proto3" json:"suid,omitempty"`
-	Sgid int32  `protobuf:"varint,7,opt,name=sgid,proto3" json:"sgid,omitempty"`
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uid    int32   `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Gid    int32   `protobuf:"varint,3,opt,name=gid,proto3" json:"gid,omitempty"`
+	Euid   int32   `protobuf:"varint,4,opt,name=euid,proto3" json:"euid,omitempty"`
+	Egid   int32   `protobuf:"varint,5,opt,name=egid,proto3" json:"egid,omitempty"`
+	Suid   int32   `protobuf:"varint,6,opt,name=suid,proto3" json:"suid,omitempty"`
+	Sgid   int32   `protobuf:"varint,7,opt,name=sgid,proto3" json:"sgid,omitempty"`
+	Fsuid  int32   `protobuf:"varint,8,opt,name=fsuid,proto3" json:"fsuid,omitempty"`
+	Fsgid  int32   `protobuf:"varint,9,opt,name=fsgid,proto3" json:"fsgid,omitempty"`
+	Groups []int32 `protobuf:"varint,10,rep,name=groups" json:"groups,omitempty"`
 }
 
 func (m *ProcessUser) Reset()                    { *m = ProcessUser{} }
 func (m *ProcessUser) String() string            { return proto.CompactTextString(m) }
 func (*ProcessUser) ProtoMessage()               {}
-func (*ProcessUser) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{10} }
+func (*ProcessUser) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{15} }
 
 type Container struct {
 	Type        string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -595,7 +984,7 @@ type Container struct {
 func (m *Container) Reset()                    { *m = Container{} }
 func (m *Container) String() string            { return proto.CompactTextString(m) }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{11} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{16} }
 
 func (m *Container) GetHost() *Host {
 	if m != nil {
@@ -639,12 +1028,13 @@ type ProcessStat struct {
 	InvoluntaryCtxSwitches uint64          `protobuf:"varint,25,opt,name=involuntaryCtxSwitches,proto3" json:"involuntaryCtxSwitches,omitempty"`
 	ByteKey                []byte          `protobuf:"bytes,26,opt,name=byteKey,proto3" json:"byteKey,omitempty"`
 	ContainerByteKey       []byte          `protobuf:"bytes,27,opt,name=containerByteKey,proto3" json:"containerByteKey,omitempty"`
+	SchedStat              *SchedStat      `protobuf:"bytes,28,opt,name=schedStat" json:"schedStat,omitempty"`
 }
 
 func (m *ProcessStat) Reset()                    { *m = ProcessStat{} }
 func (m *ProcessStat) String() string            { return proto.CompactTextString(m) }
 func (*ProcessStat) ProtoMessage()               {}
-func (*ProcessStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{12} }
+func (*ProcessStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{17} }
 
 func (m *ProcessStat) GetMemory() *MemoryStat {
 	if m != nil {
@@ -667,6 +1057,13 @@ func (m *ProcessStat) GetIoStat() *IOStat {
 	return nil
 }
 
+func (m *ProcessStat) GetSchedStat() *SchedStat {
+	if m != nil {
+		return m.SchedStat
+	}
+	return nil
+}
+
 // ContainerStat is used for real-time container messages. It should only contain
 // data that can change for a running container (and relevant information to
 // generate a key). We will send a lot of these in the real-time messages so
@@ -697,7 +1094,7 @@ type ContainerStat struct {
 func (m *ContainerStat) Reset()                    { *m = ContainerStat{} }
 func (m *ContainerStat) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStat) ProtoMessage()               {}
-func (*ContainerStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{13} }
+func (*ContainerStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{18} }
 
 type SystemInfo struct {
 	Uuid string     `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
@@ -710,7 +1107,7 @@ type SystemInfo struct {
 func (m *SystemInfo) Reset()                    { *m = SystemInfo{} }
 func (m *SystemInfo) String() string            { return proto.CompactTextString(m) }
 func (*SystemInfo) ProtoMessage()               {}
-func (*SystemInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{14} }
+func (*SystemInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{19} }
 
 func (m *SystemInfo) GetOs() *OSInfo {
 	if m != nil {
@@ -737,7 +1134,7 @@ type OSInfo struct {
 func (m *OSInfo) Reset()                    { *m = OSInfo{} }
 func (m *OSInfo) String() string            { return proto.CompactTextString(m) }
 func (*OSInfo) ProtoMessage()               {}
-func (*OSInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{15} }
+func (*OSInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{20} }
 
 type IOStat struct {
 	ReadRate       float32 `protobuf:"fixed32,1,opt,name=readRate,proto3" json:"readRate,omitempty"`
@@ -749,7 +1146,7 @@ type IOStat struct {
 func (m *IOStat) Reset()                    { *m = IOStat{} }
 func (m *IOStat) String() string            { return proto.CompactTextString(m) }
 func (*IOStat) ProtoMessage()               {}
-func (*IOStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{16} }
+func (*IOStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{21} }
 
 type Connection struct {
 	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
@@ -764,12 +1161,15 @@ type Connection struct {
 	Family        ConnectionFamily `protobuf:"varint,10,opt,name=family,proto3,enum=datadog.process_agent.ConnectionFamily" json:"family,omitempty"`
 	Type          ConnectionType   `protobuf:"varint,11,opt,name=type,proto3,enum=datadog.process_agent.ConnectionType" json:"type,omitempty"`
 	PidCreateTime int64            `protobuf:"varint,12,opt,name=pidCreateTime,proto3" json:"pidCreateTime,omitempty"`
+	TcpState      TCPState         `protobuf:"varint,13,opt,name=tcpState,proto3,enum=datadog.process_agent.TCPState" json:"tcpState,omitempty"`
+	FromProcfs    bool             `protobuf:"varint,14,opt,name=fromProcfs,proto3" json:"fromProcfs,omitempty"`
+	TcpStats      *TCPStats        `protobuf:"bytes,15,opt,name=tcpStats" json:"tcpStats,omitempty"`
 }
 
 func (m *Connection) Reset()                    { *m = Connection{} }
 func (m *Connection) String() string            { return proto.CompactTextString(m) }
 func (*Connection) ProtoMessage()               {}
-func (*Connection) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{17} }
+func (*Connection) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{22} }
 
 func (m *Connection) GetLaddr() *Addr {
 	if m != nil {
@@ -785,6 +1185,53 @@ func (m *Connection) GetRaddr() *Addr {
 	return nil
 }
 
+func (m *Connection) GetTcpStats() *TCPStats {
+	if m != nil {
+		return m.TcpStats
+	}
+	return nil
+}
+
+// TCPStats are the stats of a TCP connection kept by the kernel, see tcp_info.
+type TCPStats struct {
+	Rtt         uint32 `protobuf:"varint,1,opt,name=rtt,proto3" json:"rtt,omitempty"`
+	RttVar      uint32 `protobuf:"varint,2,opt,name=rttVar,proto3" json:"rttVar,omitempty"`
+	Retransmits uint32 `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
+	SndCwnd     uint32 `protobuf:"varint,4,opt,name=sndCwnd,proto3" json:"sndCwnd,omitempty"`
+	RecvQueue   uint32 `protobuf:"varint,5,opt,name=recvQueue,proto3" json:"recvQueue,omitempty"`
+	SendQueue   uint32 `protobuf:"varint,6,opt,name=sendQueue,proto3" json:"sendQueue,omitempty"`
+}
+
+func (m *TCPStats) Reset()                    { *m = TCPStats{} }
+func (m *TCPStats) String() string            { return proto.CompactTextString(m) }
+func (*TCPStats) ProtoMessage()               {}
+func (*TCPStats) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{23} }
+
+// Listener is a socket a process accepts connections or receives datagrams on.
+type Listener struct {
+	Pid           int32            `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	PidCreateTime int64            `protobuf:"varint,2,opt,name=pidCreateTime,proto3" json:"pidCreateTime,omitempty"`
+	Protocol      ListenerProtocol `protobuf:"varint,3,opt,name=protocol,proto3,enum=datadog.process_agent.ListenerProtocol" json:"protocol,omitempty"`
+	Family        ConnectionFamily `protobuf:"varint,4,opt,name=family,proto3,enum=datadog.process_agent.ConnectionFamily" json:"family,omitempty"`
+	Addr          *Addr            `protobuf:"bytes,5,opt,name=addr" json:"addr,omitempty"`
+	Path          string           `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	Inode         uint64           `protobuf:"varint,7,opt,name=inode,proto3" json:"inode,omitempty"`
+	NetNamespace  uint64           `protobuf:"varint,8,opt,name=netNamespace,proto3" json:"netNamespace,omitempty"`
+	SharedBy      int32            `protobuf:"varint,9,opt,name=sharedBy,proto3" json:"sharedBy,omitempty"`
+}
+
+func (m *Listener) Reset()                    { *m = Listener{} }
+func (m *Listener) String() string            { return proto.CompactTextString(m) }
+func (*Listener) ProtoMessage()               {}
+func (*Listener) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{24} }
+
+func (m *Listener) GetAddr() *Addr {
+	if m != nil {
+		return m.Addr
+	}
+	return nil
+}
+
 type Addr struct {
 	Host *Host  `protobuf:"bytes,1,opt,name=host" json:"host,omitempty"`
 	Ip   string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
@@ -794,7 +1241,7 @@ type Addr struct {
 func (m *Addr) Reset()                    { *m = Addr{} }
 func (m *Addr) String() string            { return proto.CompactTextString(m) }
 func (*Addr) ProtoMessage()               {}
-func (*Addr) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{18} }
+func (*Addr) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{25} }
 
 func (m *Addr) GetHost() *Host {
 	if m != nil {
@@ -812,12 +1259,188 @@ type MemoryStat struct {
 	Lib    uint64 `protobuf:"varint,6,opt,name=lib,proto3" json:"lib,omitempty"`
 	Data   uint64 `protobuf:"varint,7,opt,name=data,proto3" json:"data,omitempty"`
 	Dirty  uint64 `protobuf:"varint,8,opt,name=dirty,proto3" json:"dirty,omitempty"`
+	// From /proc/<pid>/smaps_rollup when memory details are collected, 0 otherwise.
+	Pss        uint64 `protobuf:"varint,9,opt,name=pss,proto3" json:"pss,omitempty"`
+	Uss        uint64 `protobuf:"varint,10,opt,name=uss,proto3" json:"uss,omitempty"`
+	SwapPss    uint64 `protobuf:"varint,11,opt,name=swapPss,proto3" json:"swapPss,omitempty"`
+	Anonymous  uint64 `protobuf:"varint,12,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	FileBacked uint64 `protobuf:"varint,13,opt,name=fileBacked,proto3" json:"fileBacked,omitempty"`
 }
 
 func (m *MemoryStat) Reset()                    { *m = MemoryStat{} }
 func (m *MemoryStat) String() string            { return proto.CompactTextString(m) }
 func (*MemoryStat) ProtoMessage()               {}
-func (*MemoryStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{19} }
+func (*MemoryStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{26} }
+
+// FdStat breaks down the open file descriptors of a process by type, from at
+// most max_proc_fds of them.
+type FdStat struct {
+	Files      int32     `protobuf:"varint,1,opt,name=files,proto3" json:"files,omitempty"`
+	Sockets    int32     `protobuf:"varint,2,opt,name=sockets,proto3" json:"sockets,omitempty"`
+	Pipes      int32     `protobuf:"varint,3,opt,name=pipes,proto3" json:"pipes,omitempty"`
+	Eventfds   int32     `protobuf:"varint,4,opt,name=eventfds,proto3" json:"eventfds,omitempty"`
+	Epolls     int32     `protobuf:"varint,5,opt,name=epolls,proto3" json:"epolls,omitempty"`
+	AnonInodes int32     `protobuf:"varint,6,opt,name=anonInodes,proto3" json:"anonInodes,omitempty"`
+	Devices    int32     `protobuf:"varint,7,opt,name=devices,proto3" json:"devices,omitempty"`
+	Other      int32     `protobuf:"varint,8,opt,name=other,proto3" json:"other,omitempty"`
+	Truncated  bool      `protobuf:"varint,9,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	TopPaths   []*FdPath `protobuf:"bytes,10,rep,name=topPaths" json:"topPaths,omitempty"`
+}
+
+func (m *FdStat) Reset()                    { *m = FdStat{} }
+func (m *FdStat) String() string            { return proto.CompactTextString(m) }
+func (*FdStat) ProtoMessage()               {}
+func (*FdStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{27} }
+
+func (m *FdStat) GetTopPaths() []*FdPath {
+	if m != nil {
+		return m.TopPaths
+	}
+	return nil
+}
+
+type FdPath struct {
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *FdPath) Reset()                    { *m = FdPath{} }
+func (m *FdPath) String() string            { return proto.CompactTextString(m) }
+func (*FdPath) ProtoMessage()               {}
+func (*FdPath) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{28} }
+
+type EnvVar struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EnvVar) Reset()                    { *m = EnvVar{} }
+func (m *EnvVar) String() string            { return proto.CompactTextString(m) }
+func (*EnvVar) ProtoMessage()               {}
+func (*EnvVar) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{29} }
+
+// SystemdUnit is where systemd placed a process: the innermost unit of its
+// cgroup, e.g. "nginx.service" or "session-3.scope", the slice holding it and
+// the scope, if any.
+type SystemdUnit struct {
+	Unit  string `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	Slice string `protobuf:"bytes,2,opt,name=slice,proto3" json:"slice,omitempty"`
+	Scope string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (m *SystemdUnit) Reset()                    { *m = SystemdUnit{} }
+func (m *SystemdUnit) String() string            { return proto.CompactTextString(m) }
+func (*SystemdUnit) ProtoMessage()               {}
+func (*SystemdUnit) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{30} }
+
+// ProcessSecurity is the security context of a process: its capabilities, as
+// bitmasks of the CAP_* values, whether it is sandboxed by seccomp or can't
+// gain privileges, and its SELinux or AppArmor label.
+type ProcessSecurity struct {
+	CapEffective  uint64      `protobuf:"varint,1,opt,name=capEffective,proto3" json:"capEffective,omitempty"`
+	CapPermitted  uint64      `protobuf:"varint,2,opt,name=capPermitted,proto3" json:"capPermitted,omitempty"`
+	CapBounding   uint64      `protobuf:"varint,3,opt,name=capBounding,proto3" json:"capBounding,omitempty"`
+	EffectiveCaps []string    `protobuf:"bytes,4,rep,name=effectiveCaps" json:"effectiveCaps,omitempty"`
+	Seccomp       SeccompMode `protobuf:"varint,5,opt,name=seccomp,proto3,enum=datadog.process_agent.SeccompMode" json:"seccomp,omitempty"`
+	NoNewPrivs    bool        `protobuf:"varint,6,opt,name=noNewPrivs,proto3" json:"noNewPrivs,omitempty"`
+	LsmLabel      string      `protobuf:"bytes,7,opt,name=lsmLabel,proto3" json:"lsmLabel,omitempty"`
+}
+
+func (m *ProcessSecurity) Reset()                    { *m = ProcessSecurity{} }
+func (m *ProcessSecurity) String() string            { return proto.CompactTextString(m) }
+func (*ProcessSecurity) ProtoMessage()               {}
+func (*ProcessSecurity) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{31} }
+
+// Namespaces are the inode numbers of the namespaces of a process, as in the
+// links of /proc/<pid>/ns. Processes sharing an inode share the namespace.
+type Namespaces struct {
+	Pid    uint64 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	Net    uint64 `protobuf:"varint,2,opt,name=net,proto3" json:"net,omitempty"`
+	Mnt    uint64 `protobuf:"varint,3,opt,name=mnt,proto3" json:"mnt,omitempty"`
+	User   uint64 `protobuf:"varint,4,opt,name=user,proto3" json:"user,omitempty"`
+	Uts    uint64 `protobuf:"varint,5,opt,name=uts,proto3" json:"uts,omitempty"`
+	Ipc    uint64 `protobuf:"varint,6,opt,name=ipc,proto3" json:"ipc,omitempty"`
+	Cgroup uint64 `protobuf:"varint,7,opt,name=cgroup,proto3" json:"cgroup,omitempty"`
+}
+
+func (m *Namespaces) Reset()                    { *m = Namespaces{} }
+func (m *Namespaces) String() string            { return proto.CompactTextString(m) }
+func (*Namespaces) ProtoMessage()               {}
+func (*Namespaces) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{32} }
+
+// ResourceLimit is a soft and hard limit, as set by setrlimit(2). Unlimited
+// is RLIM_INFINITY, the maximum uint64.
+type ResourceLimit struct {
+	Soft uint64 `protobuf:"varint,1,opt,name=soft,proto3" json:"soft,omitempty"`
+	Hard uint64 `protobuf:"varint,2,opt,name=hard,proto3" json:"hard,omitempty"`
+}
+
+func (m *ResourceLimit) Reset()                    { *m = ResourceLimit{} }
+func (m *ResourceLimit) String() string            { return proto.CompactTextString(m) }
+func (*ResourceLimit) ProtoMessage()               {}
+func (*ResourceLimit) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{33} }
+
+// ProcessLimits are the resource limits of a process, sizes are in bytes.
+type ProcessLimits struct {
+	OpenFiles    *ResourceLimit `protobuf:"bytes,1,opt,name=openFiles" json:"openFiles,omitempty"`
+	Processes    *ResourceLimit `protobuf:"bytes,2,opt,name=processes" json:"processes,omitempty"`
+	LockedMemory *ResourceLimit `protobuf:"bytes,3,opt,name=lockedMemory" json:"lockedMemory,omitempty"`
+	CoreSize     *ResourceLimit `protobuf:"bytes,4,opt,name=coreSize" json:"coreSize,omitempty"`
+}
+
+func (m *ProcessLimits) Reset()                    { *m = ProcessLimits{} }
+func (m *ProcessLimits) String() string            { return proto.CompactTextString(m) }
+func (*ProcessLimits) ProtoMessage()               {}
+func (*ProcessLimits) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{34} }
+
+func (m *ProcessLimits) GetOpenFiles() *ResourceLimit {
+	if m != nil {
+		return m.OpenFiles
+	}
+	return nil
+}
+
+func (m *ProcessLimits) GetProcesses() *ResourceLimit {
+	if m != nil {
+		return m.Processes
+	}
+	return nil
+}
+
+func (m *ProcessLimits) GetLockedMemory() *ResourceLimit {
+	if m != nil {
+		return m.LockedMemory
+	}
+	return nil
+}
+
+func (m *ProcessLimits) GetCoreSize() *ResourceLimit {
+	if m != nil {
+		return m.CoreSize
+	}
+	return nil
+}
+
+// SchedStat tells how long the threads of a process waited rather than ran,
+// from schedstat and, when available, taskstats delay accounting. Times are
+// cumulative, in nanoseconds. Percentages are of the time elapsed since the
+// last run, summed over threads, so they can go over 100.
+type SchedStat struct {
+	RunTime         uint64  `protobuf:"varint,1,opt,name=runTime,proto3" json:"runTime,omitempty"`
+	RunQueueWait    uint64  `protobuf:"varint,2,opt,name=runQueueWait,proto3" json:"runQueueWait,omitempty"`
+	Timeslices      uint64  `protobuf:"varint,3,opt,name=timeslices,proto3" json:"timeslices,omitempty"`
+	RunQueueWaitPct float32 `protobuf:"fixed32,4,opt,name=runQueueWaitPct,proto3" json:"runQueueWaitPct,omitempty"`
+	DelayAcct       bool    `protobuf:"varint,5,opt,name=delayAcct,proto3" json:"delayAcct,omitempty"`
+	BlkioDelay      uint64  `protobuf:"varint,6,opt,name=blkioDelay,proto3" json:"blkioDelay,omitempty"`
+	SwapinDelay     uint64  `protobuf:"varint,7,opt,name=swapinDelay,proto3" json:"swapinDelay,omitempty"`
+	BlkioDelayPct   float32 `protobuf:"fixed32,8,opt,name=blkioDelayPct,proto3" json:"blkioDelayPct,omitempty"`
+	SwapinDelayPct  float32 `protobuf:"fixed32,9,opt,name=swapinDelayPct,proto3" json:"swapinDelayPct,omitempty"`
+}
+
+func (m *SchedStat) Reset()                    { *m = SchedStat{} }
+func (m *SchedStat) String() string            { return proto.CompactTextString(m) }
+func (*SchedStat) ProtoMessage()               {}
+func (*SchedStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{35} }
 
 type CPUStat struct {
 	LastCpu    string           `protobuf:"bytes,1,opt,name=lastCpu,proto3" json:"lastCpu,omitempty"`
@@ -834,7 +1457,7 @@ type CPUStat struct {
 func (m *CPUStat) Reset()                    { *m = CPUStat{} }
 func (m *CPUStat) String() string            { return proto.CompactTextString(m) }
 func (*CPUStat) ProtoMessage()               {}
-func (*CPUStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{20} }
+func (*CPUStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{36} }
 
 func (m *CPUStat) GetCpus() []*SingleCPUStat {
 	if m != nil {
@@ -851,7 +1474,7 @@ type SingleCPUStat struct {
 func (m *SingleCPUStat) Reset()                    { *m = SingleCPUStat{} }
 func (m *SingleCPUStat) String() string            { return proto.CompactTextString(m) }
 func (*SingleCPUStat) ProtoMessage()               {}
-func (*SingleCPUStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{21} }
+func (*SingleCPUStat) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{37} }
 
 type CPUInfo struct {
 	Number     int32  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
@@ -868,7 +1491,7 @@ type CPUInfo struct {
 func (m *CPUInfo) Reset()                    { *m = CPUInfo{} }
 func (m *CPUInfo) String() string            { return proto.CompactTextString(m) }
 func (*CPUInfo) ProtoMessage()               {}
-func (*CPUInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{22} }
+func (*CPUInfo) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{38} }
 
 type Host struct {
 	Id          int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -883,7 +1506,7 @@ type Host struct {
 func (m *Host) Reset()                    { *m = Host{} }
 func (m *Host) String() string            { return proto.CompactTextString(m) }
 func (*Host) ProtoMessage()               {}
-func (*Host) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{23} }
+func (*Host) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{39} }
 
 func (m *Host) GetTags() []*HostTags {
 	if m != nil {
@@ -900,20 +1523,25 @@ type HostTags struct {
 func (m *HostTags) Reset()                    { *m = HostTags{} }
 func (m *HostTags) String() string            { return proto.CompactTextString(m) }
 func (*HostTags) ProtoMessage()               {}
-func (*HostTags) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{24} }
+func (*HostTags) Descriptor() ([]byte, []int) { return fileDescriptorAgent, []int{40} }
 
 func init() {
 	proto.RegisterType((*ResCollector)(nil), "datadog.process_agent.ResCollector")
 	proto.RegisterType((*ResCollector_Header)(nil), "datadog.process_agent.ResCollector.Header")
 	proto.RegisterType((*CollectorProc)(nil), "datadog.process_agent.CollectorProc")
 	proto.RegisterType((*CollectorConnections)(nil), "datadog.process_agent.CollectorConnections")
+	proto.RegisterType((*CollectorListeners)(nil), "datadog.process_agent.CollectorListeners")
 	proto.RegisterType((*CollectorRealTime)(nil), "datadog.process_agent.CollectorRealTime")
 	proto.RegisterType((*CollectorContainer)(nil), "datadog.process_agent.CollectorContainer")
 	proto.RegisterType((*CollectorContainerRealTime)(nil), "datadog.process_agent.CollectorContainerRealTime")
+	proto.RegisterType((*CollectorProcEvent)(nil), "datadog.process_agent.CollectorProcEvent")
 	proto.RegisterType((*CollectorReqStatus)(nil), "datadog.process_agent.CollectorReqStatus")
 	proto.RegisterType((*CollectorStatus)(nil), "datadog.process_agent.CollectorStatus")
 	proto.RegisterType((*Process)(nil), "datadog.process_agent.Process")
+	proto.RegisterType((*ShortLivedProcess)(nil), "datadog.process_agent.ShortLivedProcess")
+	proto.RegisterType((*ProcessEvent)(nil), "datadog.process_agent.ProcessEvent")
 	proto.RegisterType((*Command)(nil), "datadog.process_agent.Command")
+	proto.RegisterType((*ExeFingerprint)(nil), "datadog.process_agent.ExeFingerprint")
 	proto.RegisterType((*ProcessUser)(nil), "datadog.process_agent.ProcessUser")
 	proto.RegisterType((*Container)(nil), "datadog.process_agent.Container")
 	proto.RegisterType((*ProcessStat)(nil), "datadog.process_agent.ProcessStat")
@@ -922,18 +1550,33 @@ func init() {
 	proto.RegisterType((*OSInfo)(nil), "datadog.process_agent.OSInfo")
 	proto.RegisterType((*IOStat)(nil), "datadog.process_agent.IOStat")
 	proto.RegisterType((*Connection)(nil), "datadog.process_agent.Connection")
+	proto.RegisterType((*TCPStats)(nil), "datadog.process_agent.TCPStats")
+	proto.RegisterType((*Listener)(nil), "datadog.process_agent.Listener")
 	proto.RegisterType((*Addr)(nil), "datadog.process_agent.Addr")
 	proto.RegisterType((*MemoryStat)(nil), "datadog.process_agent.MemoryStat")
+	proto.RegisterType((*FdStat)(nil), "datadog.process_agent.FdStat")
+	proto.RegisterType((*FdPath)(nil), "datadog.process_agent.FdPath")
+	proto.RegisterType((*EnvVar)(nil), "datadog.process_agent.EnvVar")
+	proto.RegisterType((*SystemdUnit)(nil), "datadog.process_agent.SystemdUnit")
+	proto.RegisterType((*ProcessSecurity)(nil), "datadog.process_agent.ProcessSecurity")
+	proto.RegisterType((*Namespaces)(nil), "datadog.process_agent.Namespaces")
+	proto.RegisterType((*ResourceLimit)(nil), "datadog.process_agent.ResourceLimit")
+	proto.RegisterType((*ProcessLimits)(nil), "datadog.process_agent.ProcessLimits")
+	proto.RegisterType((*SchedStat)(nil), "datadog.process_agent.SchedStat")
 	proto.RegisterType((*CPUStat)(nil), "datadog.process_agent.CPUStat")
 	proto.RegisterType((*SingleCPUStat)(nil), "datadog.process_agent.SingleCPUStat")
 	proto.RegisterType((*CPUInfo)(nil), "datadog.process_agent.CPUInfo")
 	proto.RegisterType((*Host)(nil), "datadog.process_agent.Host")
 	proto.RegisterType((*HostTags)(nil), "datadog.process_agent.HostTags")
+	proto.RegisterEnum("datadog.process_agent.ProcessEventType", ProcessEventType_name, ProcessEventType_value)
 	proto.RegisterEnum("datadog.process_agent.ContainerState", ContainerState_name, ContainerState_value)
 	proto.RegisterEnum("datadog.process_agent.ContainerHealth", ContainerHealth_name, ContainerHealth_value)
 	proto.RegisterEnum("datadog.process_agent.ProcessState", ProcessState_name, ProcessState_value)
 	proto.RegisterEnum("datadog.process_agent.ConnectionType", ConnectionType_name, ConnectionType_value)
 	proto.RegisterEnum("datadog.process_agent.ConnectionFamily", ConnectionFamily_name, ConnectionFamily_value)
+	proto.RegisterEnum("datadog.process_agent.TCPState", TCPState_name, TCPState_value)
+	proto.RegisterEnum("datadog.process_agent.ListenerProtocol", ListenerProtocol_name, ListenerProtocol_value)
+	proto.RegisterEnum("datadog.process_agent.SeccompMode", SeccompMode_name, SeccompMode_value)
 }
 func (m *ResCollector) Marshal() (data []byte, err error) {
 	size := m.Size()
@@ -1097,6 +1740,30 @@ func (m *CollectorProc) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.ShortLivedProcesses) > 0 {
+		for _, msg := range m.ShortLivedProcesses {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.HostCpus) > 0 {
+		for _, msg := range m.HostCpus {
+			data[i] = 0x62
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1156,6 +1823,52 @@ func (m *CollectorConnections) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
+func (m *CollectorListeners) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CollectorListeners) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostName) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.HostName)))
+		i += copy(data[i:], m.HostName)
+	}
+	if len(m.Listeners) > 0 {
+		for _, msg := range m.Listeners {
+			data[i] = 0x12
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.GroupId != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.GroupId))
+	}
+	if m.GroupSize != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.GroupSize))
+	}
+	return i, nil
+}
+
 func (m *CollectorRealTime) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -1231,6 +1944,18 @@ func (m *CollectorRealTime) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.HostCpus) > 0 {
+		for _, msg := range m.HostCpus {
+			data[i] = 0x5a
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -1381,7 +2106,7 @@ func (m *CollectorContainerRealTime) MarshalTo(data []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CollectorReqStatus) Marshal() (data []byte, err error) {
+func (m *CollectorProcEvent) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1391,7 +2116,53 @@ func (m *CollectorReqStatus) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *CollectorReqStatus) MarshalTo(data []byte) (int, error) {
+func (m *CollectorProcEvent) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostName) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.HostName)))
+		i += copy(data[i:], m.HostName)
+	}
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			data[i] = 0x12
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.GroupId != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.GroupId))
+	}
+	if m.GroupSize != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.GroupSize))
+	}
+	return i, nil
+}
+
+func (m *CollectorReqStatus) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CollectorReqStatus) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1584,27 +2355,110 @@ func (m *Process) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintAgent(data, i, uint64(len(m.ContainerByteKey)))
 		i += copy(data[i:], m.ContainerByteKey)
 	}
-	return i, nil
-}
-
-func (m *Command) Marshal() (data []byte, err error) {
-	size := m.Size()
-	data = make([]byte, size)
-	n, err := m.MarshalTo(data)
-	if err != nil {
-		return nil, err
+	if m.SchedStat != nil {
+		data[i] = 0xa2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SchedStat.Size()))
+		n19, err := m.SchedStat.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
 	}
-	return data[:n], nil
-}
-
-func (m *Command) MarshalTo(data []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			data[i] = 0xa
+	if m.Limits != nil {
+		data[i] = 0xaa
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Limits.Size()))
+		n20, err := m.Limits.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.FdUtilizationPct != 0 {
+		data[i] = 0xb5
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.FdUtilizationPct))))
+	}
+	if m.FdStat != nil {
+		data[i] = 0xba
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.FdStat.Size()))
+		n21, err := m.FdStat.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.Namespaces != nil {
+		data[i] = 0xc2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Namespaces.Size()))
+		n22, err := m.Namespaces.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if len(m.Cgroup) > 0 {
+		data[i] = 0xca
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Cgroup)))
+		i += copy(data[i:], m.Cgroup)
+	}
+	if m.Security != nil {
+		data[i] = 0xd2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Security.Size()))
+		n23, err := m.Security.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if m.Systemd != nil {
+		data[i] = 0xda
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Systemd.Size()))
+		n24, err := m.Systemd.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if m.Reparented {
+		data[i] = 0xe0
+		i++
+		data[i] = 0x1
+		i++
+		if m.Reparented {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			data[i] = 0xea
+			i++
+			data[i] = 0x1
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -1617,48 +2471,36 @@ func (m *Command) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], s)
 		}
 	}
-	if len(m.Cwd) > 0 {
-		data[i] = 0x1a
-		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Cwd)))
-		i += copy(data[i:], m.Cwd)
+	if len(m.Env) > 0 {
+		for _, msg := range m.Env {
+			data[i] = 0xf2
+			i++
+			data[i] = 0x1
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if len(m.Root) > 0 {
-		data[i] = 0x22
+	if m.EnvTruncated {
+		data[i] = 0xf8
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Root)))
-		i += copy(data[i:], m.Root)
-	}
-	if m.OnDisk {
-		data[i] = 0x28
+		data[i] = 0x1
 		i++
-		if m.OnDisk {
+		if m.EnvTruncated {
 			data[i] = 1
 		} else {
 			data[i] = 0
 		}
 		i++
 	}
-	if m.Ppid != 0 {
-		data[i] = 0x30
-		i++
-		i = encodeVarintAgent(data, i, uint64(m.Ppid))
-	}
-	if m.Pgroup != 0 {
-		data[i] = 0x38
-		i++
-		i = encodeVarintAgent(data, i, uint64(m.Pgroup))
-	}
-	if len(m.Exe) > 0 {
-		data[i] = 0x42
-		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Exe)))
-		i += copy(data[i:], m.Exe)
-	}
 	return i, nil
 }
 
-func (m *ProcessUser) Marshal() (data []byte, err error) {
+func (m *ShortLivedProcess) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1668,51 +2510,66 @@ func (m *ProcessUser) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *ProcessUser) MarshalTo(data []byte) (int, error) {
+func (m *ShortLivedProcess) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		data[i] = 0xa
+	if m.Pid != 0 {
+		data[i] = 0x8
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
-		i += copy(data[i:], m.Name)
+		i = encodeVarintAgent(data, i, uint64(m.Pid))
 	}
-	if m.Uid != 0 {
-		data[i] = 0x10
+	if m.Command != nil {
+		data[i] = 0x12
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Uid))
+		i = encodeVarintAgent(data, i, uint64(m.Command.Size()))
+		n25, err := m.Command.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
 	}
-	if m.Gid != 0 {
-		data[i] = 0x18
+	if m.User != nil {
+		data[i] = 0x1a
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Gid))
+		i = encodeVarintAgent(data, i, uint64(m.User.Size()))
+		n26, err := m.User.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
 	}
-	if m.Euid != 0 {
+	if m.CreateTime != 0 {
 		data[i] = 0x20
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Euid))
+		i = encodeVarintAgent(data, i, uint64(m.CreateTime))
 	}
-	if m.Egid != 0 {
+	if m.ExitTime != 0 {
 		data[i] = 0x28
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Egid))
+		i = encodeVarintAgent(data, i, uint64(m.ExitTime))
 	}
-	if m.Suid != 0 {
+	if m.ExitCode != 0 {
 		data[i] = 0x30
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Suid))
+		i = encodeVarintAgent(data, i, uint64(m.ExitCode))
 	}
-	if m.Sgid != 0 {
+	if m.ExitSignal != 0 {
 		data[i] = 0x38
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Sgid))
+		i = encodeVarintAgent(data, i, uint64(m.ExitSignal))
+	}
+	if len(m.ContainerId) > 0 {
+		data[i] = 0x42
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.ContainerId)))
+		i += copy(data[i:], m.ContainerId)
 	}
 	return i, nil
 }
 
-func (m *Container) Marshal() (data []byte, err error) {
+func (m *ProcessEvent) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1722,182 +2579,250 @@ func (m *Container) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *Container) MarshalTo(data []byte) (int, error) {
+func (m *ProcessEvent) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Type) > 0 {
-		data[i] = 0xa
+	if m.Type != 0 {
+		data[i] = 0x8
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Type)))
-		i += copy(data[i:], m.Type)
+		i = encodeVarintAgent(data, i, uint64(m.Type))
 	}
-	if len(m.Id) > 0 {
-		data[i] = 0x12
+	if m.Timestamp != 0 {
+		data[i] = 0x10
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Id)))
-		i += copy(data[i:], m.Id)
+		i = encodeVarintAgent(data, i, uint64(m.Timestamp))
 	}
-	if len(m.Name) > 0 {
-		data[i] = 0x1a
+	if m.Pid != 0 {
+		data[i] = 0x18
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
-		i += copy(data[i:], m.Name)
+		i = encodeVarintAgent(data, i, uint64(m.Pid))
 	}
-	if len(m.Image) > 0 {
-		data[i] = 0x22
+	if m.CreateTime != 0 {
+		data[i] = 0x20
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Image)))
-		i += copy(data[i:], m.Image)
+		i = encodeVarintAgent(data, i, uint64(m.CreateTime))
 	}
-	if m.CpuLimit != 0 {
-		data[i] = 0x2d
+	if m.Command != nil {
+		data[i] = 0x2a
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.CpuLimit))))
-	}
-	if m.MemoryLimit != 0 {
-		data[i] = 0x30
-		i++
-		i = encodeVarintAgent(data, i, uint64(m.MemoryLimit))
+		i = encodeVarintAgent(data, i, uint64(m.Command.Size()))
+		n27, err := m.Command.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
 	}
-	if m.State != 0 {
-		data[i] = 0x40
+	if m.User != nil {
+		data[i] = 0x32
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.State))
+		i = encodeVarintAgent(data, i, uint64(m.User.Size()))
+		n28, err := m.User.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
 	}
-	if m.Health != 0 {
-		data[i] = 0x48
+	if len(m.ContainerId) > 0 {
+		data[i] = 0x3a
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Health))
+		i = encodeVarintAgent(data, i, uint64(len(m.ContainerId)))
+		i += copy(data[i:], m.ContainerId)
 	}
-	if m.Created != 0 {
-		data[i] = 0x50
+	if m.PreviousCommand != nil {
+		data[i] = 0x42
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Created))
+		i = encodeVarintAgent(data, i, uint64(m.PreviousCommand.Size()))
+		n29, err := m.PreviousCommand.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
 	}
-	if m.Rbps != 0 {
-		data[i] = 0x5d
+	if m.PreviousUser != nil {
+		data[i] = 0x4a
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.Rbps))))
+		i = encodeVarintAgent(data, i, uint64(m.PreviousUser.Size()))
+		n30, err := m.PreviousUser.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
 	}
-	if m.Wbps != 0 {
-		data[i] = 0x65
+	if m.ExitStatusKnown {
+		data[i] = 0x50
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.Wbps))))
-	}
-	if m.Key != 0 {
-		data[i] = 0x68
+		if m.ExitStatusKnown {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Key))
 	}
-	if m.NetRcvdPs != 0 {
-		data[i] = 0x75
+	if m.ExitCode != 0 {
+		data[i] = 0x58
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.NetRcvdPs))))
+		i = encodeVarintAgent(data, i, uint64(m.ExitCode))
 	}
-	if m.NetSentPs != 0 {
-		data[i] = 0x7d
+	if m.ExitSignal != 0 {
+		data[i] = 0x60
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.NetSentPs))))
+		i = encodeVarintAgent(data, i, uint64(m.ExitSignal))
 	}
-	if m.NetRcvdBps != 0 {
-		data[i] = 0x85
+	if m.Zombie {
+		data[i] = 0x68
 		i++
-		data[i] = 0x1
+		if m.Zombie {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.NetRcvdBps))))
 	}
-	if m.NetSentBps != 0 {
-		data[i] = 0x8d
+	if m.OomKilled {
+		data[i] = 0x70
 		i++
-		data[i] = 0x1
+		if m.OomKilled {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.NetSentBps))))
 	}
-	if m.UserPct != 0 {
-		data[i] = 0x95
-		i++
-		data[i] = 0x1
-		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.UserPct))))
+	return i, nil
+}
+
+func (m *Command) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	if m.SystemPct != 0 {
-		data[i] = 0x9d
-		i++
-		data[i] = 0x1
-		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.SystemPct))))
+	return data[:n], nil
+}
+
+func (m *Command) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			data[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
 	}
-	if m.TotalPct != 0 {
-		data[i] = 0xa5
+	if len(m.Cwd) > 0 {
+		data[i] = 0x1a
 		i++
-		data[i] = 0x1
+		i = encodeVarintAgent(data, i, uint64(len(m.Cwd)))
+		i += copy(data[i:], m.Cwd)
+	}
+	if len(m.Root) > 0 {
+		data[i] = 0x22
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.TotalPct))))
+		i = encodeVarintAgent(data, i, uint64(len(m.Root)))
+		i += copy(data[i:], m.Root)
 	}
-	if m.MemRss != 0 {
-		data[i] = 0xa8
+	if m.OnDisk {
+		data[i] = 0x28
 		i++
-		data[i] = 0x1
+		if m.OnDisk {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.MemRss))
 	}
-	if m.MemCache != 0 {
-		data[i] = 0xb0
+	if m.Ppid != 0 {
+		data[i] = 0x30
 		i++
-		data[i] = 0x1
+		i = encodeVarintAgent(data, i, uint64(m.Ppid))
+	}
+	if m.Pgroup != 0 {
+		data[i] = 0x38
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.MemCache))
+		i = encodeVarintAgent(data, i, uint64(m.Pgroup))
 	}
-	if m.Host != nil {
-		data[i] = 0xba
+	if len(m.Exe) > 0 {
+		data[i] = 0x42
 		i++
-		data[i] = 0x1
+		i = encodeVarintAgent(data, i, uint64(len(m.Exe)))
+		i += copy(data[i:], m.Exe)
+	}
+	if m.Fingerprint != nil {
+		data[i] = 0x4a
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Host.Size()))
-		n19, err := m.Host.MarshalTo(data[i:])
+		i = encodeVarintAgent(data, i, uint64(m.Fingerprint.Size()))
+		n31, err := m.Fingerprint.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n31
 	}
-	if m.Started != 0 {
-		data[i] = 0xc0
+	return i, nil
+}
+
+func (m *ExeFingerprint) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ExeFingerprint) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sha256) > 0 {
+		data[i] = 0xa
 		i++
-		data[i] = 0x1
+		i = encodeVarintAgent(data, i, uint64(len(m.Sha256)))
+		i += copy(data[i:], m.Sha256)
+	}
+	if len(m.BuildId) > 0 {
+		data[i] = 0x12
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Started))
+		i = encodeVarintAgent(data, i, uint64(len(m.BuildId)))
+		i += copy(data[i:], m.BuildId)
 	}
-	if len(m.ByteKey) > 0 {
-		data[i] = 0xca
+	if len(m.PackageManager) > 0 {
+		data[i] = 0x1a
 		i++
-		data[i] = 0x1
+		i = encodeVarintAgent(data, i, uint64(len(m.PackageManager)))
+		i += copy(data[i:], m.PackageManager)
+	}
+	if len(m.Package) > 0 {
+		data[i] = 0x22
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.ByteKey)))
-		i += copy(data[i:], m.ByteKey)
+		i = encodeVarintAgent(data, i, uint64(len(m.Package)))
+		i += copy(data[i:], m.Package)
 	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			data[i] = 0xd2
-			i++
-			data[i] = 0x1
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
+	if len(m.PackageVersion) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.PackageVersion)))
+		i += copy(data[i:], m.PackageVersion)
 	}
 	return i, nil
 }
 
-func (m *ProcessStat) Marshal() (data []byte, err error) {
+func (m *ProcessUser) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -1907,48 +2832,304 @@ func (m *ProcessStat) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *ProcessStat) MarshalTo(data []byte) (int, error) {
+func (m *ProcessUser) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pid != 0 {
-		data[i] = 0x8
+	if len(m.Name) > 0 {
+		data[i] = 0xa
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Pid))
+		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
 	}
-	if m.CreateTime != 0 {
+	if m.Uid != 0 {
 		data[i] = 0x10
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.CreateTime))
+		i = encodeVarintAgent(data, i, uint64(m.Uid))
 	}
-	if m.Memory != nil {
-		data[i] = 0x1a
+	if m.Gid != 0 {
+		data[i] = 0x18
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Memory.Size()))
-		n20, err := m.Memory.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
+		i = encodeVarintAgent(data, i, uint64(m.Gid))
 	}
-	if m.Cpu != nil {
-		data[i] = 0x22
+	if m.Euid != 0 {
+		data[i] = 0x20
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Cpu.Size()))
-		n21, err := m.Cpu.MarshalTo(data[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n21
+		i = encodeVarintAgent(data, i, uint64(m.Euid))
 	}
-	if m.Nice != 0 {
+	if m.Egid != 0 {
 		data[i] = 0x28
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Nice))
+		i = encodeVarintAgent(data, i, uint64(m.Egid))
 	}
-	if m.Threads != 0 {
-		data[i] = 0x38
+	if m.Suid != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Suid))
+	}
+	if m.Sgid != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Sgid))
+	}
+	if m.Fsuid != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Fsuid))
+	}
+	if m.Fsgid != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Fsgid))
+	}
+	if len(m.Groups) > 0 {
+		for _, num := range m.Groups {
+			data[i] = 0x50
+			i++
+			i = encodeVarintAgent(data, i, uint64(num))
+		}
+	}
+	return i, nil
+}
+
+func (m *Container) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Container) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Type)))
+		i += copy(data[i:], m.Type)
+	}
+	if len(m.Id) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Id)))
+		i += copy(data[i:], m.Id)
+	}
+	if len(m.Name) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
+	}
+	if len(m.Image) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Image)))
+		i += copy(data[i:], m.Image)
+	}
+	if m.CpuLimit != 0 {
+		data[i] = 0x2d
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.CpuLimit))))
+	}
+	if m.MemoryLimit != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemoryLimit))
+	}
+	if m.State != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.State))
+	}
+	if m.Health != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Health))
+	}
+	if m.Created != 0 {
+		data[i] = 0x50
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Created))
+	}
+	if m.Rbps != 0 {
+		data[i] = 0x5d
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.Rbps))))
+	}
+	if m.Wbps != 0 {
+		data[i] = 0x65
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.Wbps))))
+	}
+	if m.Key != 0 {
+		data[i] = 0x68
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Key))
+	}
+	if m.NetRcvdPs != 0 {
+		data[i] = 0x75
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.NetRcvdPs))))
+	}
+	if m.NetSentPs != 0 {
+		data[i] = 0x7d
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.NetSentPs))))
+	}
+	if m.NetRcvdBps != 0 {
+		data[i] = 0x85
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.NetRcvdBps))))
+	}
+	if m.NetSentBps != 0 {
+		data[i] = 0x8d
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.NetSentBps))))
+	}
+	if m.UserPct != 0 {
+		data[i] = 0x95
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.UserPct))))
+	}
+	if m.SystemPct != 0 {
+		data[i] = 0x9d
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.SystemPct))))
+	}
+	if m.TotalPct != 0 {
+		data[i] = 0xa5
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.TotalPct))))
+	}
+	if m.MemRss != 0 {
+		data[i] = 0xa8
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemRss))
+	}
+	if m.MemCache != 0 {
+		data[i] = 0xb0
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.MemCache))
+	}
+	if m.Host != nil {
+		data[i] = 0xba
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Host.Size()))
+		n32, err := m.Host.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if m.Started != 0 {
+		data[i] = 0xc0
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Started))
+	}
+	if len(m.ByteKey) > 0 {
+		data[i] = 0xca
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.ByteKey)))
+		i += copy(data[i:], m.ByteKey)
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			data[i] = 0xd2
+			i++
+			data[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ProcessStat) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ProcessStat) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Pid))
+	}
+	if m.CreateTime != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.CreateTime))
+	}
+	if m.Memory != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Memory.Size()))
+		n33, err := m.Memory.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if m.Cpu != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Cpu.Size()))
+		n34, err := m.Cpu.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if m.Nice != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Nice))
+	}
+	if m.Threads != 0 {
+		data[i] = 0x38
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Threads))
 	}
@@ -2010,11 +3191,11 @@ func (m *ProcessStat) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.IoStat.Size()))
-		n22, err := m.IoStat.MarshalTo(data[i:])
+		n35, err := m.IoStat.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n35
 	}
 	if m.ContainerNetRcvdPs != 0 {
 		data[i] = 0xa5
@@ -2074,10 +3255,22 @@ func (m *ProcessStat) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintAgent(data, i, uint64(len(m.ContainerByteKey)))
 		i += copy(data[i:], m.ContainerByteKey)
 	}
-	return i, nil
-}
-
-func (m *ContainerStat) Marshal() (data []byte, err error) {
+	if m.SchedStat != nil {
+		data[i] = 0xe2
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SchedStat.Size()))
+		n36, err := m.SchedStat.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	return i, nil
+}
+
+func (m *ContainerStat) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -2225,11 +3418,11 @@ func (m *SystemInfo) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Os.Size()))
-		n23, err := m.Os.MarshalTo(data[i:])
+		n37, err := m.Os.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n37
 	}
 	if len(m.Cpus) > 0 {
 		for _, msg := range m.Cpus {
@@ -2361,21 +3554,21 @@ func (m *Connection) MarshalTo(data []byte) (int, error) {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Laddr.Size()))
-		n24, err := m.Laddr.MarshalTo(data[i:])
+		n38, err := m.Laddr.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n38
 	}
 	if m.Raddr != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Raddr.Size()))
-		n25, err := m.Raddr.MarshalTo(data[i:])
+		n39, err := m.Raddr.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n39
 	}
 	if m.BytesSent != 0 {
 		data[i] = 0x45
@@ -2402,6 +3595,148 @@ func (m *Connection) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.PidCreateTime))
 	}
+	if m.TcpState != 0 {
+		data[i] = 0x68
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.TcpState))
+	}
+	if m.FromProcfs {
+		data[i] = 0x70
+		i++
+		if m.FromProcfs {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if m.TcpStats != nil {
+		data[i] = 0x7a
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.TcpStats.Size()))
+		n40, err := m.TcpStats.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	return i, nil
+}
+
+func (m *TCPStats) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TCPStats) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Rtt != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Rtt))
+	}
+	if m.RttVar != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.RttVar))
+	}
+	if m.Retransmits != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Retransmits))
+	}
+	if m.SndCwnd != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SndCwnd))
+	}
+	if m.RecvQueue != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.RecvQueue))
+	}
+	if m.SendQueue != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SendQueue))
+	}
+	return i, nil
+}
+
+func (m *Listener) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Listener) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Pid))
+	}
+	if m.PidCreateTime != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.PidCreateTime))
+	}
+	if m.Protocol != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Protocol))
+	}
+	if m.Family != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Family))
+	}
+	if m.Addr != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Addr.Size()))
+		n41, err := m.Addr.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if len(m.Path) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Path)))
+		i += copy(data[i:], m.Path)
+	}
+	if m.Inode != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Inode))
+	}
+	if m.NetNamespace != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.NetNamespace))
+	}
+	if m.SharedBy != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SharedBy))
+	}
 	return i, nil
 }
 
@@ -2424,11 +3759,11 @@ func (m *Addr) MarshalTo(data []byte) (int, error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Host.Size()))
-		n26, err := m.Host.MarshalTo(data[i:])
+		n42, err := m.Host.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n42
 	}
 	if len(m.Ip) > 0 {
 		data[i] = 0x12
@@ -2499,10 +3834,35 @@ func (m *MemoryStat) MarshalTo(data []byte) (int, error) {
 		i++
 		i = encodeVarintAgent(data, i, uint64(m.Dirty))
 	}
+	if m.Pss != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Pss))
+	}
+	if m.Uss != 0 {
+		data[i] = 0x50
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Uss))
+	}
+	if m.SwapPss != 0 {
+		data[i] = 0x58
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SwapPss))
+	}
+	if m.Anonymous != 0 {
+		data[i] = 0x60
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Anonymous))
+	}
+	if m.FileBacked != 0 {
+		data[i] = 0x68
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.FileBacked))
+	}
 	return i, nil
 }
 
-func (m *CPUStat) Marshal() (data []byte, err error) {
+func (m *FdStat) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -2512,40 +3872,64 @@ func (m *CPUStat) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *CPUStat) MarshalTo(data []byte) (int, error) {
+func (m *FdStat) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.LastCpu) > 0 {
-		data[i] = 0xa
+	if m.Files != 0 {
+		data[i] = 0x8
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.LastCpu)))
-		i += copy(data[i:], m.LastCpu)
+		i = encodeVarintAgent(data, i, uint64(m.Files))
 	}
-	if m.TotalPct != 0 {
-		data[i] = 0x15
+	if m.Sockets != 0 {
+		data[i] = 0x10
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.TotalPct))))
+		i = encodeVarintAgent(data, i, uint64(m.Sockets))
 	}
-	if m.UserPct != 0 {
-		data[i] = 0x1d
+	if m.Pipes != 0 {
+		data[i] = 0x18
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.UserPct))))
+		i = encodeVarintAgent(data, i, uint64(m.Pipes))
 	}
-	if m.SystemPct != 0 {
-		data[i] = 0x25
+	if m.Eventfds != 0 {
+		data[i] = 0x20
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.SystemPct))))
+		i = encodeVarintAgent(data, i, uint64(m.Eventfds))
 	}
-	if m.NumThreads != 0 {
+	if m.Epolls != 0 {
 		data[i] = 0x28
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.NumThreads))
+		i = encodeVarintAgent(data, i, uint64(m.Epolls))
 	}
-	if len(m.Cpus) > 0 {
-		for _, msg := range m.Cpus {
-			data[i] = 0x32
+	if m.AnonInodes != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.AnonInodes))
+	}
+	if m.Devices != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Devices))
+	}
+	if m.Other != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Other))
+	}
+	if m.Truncated {
+		data[i] = 0x48
+		i++
+		if m.Truncated {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.TopPaths) > 0 {
+		for _, msg := range m.TopPaths {
+			data[i] = 0x52
 			i++
 			i = encodeVarintAgent(data, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(data[i:])
@@ -2555,25 +3939,39 @@ func (m *CPUStat) MarshalTo(data []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.Nice != 0 {
-		data[i] = 0x38
-		i++
-		i = encodeVarintAgent(data, i, uint64(m.Nice))
+	return i, nil
+}
+
+func (m *FdPath) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	if m.UserTime != 0 {
-		data[i] = 0x40
+	return data[:n], nil
+}
+
+func (m *FdPath) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		data[i] = 0xa
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.UserTime))
+		i = encodeVarintAgent(data, i, uint64(len(m.Path)))
+		i += copy(data[i:], m.Path)
 	}
-	if m.SystemTime != 0 {
-		data[i] = 0x48
+	if m.Count != 0 {
+		data[i] = 0x10
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.SystemTime))
+		i = encodeVarintAgent(data, i, uint64(m.Count))
 	}
 	return i, nil
 }
 
-func (m *SingleCPUStat) Marshal() (data []byte, err error) {
+func (m *EnvVar) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -2583,7 +3981,7 @@ func (m *SingleCPUStat) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *SingleCPUStat) MarshalTo(data []byte) (int, error) {
+func (m *EnvVar) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2594,15 +3992,16 @@ func (m *SingleCPUStat) MarshalTo(data []byte) (int, error) {
 		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
 		i += copy(data[i:], m.Name)
 	}
-	if m.TotalPct != 0 {
-		data[i] = 0x15
+	if len(m.Value) > 0 {
+		data[i] = 0x12
 		i++
-		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.TotalPct))))
+		i = encodeVarintAgent(data, i, uint64(len(m.Value)))
+		i += copy(data[i:], m.Value)
 	}
 	return i, nil
 }
 
-func (m *CPUInfo) Marshal() (data []byte, err error) {
+func (m *SystemdUnit) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -2612,65 +4011,33 @@ func (m *CPUInfo) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *CPUInfo) MarshalTo(data []byte) (int, error) {
+func (m *SystemdUnit) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Number != 0 {
-		data[i] = 0x8
+	if len(m.Unit) > 0 {
+		data[i] = 0xa
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Number))
+		i = encodeVarintAgent(data, i, uint64(len(m.Unit)))
+		i += copy(data[i:], m.Unit)
 	}
-	if len(m.Vendor) > 0 {
+	if len(m.Slice) > 0 {
 		data[i] = 0x12
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Vendor)))
-		i += copy(data[i:], m.Vendor)
+		i = encodeVarintAgent(data, i, uint64(len(m.Slice)))
+		i += copy(data[i:], m.Slice)
 	}
-	if len(m.Family) > 0 {
+	if len(m.Scope) > 0 {
 		data[i] = 0x1a
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Family)))
-		i += copy(data[i:], m.Family)
-	}
-	if len(m.Model) > 0 {
-		data[i] = 0x22
-		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Model)))
-		i += copy(data[i:], m.Model)
-	}
-	if len(m.PhysicalId) > 0 {
-		data[i] = 0x2a
-		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.PhysicalId)))
-		i += copy(data[i:], m.PhysicalId)
-	}
-	if len(m.CoreId) > 0 {
-		data[i] = 0x32
-		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.CoreId)))
-		i += copy(data[i:], m.CoreId)
-	}
-	if m.Cores != 0 {
-		data[i] = 0x38
-		i++
-		i = encodeVarintAgent(data, i, uint64(m.Cores))
-	}
-	if m.Mhz != 0 {
-		data[i] = 0x40
-		i++
-		i = encodeVarintAgent(data, i, uint64(m.Mhz))
-	}
-	if m.CacheSize != 0 {
-		data[i] = 0x48
-		i++
-		i = encodeVarintAgent(data, i, uint64(m.CacheSize))
+		i = encodeVarintAgent(data, i, uint64(len(m.Scope)))
+		i += copy(data[i:], m.Scope)
 	}
 	return i, nil
 }
 
-func (m *Host) Marshal() (data []byte, err error) {
+func (m *ProcessSecurity) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -2680,43 +4047,30 @@ func (m *Host) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *Host) MarshalTo(data []byte) (int, error) {
+func (m *ProcessSecurity) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
+	if m.CapEffective != 0 {
 		data[i] = 0x8
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.Id))
+		i = encodeVarintAgent(data, i, uint64(m.CapEffective))
 	}
-	if m.OrgId != 0 {
+	if m.CapPermitted != 0 {
 		data[i] = 0x10
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.OrgId))
+		i = encodeVarintAgent(data, i, uint64(m.CapPermitted))
 	}
-	if len(m.Name) > 0 {
-		data[i] = 0x1a
+	if m.CapBounding != 0 {
+		data[i] = 0x18
 		i++
-		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
-		i += copy(data[i:], m.Name)
+		i = encodeVarintAgent(data, i, uint64(m.CapBounding))
 	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
+	if len(m.EffectiveCaps) > 0 {
+		for _, s := range m.EffectiveCaps {
 			data[i] = 0x22
 			i++
-			i = encodeVarintAgent(data, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(data[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.AllTags) > 0 {
-		for _, s := range m.AllTags {
-			data[i] = 0x32
-			i++
 			l = len(s)
 			for l >= 1<<7 {
 				data[i] = uint8(uint64(l)&0x7f | 0x80)
@@ -2728,20 +4082,31 @@ func (m *Host) MarshalTo(data []byte) (int, error) {
 			i += copy(data[i:], s)
 		}
 	}
-	if m.NumCpus != 0 {
-		data[i] = 0x38
+	if m.Seccomp != 0 {
+		data[i] = 0x28
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.NumCpus))
+		i = encodeVarintAgent(data, i, uint64(m.Seccomp))
 	}
-	if m.TotalMemory != 0 {
-		data[i] = 0x40
+	if m.NoNewPrivs {
+		data[i] = 0x30
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.TotalMemory))
+		if m.NoNewPrivs {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
+	}
+	if len(m.LsmLabel) > 0 {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.LsmLabel)))
+		i += copy(data[i:], m.LsmLabel)
 	}
 	return i, nil
 }
 
-func (m *HostTags) Marshal() (data []byte, err error) {
+func (m *Namespaces) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -2751,518 +4116,817 @@ func (m *HostTags) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *HostTags) MarshalTo(data []byte) (int, error) {
+func (m *Namespaces) MarshalTo(data []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.SourceType != 0 {
+	if m.Pid != 0 {
 		data[i] = 0x8
 		i++
-		i = encodeVarintAgent(data, i, uint64(m.SourceType))
+		i = encodeVarintAgent(data, i, uint64(m.Pid))
 	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			data[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
-			i++
-			i += copy(data[i:], s)
-		}
+	if m.Net != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Net))
+	}
+	if m.Mnt != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Mnt))
+	}
+	if m.User != 0 {
+		data[i] = 0x20
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.User))
+	}
+	if m.Uts != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Uts))
+	}
+	if m.Ipc != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Ipc))
+	}
+	if m.Cgroup != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Cgroup))
 	}
 	return i, nil
 }
 
-func encodeFixed64Agent(data []byte, offset int, v uint64) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	data[offset+4] = uint8(v >> 32)
-	data[offset+5] = uint8(v >> 40)
-	data[offset+6] = uint8(v >> 48)
-	data[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Agent(data []byte, offset int, v uint32) int {
-	data[offset] = uint8(v)
-	data[offset+1] = uint8(v >> 8)
-	data[offset+2] = uint8(v >> 16)
-	data[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintAgent(data []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		data[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ResourceLimit) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	data[offset] = uint8(v)
-	return offset + 1
+	return data[:n], nil
 }
-func (m *ResCollector) Size() (n int) {
+
+func (m *ResourceLimit) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Soft != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Soft))
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Hard != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Hard))
 	}
-	return n
+	return i, nil
 }
 
-func (m *ResCollector_Header) Size() (n int) {
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovAgent(uint64(m.Type))
+func (m *ProcessLimits) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *CollectorProc) Size() (n int) {
+func (m *ProcessLimits) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.HostName)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if len(m.Processes) > 0 {
-		for _, e := range m.Processes {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if m.OpenFiles != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.OpenFiles.Size()))
+		n43, err := m.OpenFiles.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n43
 	}
-	if m.Host != nil {
-		l = m.Host.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.GroupId != 0 {
-		n += 1 + sovAgent(uint64(m.GroupId))
-	}
-	if m.GroupSize != 0 {
-		n += 1 + sovAgent(uint64(m.GroupSize))
-	}
-	if m.Kubernetes != nil {
-		l = m.Kubernetes.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Processes != nil {
+		data[i] = 0x12
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Processes.Size()))
+		n44, err := m.Processes.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
 	}
-	if m.Ecs != nil {
-		l = m.Ecs.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.LockedMemory != nil {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.LockedMemory.Size()))
+		n45, err := m.LockedMemory.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
 	}
-	if len(m.Containers) > 0 {
-		for _, e := range m.Containers {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	if m.CoreSize != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.CoreSize.Size()))
+		n46, err := m.CoreSize.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n46
 	}
-	return n
+	return i, nil
 }
 
-func (m *CollectorConnections) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.HostName)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if len(m.Connections) > 0 {
-		for _, e := range m.Connections {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
-	}
-	if m.Host != nil {
-		l = m.Host.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.GroupId != 0 {
-		n += 1 + sovAgent(uint64(m.GroupId))
-	}
-	if m.GroupSize != 0 {
-		n += 1 + sovAgent(uint64(m.GroupSize))
+func (m *SchedStat) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *CollectorRealTime) Size() (n int) {
+func (m *SchedStat) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.HostName)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.RunTime != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.RunTime))
 	}
-	if len(m.Stats) > 0 {
-		for _, e := range m.Stats {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.RunQueueWait != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.RunQueueWait))
 	}
-	if m.HostId != 0 {
-		n += 1 + sovAgent(uint64(m.HostId))
+	if m.Timeslices != 0 {
+		data[i] = 0x18
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Timeslices))
 	}
-	if m.OrgId != 0 {
-		n += 1 + sovAgent(uint64(m.OrgId))
+	if m.RunQueueWaitPct != 0 {
+		data[i] = 0x25
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.RunQueueWaitPct))))
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovAgent(uint64(m.GroupId))
+	if m.DelayAcct {
+		data[i] = 0x28
+		i++
+		if m.DelayAcct {
+			data[i] = 1
+		} else {
+			data[i] = 0
+		}
+		i++
 	}
-	if m.GroupSize != 0 {
-		n += 1 + sovAgent(uint64(m.GroupSize))
+	if m.BlkioDelay != 0 {
+		data[i] = 0x30
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.BlkioDelay))
 	}
-	if m.NumCpus != 0 {
-		n += 1 + sovAgent(uint64(m.NumCpus))
+	if m.SwapinDelay != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SwapinDelay))
 	}
-	if m.TotalMemory != 0 {
-		n += 1 + sovAgent(uint64(m.TotalMemory))
+	if m.BlkioDelayPct != 0 {
+		data[i] = 0x45
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.BlkioDelayPct))))
 	}
-	if len(m.ContainerStats) > 0 {
-		for _, e := range m.ContainerStats {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.SwapinDelayPct != 0 {
+		data[i] = 0x4d
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.SwapinDelayPct))))
 	}
-	return n
+	return i, nil
 }
 
-func (m *CollectorContainer) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.HostName)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if len(m.Containers) > 0 {
-		for _, e := range m.Containers {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
-	}
-	if m.GroupId != 0 {
-		n += 1 + sovAgent(uint64(m.GroupId))
-	}
-	if m.GroupSize != 0 {
-		n += 1 + sovAgent(uint64(m.GroupSize))
-	}
-	if m.Kubernetes != nil {
-		l = m.Kubernetes.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Ecs != nil {
-		l = m.Ecs.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Host != nil {
-		l = m.Host.Size()
-		n += 1 + l + sovAgent(uint64(l))
+func (m *CPUStat) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *CollectorContainerRealTime) Size() (n int) {
+func (m *CPUStat) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.HostName)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.LastCpu) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.LastCpu)))
+		i += copy(data[i:], m.LastCpu)
 	}
-	if len(m.Stats) > 0 {
-		for _, e := range m.Stats {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.TotalPct != 0 {
+		data[i] = 0x15
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.TotalPct))))
 	}
-	if m.NumCpus != 0 {
-		n += 1 + sovAgent(uint64(m.NumCpus))
+	if m.UserPct != 0 {
+		data[i] = 0x1d
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.UserPct))))
 	}
-	if m.TotalMemory != 0 {
-		n += 1 + sovAgent(uint64(m.TotalMemory))
+	if m.SystemPct != 0 {
+		data[i] = 0x25
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.SystemPct))))
 	}
-	if m.HostId != 0 {
-		n += 1 + sovAgent(uint64(m.HostId))
+	if m.NumThreads != 0 {
+		data[i] = 0x28
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.NumThreads))
 	}
-	if m.GroupId != 0 {
-		n += 1 + sovAgent(uint64(m.GroupId))
+	if len(m.Cpus) > 0 {
+		for _, msg := range m.Cpus {
+			data[i] = 0x32
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.GroupSize != 0 {
-		n += 1 + sovAgent(uint64(m.GroupSize))
+	if m.Nice != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Nice))
 	}
-	return n
+	if m.UserTime != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.UserTime))
+	}
+	if m.SystemTime != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SystemTime))
+	}
+	return i, nil
 }
 
-func (m *CollectorReqStatus) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.HostName)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func (m *SingleCPUStat) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return data[:n], nil
 }
 
-func (m *CollectorStatus) Size() (n int) {
+func (m *SingleCPUStat) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ActiveClients != 0 {
-		n += 1 + sovAgent(uint64(m.ActiveClients))
+	if len(m.Name) > 0 {
+		data[i] = 0xa
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
 	}
-	if m.Interval != 0 {
-		n += 1 + sovAgent(uint64(m.Interval))
+	if m.TotalPct != 0 {
+		data[i] = 0x15
+		i++
+		i = encodeFixed32Agent(data, i, uint32(math.Float32bits(float32(m.TotalPct))))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Process) Size() (n int) {
+func (m *CPUInfo) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CPUInfo) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Key != 0 {
-		n += 1 + sovAgent(uint64(m.Key))
-	}
-	if m.Pid != 0 {
-		n += 1 + sovAgent(uint64(m.Pid))
+	if m.Number != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Number))
 	}
-	if m.Host != nil {
-		l = m.Host.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Vendor) > 0 {
+		data[i] = 0x12
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Vendor)))
+		i += copy(data[i:], m.Vendor)
 	}
-	if m.Command != nil {
-		l = m.Command.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Family) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Family)))
+		i += copy(data[i:], m.Family)
 	}
-	if m.User != nil {
-		l = m.User.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Model) > 0 {
+		data[i] = 0x22
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Model)))
+		i += copy(data[i:], m.Model)
 	}
-	if m.Memory != nil {
-		l = m.Memory.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.PhysicalId) > 0 {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.PhysicalId)))
+		i += copy(data[i:], m.PhysicalId)
 	}
-	if m.Cpu != nil {
-		l = m.Cpu.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.CoreId) > 0 {
+		data[i] = 0x32
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.CoreId)))
+		i += copy(data[i:], m.CoreId)
 	}
-	if m.CreateTime != 0 {
-		n += 1 + sovAgent(uint64(m.CreateTime))
+	if m.Cores != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Cores))
 	}
-	if m.Container != nil {
-		l = m.Container.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Mhz != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Mhz))
 	}
-	if m.OpenFdCount != 0 {
-		n += 1 + sovAgent(uint64(m.OpenFdCount))
+	if m.CacheSize != 0 {
+		data[i] = 0x48
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.CacheSize))
 	}
-	if m.State != 0 {
-		n += 1 + sovAgent(uint64(m.State))
+	return i, nil
+}
+
+func (m *Host) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
 	}
-	if m.IoStat != nil {
-		l = m.IoStat.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	return data[:n], nil
+}
+
+func (m *Host) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.Id))
 	}
-	l = len(m.ContainerId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.OrgId != 0 {
+		data[i] = 0x10
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.OrgId))
 	}
-	if m.ContainerKey != 0 {
-		n += 1 + sovAgent(uint64(m.ContainerKey))
+	if len(m.Name) > 0 {
+		data[i] = 0x1a
+		i++
+		i = encodeVarintAgent(data, i, uint64(len(m.Name)))
+		i += copy(data[i:], m.Name)
 	}
-	if m.VoluntaryCtxSwitches != 0 {
-		n += 2 + sovAgent(uint64(m.VoluntaryCtxSwitches))
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			data[i] = 0x22
+			i++
+			i = encodeVarintAgent(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.InvoluntaryCtxSwitches != 0 {
-		n += 2 + sovAgent(uint64(m.InvoluntaryCtxSwitches))
+	if len(m.AllTags) > 0 {
+		for _, s := range m.AllTags {
+			data[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
 	}
-	l = len(m.ByteKey)
-	if l > 0 {
-		n += 2 + l + sovAgent(uint64(l))
+	if m.NumCpus != 0 {
+		data[i] = 0x38
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.NumCpus))
 	}
-	l = len(m.ContainerByteKey)
-	if l > 0 {
-		n += 2 + l + sovAgent(uint64(l))
+	if m.TotalMemory != 0 {
+		data[i] = 0x40
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.TotalMemory))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Command) Size() (n int) {
+func (m *HostTags) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *HostTags) MarshalTo(data []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
+	if m.SourceType != 0 {
+		data[i] = 0x8
+		i++
+		i = encodeVarintAgent(data, i, uint64(m.SourceType))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			data[i] = 0x12
+			i++
 			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
 		}
 	}
-	l = len(m.Cwd)
-	if l > 0 {
+	return i, nil
+}
+
+func encodeFixed64Agent(data []byte, offset int, v uint64) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	data[offset+4] = uint8(v >> 32)
+	data[offset+5] = uint8(v >> 40)
+	data[offset+6] = uint8(v >> 48)
+	data[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Agent(data []byte, offset int, v uint32) int {
+	data[offset] = uint8(v)
+	data[offset+1] = uint8(v >> 8)
+	data[offset+2] = uint8(v >> 16)
+	data[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintAgent(data []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		data[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	data[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ResCollector) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.Root)
+	l = len(m.Message)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.OnDisk {
-		n += 2
-	}
-	if m.Ppid != 0 {
-		n += 1 + sovAgent(uint64(m.Ppid))
-	}
-	if m.Pgroup != 0 {
-		n += 1 + sovAgent(uint64(m.Pgroup))
-	}
-	l = len(m.Exe)
-	if l > 0 {
+	if m.Status != nil {
+		l = m.Status.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
 	return n
 }
 
-func (m *ProcessUser) Size() (n int) {
+func (m *ResCollector_Header) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	if m.Type != 0 {
+		n += 1 + sovAgent(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *CollectorProc) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostName)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Uid != 0 {
-		n += 1 + sovAgent(uint64(m.Uid))
+	if len(m.Processes) > 0 {
+		for _, e := range m.Processes {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	if m.Gid != 0 {
-		n += 1 + sovAgent(uint64(m.Gid))
+	if m.Host != nil {
+		l = m.Host.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Euid != 0 {
-		n += 1 + sovAgent(uint64(m.Euid))
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Egid != 0 {
-		n += 1 + sovAgent(uint64(m.Egid))
+	if m.GroupId != 0 {
+		n += 1 + sovAgent(uint64(m.GroupId))
 	}
-	if m.Suid != 0 {
-		n += 1 + sovAgent(uint64(m.Suid))
+	if m.GroupSize != 0 {
+		n += 1 + sovAgent(uint64(m.GroupSize))
 	}
-	if m.Sgid != 0 {
-		n += 1 + sovAgent(uint64(m.Sgid))
+	if m.Kubernetes != nil {
+		l = m.Kubernetes.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Ecs != nil {
+		l = m.Ecs.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.ShortLivedProcesses) > 0 {
+		for _, e := range m.ShortLivedProcesses {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.HostCpus) > 0 {
+		for _, e := range m.HostCpus {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *Container) Size() (n int) {
+func (m *CollectorConnections) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Type)
+	l = len(m.HostName)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if len(m.Connections) > 0 {
+		for _, e := range m.Connections {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	l = len(m.Name)
-	if l > 0 {
+	if m.Host != nil {
+		l = m.Host.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.Image)
+	if m.GroupId != 0 {
+		n += 1 + sovAgent(uint64(m.GroupId))
+	}
+	if m.GroupSize != 0 {
+		n += 1 + sovAgent(uint64(m.GroupSize))
+	}
+	return n
+}
+
+func (m *CollectorListeners) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostName)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.CpuLimit != 0 {
-		n += 5
+	if len(m.Listeners) > 0 {
+		for _, e := range m.Listeners {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	if m.MemoryLimit != 0 {
-		n += 1 + sovAgent(uint64(m.MemoryLimit))
+	if m.GroupId != 0 {
+		n += 1 + sovAgent(uint64(m.GroupId))
 	}
-	if m.State != 0 {
-		n += 1 + sovAgent(uint64(m.State))
+	if m.GroupSize != 0 {
+		n += 1 + sovAgent(uint64(m.GroupSize))
 	}
-	if m.Health != 0 {
-		n += 1 + sovAgent(uint64(m.Health))
+	return n
+}
+
+func (m *CollectorRealTime) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostName)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Created != 0 {
-		n += 1 + sovAgent(uint64(m.Created))
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	if m.Rbps != 0 {
-		n += 5
+	if m.HostId != 0 {
+		n += 1 + sovAgent(uint64(m.HostId))
 	}
-	if m.Wbps != 0 {
-		n += 5
+	if m.OrgId != 0 {
+		n += 1 + sovAgent(uint64(m.OrgId))
 	}
-	if m.Key != 0 {
-		n += 1 + sovAgent(uint64(m.Key))
+	if m.GroupId != 0 {
+		n += 1 + sovAgent(uint64(m.GroupId))
 	}
-	if m.NetRcvdPs != 0 {
-		n += 5
+	if m.GroupSize != 0 {
+		n += 1 + sovAgent(uint64(m.GroupSize))
 	}
-	if m.NetSentPs != 0 {
-		n += 5
+	if m.NumCpus != 0 {
+		n += 1 + sovAgent(uint64(m.NumCpus))
 	}
-	if m.NetRcvdBps != 0 {
-		n += 6
+	if m.TotalMemory != 0 {
+		n += 1 + sovAgent(uint64(m.TotalMemory))
 	}
-	if m.NetSentBps != 0 {
-		n += 6
+	if len(m.ContainerStats) > 0 {
+		for _, e := range m.ContainerStats {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	if m.UserPct != 0 {
-		n += 6
+	if len(m.HostCpus) > 0 {
+		for _, e := range m.HostCpus {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	if m.SystemPct != 0 {
-		n += 6
+	return n
+}
+
+func (m *CollectorContainer) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostName)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.TotalPct != 0 {
-		n += 6
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.MemRss != 0 {
-		n += 2 + sovAgent(uint64(m.MemRss))
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	if m.MemCache != 0 {
-		n += 2 + sovAgent(uint64(m.MemCache))
+	if m.GroupId != 0 {
+		n += 1 + sovAgent(uint64(m.GroupId))
+	}
+	if m.GroupSize != 0 {
+		n += 1 + sovAgent(uint64(m.GroupSize))
+	}
+	if m.Kubernetes != nil {
+		l = m.Kubernetes.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Ecs != nil {
+		l = m.Ecs.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
 	if m.Host != nil {
 		l = m.Host.Size()
-		n += 2 + l + sovAgent(uint64(l))
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Started != 0 {
-		n += 2 + sovAgent(uint64(m.Started))
+	return n
+}
+
+func (m *CollectorContainerRealTime) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostName)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.ByteKey)
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.NumCpus != 0 {
+		n += 1 + sovAgent(uint64(m.NumCpus))
+	}
+	if m.TotalMemory != 0 {
+		n += 1 + sovAgent(uint64(m.TotalMemory))
+	}
+	if m.HostId != 0 {
+		n += 1 + sovAgent(uint64(m.HostId))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovAgent(uint64(m.GroupId))
+	}
+	if m.GroupSize != 0 {
+		n += 1 + sovAgent(uint64(m.GroupSize))
+	}
+	return n
+}
+
+func (m *CollectorProcEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostName)
 	if l > 0 {
-		n += 2 + l + sovAgent(uint64(l))
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
-			n += 2 + l + sovAgent(uint64(l))
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
 		}
 	}
+	if m.GroupId != 0 {
+		n += 1 + sovAgent(uint64(m.GroupId))
+	}
+	if m.GroupSize != 0 {
+		n += 1 + sovAgent(uint64(m.GroupSize))
+	}
 	return n
 }
 
-func (m *ProcessStat) Size() (n int) {
+func (m *CollectorReqStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.HostName)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *CollectorStatus) Size() (n int) {
+	var l int
+	_ = l
+	if m.ActiveClients != 0 {
+		n += 1 + sovAgent(uint64(m.ActiveClients))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovAgent(uint64(m.Interval))
+	}
+	return n
+}
+
+func (m *Process) Size() (n int) {
 	var l int
 	_ = l
+	if m.Key != 0 {
+		n += 1 + sovAgent(uint64(m.Key))
+	}
 	if m.Pid != 0 {
 		n += 1 + sovAgent(uint64(m.Pid))
 	}
-	if m.CreateTime != 0 {
-		n += 1 + sovAgent(uint64(m.CreateTime))
+	if m.Host != nil {
+		l = m.Host.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Command != nil {
+		l = m.Command.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
 	if m.Memory != nil {
 		l = m.Memory.Size()
@@ -3272,55 +4936,29 @@ func (m *ProcessStat) Size() (n int) {
 		l = m.Cpu.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Nice != 0 {
-		n += 1 + sovAgent(uint64(m.Nice))
+	if m.CreateTime != 0 {
+		n += 1 + sovAgent(uint64(m.CreateTime))
 	}
-	if m.Threads != 0 {
-		n += 1 + sovAgent(uint64(m.Threads))
+	if m.Container != nil {
+		l = m.Container.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
 	if m.OpenFdCount != 0 {
 		n += 1 + sovAgent(uint64(m.OpenFdCount))
 	}
-	if m.Key != 0 {
-		n += 1 + sovAgent(uint64(m.Key))
+	if m.State != 0 {
+		n += 1 + sovAgent(uint64(m.State))
+	}
+	if m.IoStat != nil {
+		l = m.IoStat.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
 	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.ContainerState != 0 {
-		n += 1 + sovAgent(uint64(m.ContainerState))
-	}
-	if m.ProcessState != 0 {
-		n += 1 + sovAgent(uint64(m.ProcessState))
-	}
-	if m.ContainerHealth != 0 {
-		n += 1 + sovAgent(uint64(m.ContainerHealth))
-	}
-	if m.ContainerRbps != 0 {
-		n += 6
-	}
-	if m.ContainerWbps != 0 {
-		n += 6
-	}
 	if m.ContainerKey != 0 {
-		n += 2 + sovAgent(uint64(m.ContainerKey))
-	}
-	if m.IoStat != nil {
-		l = m.IoStat.Size()
-		n += 2 + l + sovAgent(uint64(l))
-	}
-	if m.ContainerNetRcvdPs != 0 {
-		n += 6
-	}
-	if m.ContainerNetSentPs != 0 {
-		n += 6
-	}
-	if m.ContainerNetRcvdBps != 0 {
-		n += 6
-	}
-	if m.ContainerNetSentBps != 0 {
-		n += 6
+		n += 1 + sovAgent(uint64(m.ContainerKey))
 	}
 	if m.VoluntaryCtxSwitches != 0 {
 		n += 2 + sovAgent(uint64(m.VoluntaryCtxSwitches))
@@ -3336,371 +4974,4571 @@ func (m *ProcessStat) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovAgent(uint64(l))
 	}
-	return n
-}
-
-func (m *ContainerStat) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.SchedStat != nil {
+		l = m.SchedStat.Size()
+		n += 2 + l + sovAgent(uint64(l))
 	}
-	if m.UserPct != 0 {
-		n += 5
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 2 + l + sovAgent(uint64(l))
 	}
-	if m.SystemPct != 0 {
-		n += 5
+	if m.FdUtilizationPct != 0 {
+		n += 6
 	}
-	if m.TotalPct != 0 {
-		n += 5
+	if m.FdStat != nil {
+		l = m.FdStat.Size()
+		n += 2 + l + sovAgent(uint64(l))
 	}
-	if m.CpuLimit != 0 {
-		n += 5
+	if m.Namespaces != nil {
+		l = m.Namespaces.Size()
+		n += 2 + l + sovAgent(uint64(l))
 	}
-	if m.MemRss != 0 {
-		n += 1 + sovAgent(uint64(m.MemRss))
+	l = len(m.Cgroup)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
 	}
-	if m.MemCache != 0 {
-		n += 1 + sovAgent(uint64(m.MemCache))
+	if m.Security != nil {
+		l = m.Security.Size()
+		n += 2 + l + sovAgent(uint64(l))
 	}
-	if m.MemLimit != 0 {
-		n += 1 + sovAgent(uint64(m.MemLimit))
+	if m.Systemd != nil {
+		l = m.Systemd.Size()
+		n += 2 + l + sovAgent(uint64(l))
 	}
-	if m.Rbps != 0 {
-		n += 5
+	if m.Reparented {
+		n += 3
 	}
-	if m.Wbps != 0 {
-		n += 5
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovAgent(uint64(l))
+		}
 	}
-	if m.NetRcvdPs != 0 {
-		n += 5
+	if len(m.Env) > 0 {
+		for _, e := range m.Env {
+			l = e.Size()
+			n += 2 + l + sovAgent(uint64(l))
+		}
 	}
-	if m.NetSentPs != 0 {
-		n += 5
+	if m.EnvTruncated {
+		n += 3
 	}
-	if m.NetRcvdBps != 0 {
-		n += 5
+	return n
+}
+
+func (m *ShortLivedProcess) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		n += 1 + sovAgent(uint64(m.Pid))
 	}
-	if m.NetSentBps != 0 {
-		n += 5
+	if m.Command != nil {
+		l = m.Command.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovAgent(uint64(m.State))
+	if m.User != nil {
+		l = m.User.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Health != 0 {
-		n += 2 + sovAgent(uint64(m.Health))
+	if m.CreateTime != 0 {
+		n += 1 + sovAgent(uint64(m.CreateTime))
 	}
-	if m.Key != 0 {
-		n += 2 + sovAgent(uint64(m.Key))
+	if m.ExitTime != 0 {
+		n += 1 + sovAgent(uint64(m.ExitTime))
 	}
-	if m.Started != 0 {
-		n += 2 + sovAgent(uint64(m.Started))
+	if m.ExitCode != 0 {
+		n += 1 + sovAgent(uint64(m.ExitCode))
 	}
-	l = len(m.ByteKey)
+	if m.ExitSignal != 0 {
+		n += 1 + sovAgent(uint64(m.ExitSignal))
+	}
+	l = len(m.ContainerId)
 	if l > 0 {
-		n += 2 + l + sovAgent(uint64(l))
+		n += 1 + l + sovAgent(uint64(l))
 	}
 	return n
 }
 
-func (m *SystemInfo) Size() (n int) {
+func (m *ProcessEvent) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovAgent(uint64(m.Type))
 	}
-	if m.Os != nil {
-		l = m.Os.Size()
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovAgent(uint64(m.Timestamp))
 	}
-	if len(m.Cpus) > 0 {
-		for _, e := range m.Cpus {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.Pid != 0 {
+		n += 1 + sovAgent(uint64(m.Pid))
 	}
-	if m.TotalMemory != 0 {
-		n += 1 + sovAgent(uint64(m.TotalMemory))
+	if m.CreateTime != 0 {
+		n += 1 + sovAgent(uint64(m.CreateTime))
 	}
-	return n
-}
-
-func (m *OSInfo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
+	if m.Command != nil {
+		l = m.Command.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.Platform)
-	if l > 0 {
+	if m.User != nil {
+		l = m.User.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.Family)
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.Version)
-	if l > 0 {
+	if m.PreviousCommand != nil {
+		l = m.PreviousCommand.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.KernelVersion)
-	if l > 0 {
+	if m.PreviousUser != nil {
+		l = m.PreviousUser.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	return n
-}
-
-func (m *IOStat) Size() (n int) {
-	var l int
-	_ = l
-	if m.ReadRate != 0 {
-		n += 5
+	if m.ExitStatusKnown {
+		n += 2
 	}
-	if m.WriteRate != 0 {
-		n += 5
+	if m.ExitCode != 0 {
+		n += 1 + sovAgent(uint64(m.ExitCode))
 	}
-	if m.ReadBytesRate != 0 {
-		n += 5
+	if m.ExitSignal != 0 {
+		n += 1 + sovAgent(uint64(m.ExitSignal))
 	}
-	if m.WriteBytesRate != 0 {
-		n += 5
+	if m.Zombie {
+		n += 2
+	}
+	if m.OomKilled {
+		n += 2
 	}
 	return n
 }
 
-func (m *Connection) Size() (n int) {
+func (m *Command) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pid != 0 {
-		n += 1 + sovAgent(uint64(m.Pid))
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
 	}
-	if m.Laddr != nil {
-		l = m.Laddr.Size()
+	l = len(m.Cwd)
+	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Raddr != nil {
-		l = m.Raddr.Size()
+	l = len(m.Root)
+	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.BytesSent != 0 {
-		n += 5
+	if m.OnDisk {
+		n += 2
 	}
-	if m.BytesRecieved != 0 {
-		n += 5
+	if m.Ppid != 0 {
+		n += 1 + sovAgent(uint64(m.Ppid))
 	}
-	if m.Family != 0 {
-		n += 1 + sovAgent(uint64(m.Family))
+	if m.Pgroup != 0 {
+		n += 1 + sovAgent(uint64(m.Pgroup))
 	}
-	if m.Type != 0 {
-		n += 1 + sovAgent(uint64(m.Type))
+	l = len(m.Exe)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.PidCreateTime != 0 {
-		n += 1 + sovAgent(uint64(m.PidCreateTime))
+	if m.Fingerprint != nil {
+		l = m.Fingerprint.Size()
+		n += 1 + l + sovAgent(uint64(l))
 	}
 	return n
 }
 
-func (m *Addr) Size() (n int) {
+func (m *ExeFingerprint) Size() (n int) {
 	var l int
 	_ = l
-	if m.Host != nil {
-		l = m.Host.Size()
+	l = len(m.Sha256)
+	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.Ip)
+	l = len(m.BuildId)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Port != 0 {
-		n += 1 + sovAgent(uint64(m.Port))
+	l = len(m.PackageManager)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.PackageVersion)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
 	return n
 }
 
-func (m *MemoryStat) Size() (n int) {
+func (m *ProcessUser) Size() (n int) {
 	var l int
 	_ = l
-	if m.Rss != 0 {
-		n += 1 + sovAgent(uint64(m.Rss))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Vms != 0 {
-		n += 1 + sovAgent(uint64(m.Vms))
+	if m.Uid != 0 {
+		n += 1 + sovAgent(uint64(m.Uid))
 	}
-	if m.Swap != 0 {
-		n += 1 + sovAgent(uint64(m.Swap))
+	if m.Gid != 0 {
+		n += 1 + sovAgent(uint64(m.Gid))
 	}
-	if m.Shared != 0 {
-		n += 1 + sovAgent(uint64(m.Shared))
+	if m.Euid != 0 {
+		n += 1 + sovAgent(uint64(m.Euid))
 	}
-	if m.Text != 0 {
-		n += 1 + sovAgent(uint64(m.Text))
+	if m.Egid != 0 {
+		n += 1 + sovAgent(uint64(m.Egid))
 	}
-	if m.Lib != 0 {
-		n += 1 + sovAgent(uint64(m.Lib))
+	if m.Suid != 0 {
+		n += 1 + sovAgent(uint64(m.Suid))
 	}
-	if m.Data != 0 {
-		n += 1 + sovAgent(uint64(m.Data))
+	if m.Sgid != 0 {
+		n += 1 + sovAgent(uint64(m.Sgid))
 	}
-	if m.Dirty != 0 {
-		n += 1 + sovAgent(uint64(m.Dirty))
+	if m.Fsuid != 0 {
+		n += 1 + sovAgent(uint64(m.Fsuid))
+	}
+	if m.Fsgid != 0 {
+		n += 1 + sovAgent(uint64(m.Fsgid))
+	}
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			n += 1 + sovAgent(uint64(e))
+		}
 	}
 	return n
 }
 
-func (m *CPUStat) Size() (n int) {
+func (m *Container) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.LastCpu)
+	l = len(m.Type)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.TotalPct != 0 {
-		n += 5
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.UserPct != 0 {
-		n += 5
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.SystemPct != 0 {
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.CpuLimit != 0 {
 		n += 5
 	}
-	if m.NumThreads != 0 {
-		n += 1 + sovAgent(uint64(m.NumThreads))
+	if m.MemoryLimit != 0 {
+		n += 1 + sovAgent(uint64(m.MemoryLimit))
 	}
-	if len(m.Cpus) > 0 {
-		for _, e := range m.Cpus {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.State != 0 {
+		n += 1 + sovAgent(uint64(m.State))
 	}
-	if m.Nice != 0 {
-		n += 1 + sovAgent(uint64(m.Nice))
+	if m.Health != 0 {
+		n += 1 + sovAgent(uint64(m.Health))
 	}
-	if m.UserTime != 0 {
-		n += 1 + sovAgent(uint64(m.UserTime))
+	if m.Created != 0 {
+		n += 1 + sovAgent(uint64(m.Created))
 	}
-	if m.SystemTime != 0 {
-		n += 1 + sovAgent(uint64(m.SystemTime))
+	if m.Rbps != 0 {
+		n += 5
 	}
-	return n
-}
-
-func (m *SingleCPUStat) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Wbps != 0 {
+		n += 5
 	}
-	if m.TotalPct != 0 {
+	if m.Key != 0 {
+		n += 1 + sovAgent(uint64(m.Key))
+	}
+	if m.NetRcvdPs != 0 {
 		n += 5
 	}
+	if m.NetSentPs != 0 {
+		n += 5
+	}
+	if m.NetRcvdBps != 0 {
+		n += 6
+	}
+	if m.NetSentBps != 0 {
+		n += 6
+	}
+	if m.UserPct != 0 {
+		n += 6
+	}
+	if m.SystemPct != 0 {
+		n += 6
+	}
+	if m.TotalPct != 0 {
+		n += 6
+	}
+	if m.MemRss != 0 {
+		n += 2 + sovAgent(uint64(m.MemRss))
+	}
+	if m.MemCache != 0 {
+		n += 2 + sovAgent(uint64(m.MemCache))
+	}
+	if m.Host != nil {
+		l = m.Host.Size()
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	if m.Started != 0 {
+		n += 2 + sovAgent(uint64(m.Started))
+	}
+	l = len(m.ByteKey)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovAgent(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *CPUInfo) Size() (n int) {
+func (m *ProcessStat) Size() (n int) {
 	var l int
 	_ = l
-	if m.Number != 0 {
-		n += 1 + sovAgent(uint64(m.Number))
+	if m.Pid != 0 {
+		n += 1 + sovAgent(uint64(m.Pid))
 	}
-	l = len(m.Vendor)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.CreateTime != 0 {
+		n += 1 + sovAgent(uint64(m.CreateTime))
 	}
-	l = len(m.Family)
-	if l > 0 {
+	if m.Memory != nil {
+		l = m.Memory.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.Model)
-	if l > 0 {
+	if m.Cpu != nil {
+		l = m.Cpu.Size()
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	l = len(m.PhysicalId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	if m.Nice != 0 {
+		n += 1 + sovAgent(uint64(m.Nice))
 	}
-	l = len(m.CoreId)
+	if m.Threads != 0 {
+		n += 1 + sovAgent(uint64(m.Threads))
+	}
+	if m.OpenFdCount != 0 {
+		n += 1 + sovAgent(uint64(m.OpenFdCount))
+	}
+	if m.Key != 0 {
+		n += 1 + sovAgent(uint64(m.Key))
+	}
+	l = len(m.ContainerId)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if m.Cores != 0 {
-		n += 1 + sovAgent(uint64(m.Cores))
+	if m.ContainerState != 0 {
+		n += 1 + sovAgent(uint64(m.ContainerState))
 	}
-	if m.Mhz != 0 {
-		n += 1 + sovAgent(uint64(m.Mhz))
+	if m.ProcessState != 0 {
+		n += 1 + sovAgent(uint64(m.ProcessState))
 	}
-	if m.CacheSize != 0 {
-		n += 1 + sovAgent(uint64(m.CacheSize))
+	if m.ContainerHealth != 0 {
+		n += 1 + sovAgent(uint64(m.ContainerHealth))
+	}
+	if m.ContainerRbps != 0 {
+		n += 6
+	}
+	if m.ContainerWbps != 0 {
+		n += 6
+	}
+	if m.ContainerKey != 0 {
+		n += 2 + sovAgent(uint64(m.ContainerKey))
+	}
+	if m.IoStat != nil {
+		l = m.IoStat.Size()
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	if m.ContainerNetRcvdPs != 0 {
+		n += 6
+	}
+	if m.ContainerNetSentPs != 0 {
+		n += 6
+	}
+	if m.ContainerNetRcvdBps != 0 {
+		n += 6
+	}
+	if m.ContainerNetSentBps != 0 {
+		n += 6
+	}
+	if m.VoluntaryCtxSwitches != 0 {
+		n += 2 + sovAgent(uint64(m.VoluntaryCtxSwitches))
+	}
+	if m.InvoluntaryCtxSwitches != 0 {
+		n += 2 + sovAgent(uint64(m.InvoluntaryCtxSwitches))
+	}
+	l = len(m.ByteKey)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ContainerByteKey)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	if m.SchedStat != nil {
+		l = m.SchedStat.Size()
+		n += 2 + l + sovAgent(uint64(l))
 	}
 	return n
 }
 
-func (m *Host) Size() (n int) {
+func (m *ContainerStat) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovAgent(uint64(m.Id))
-	}
-	if m.OrgId != 0 {
-		n += 1 + sovAgent(uint64(m.OrgId))
-	}
-	l = len(m.Name)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovAgent(uint64(l))
 	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.UserPct != 0 {
+		n += 5
 	}
-	if len(m.AllTags) > 0 {
-		for _, s := range m.AllTags {
-			l = len(s)
-			n += 1 + l + sovAgent(uint64(l))
-		}
+	if m.SystemPct != 0 {
+		n += 5
 	}
-	if m.NumCpus != 0 {
-		n += 1 + sovAgent(uint64(m.NumCpus))
+	if m.TotalPct != 0 {
+		n += 5
 	}
-	if m.TotalMemory != 0 {
-		n += 1 + sovAgent(uint64(m.TotalMemory))
+	if m.CpuLimit != 0 {
+		n += 5
+	}
+	if m.MemRss != 0 {
+		n += 1 + sovAgent(uint64(m.MemRss))
+	}
+	if m.MemCache != 0 {
+		n += 1 + sovAgent(uint64(m.MemCache))
+	}
+	if m.MemLimit != 0 {
+		n += 1 + sovAgent(uint64(m.MemLimit))
+	}
+	if m.Rbps != 0 {
+		n += 5
+	}
+	if m.Wbps != 0 {
+		n += 5
+	}
+	if m.NetRcvdPs != 0 {
+		n += 5
+	}
+	if m.NetSentPs != 0 {
+		n += 5
+	}
+	if m.NetRcvdBps != 0 {
+		n += 5
+	}
+	if m.NetSentBps != 0 {
+		n += 5
+	}
+	if m.State != 0 {
+		n += 1 + sovAgent(uint64(m.State))
+	}
+	if m.Health != 0 {
+		n += 2 + sovAgent(uint64(m.Health))
+	}
+	if m.Key != 0 {
+		n += 2 + sovAgent(uint64(m.Key))
+	}
+	if m.Started != 0 {
+		n += 2 + sovAgent(uint64(m.Started))
+	}
+	l = len(m.ByteKey)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
 	}
 	return n
 }
 
-func (m *HostTags) Size() (n int) {
+func (m *SystemInfo) Size() (n int) {
 	var l int
 	_ = l
-	if m.SourceType != 0 {
-		n += 1 + sovAgent(uint64(m.SourceType))
+	l = len(m.Uuid)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	if len(m.Tags) > 0 {
-		for _, s := range m.Tags {
-			l = len(s)
+	if m.Os != nil {
+		l = m.Os.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Cpus) > 0 {
+		for _, e := range m.Cpus {
+			l = e.Size()
 			n += 1 + l + sovAgent(uint64(l))
 		}
 	}
+	if m.TotalMemory != 0 {
+		n += 1 + sovAgent(uint64(m.TotalMemory))
+	}
 	return n
 }
 
-func sovAgent(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *OSInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
 	}
-	return n
-}
-func sozAgent(x uint64) (n int) {
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Family)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.KernelVersion)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *IOStat) Size() (n int) {
+	var l int
+	_ = l
+	if m.ReadRate != 0 {
+		n += 5
+	}
+	if m.WriteRate != 0 {
+		n += 5
+	}
+	if m.ReadBytesRate != 0 {
+		n += 5
+	}
+	if m.WriteBytesRate != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *Connection) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		n += 1 + sovAgent(uint64(m.Pid))
+	}
+	if m.Laddr != nil {
+		l = m.Laddr.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Raddr != nil {
+		l = m.Raddr.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.BytesSent != 0 {
+		n += 5
+	}
+	if m.BytesRecieved != 0 {
+		n += 5
+	}
+	if m.Family != 0 {
+		n += 1 + sovAgent(uint64(m.Family))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAgent(uint64(m.Type))
+	}
+	if m.PidCreateTime != 0 {
+		n += 1 + sovAgent(uint64(m.PidCreateTime))
+	}
+	if m.TcpState != 0 {
+		n += 1 + sovAgent(uint64(m.TcpState))
+	}
+	if m.FromProcfs {
+		n += 2
+	}
+	if m.TcpStats != nil {
+		l = m.TcpStats.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *TCPStats) Size() (n int) {
+	var l int
+	_ = l
+	if m.Rtt != 0 {
+		n += 1 + sovAgent(uint64(m.Rtt))
+	}
+	if m.RttVar != 0 {
+		n += 1 + sovAgent(uint64(m.RttVar))
+	}
+	if m.Retransmits != 0 {
+		n += 1 + sovAgent(uint64(m.Retransmits))
+	}
+	if m.SndCwnd != 0 {
+		n += 1 + sovAgent(uint64(m.SndCwnd))
+	}
+	if m.RecvQueue != 0 {
+		n += 1 + sovAgent(uint64(m.RecvQueue))
+	}
+	if m.SendQueue != 0 {
+		n += 1 + sovAgent(uint64(m.SendQueue))
+	}
+	return n
+}
+
+func (m *Listener) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		n += 1 + sovAgent(uint64(m.Pid))
+	}
+	if m.PidCreateTime != 0 {
+		n += 1 + sovAgent(uint64(m.PidCreateTime))
+	}
+	if m.Protocol != 0 {
+		n += 1 + sovAgent(uint64(m.Protocol))
+	}
+	if m.Family != 0 {
+		n += 1 + sovAgent(uint64(m.Family))
+	}
+	if m.Addr != nil {
+		l = m.Addr.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Inode != 0 {
+		n += 1 + sovAgent(uint64(m.Inode))
+	}
+	if m.NetNamespace != 0 {
+		n += 1 + sovAgent(uint64(m.NetNamespace))
+	}
+	if m.SharedBy != 0 {
+		n += 1 + sovAgent(uint64(m.SharedBy))
+	}
+	return n
+}
+
+func (m *Addr) Size() (n int) {
+	var l int
+	_ = l
+	if m.Host != nil {
+		l = m.Host.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Ip)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Port != 0 {
+		n += 1 + sovAgent(uint64(m.Port))
+	}
+	return n
+}
+
+func (m *MemoryStat) Size() (n int) {
+	var l int
+	_ = l
+	if m.Rss != 0 {
+		n += 1 + sovAgent(uint64(m.Rss))
+	}
+	if m.Vms != 0 {
+		n += 1 + sovAgent(uint64(m.Vms))
+	}
+	if m.Swap != 0 {
+		n += 1 + sovAgent(uint64(m.Swap))
+	}
+	if m.Shared != 0 {
+		n += 1 + sovAgent(uint64(m.Shared))
+	}
+	if m.Text != 0 {
+		n += 1 + sovAgent(uint64(m.Text))
+	}
+	if m.Lib != 0 {
+		n += 1 + sovAgent(uint64(m.Lib))
+	}
+	if m.Data != 0 {
+		n += 1 + sovAgent(uint64(m.Data))
+	}
+	if m.Dirty != 0 {
+		n += 1 + sovAgent(uint64(m.Dirty))
+	}
+	if m.Pss != 0 {
+		n += 1 + sovAgent(uint64(m.Pss))
+	}
+	if m.Uss != 0 {
+		n += 1 + sovAgent(uint64(m.Uss))
+	}
+	if m.SwapPss != 0 {
+		n += 1 + sovAgent(uint64(m.SwapPss))
+	}
+	if m.Anonymous != 0 {
+		n += 1 + sovAgent(uint64(m.Anonymous))
+	}
+	if m.FileBacked != 0 {
+		n += 1 + sovAgent(uint64(m.FileBacked))
+	}
+	return n
+}
+
+func (m *FdStat) Size() (n int) {
+	var l int
+	_ = l
+	if m.Files != 0 {
+		n += 1 + sovAgent(uint64(m.Files))
+	}
+	if m.Sockets != 0 {
+		n += 1 + sovAgent(uint64(m.Sockets))
+	}
+	if m.Pipes != 0 {
+		n += 1 + sovAgent(uint64(m.Pipes))
+	}
+	if m.Eventfds != 0 {
+		n += 1 + sovAgent(uint64(m.Eventfds))
+	}
+	if m.Epolls != 0 {
+		n += 1 + sovAgent(uint64(m.Epolls))
+	}
+	if m.AnonInodes != 0 {
+		n += 1 + sovAgent(uint64(m.AnonInodes))
+	}
+	if m.Devices != 0 {
+		n += 1 + sovAgent(uint64(m.Devices))
+	}
+	if m.Other != 0 {
+		n += 1 + sovAgent(uint64(m.Other))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	if len(m.TopPaths) > 0 {
+		for _, e := range m.TopPaths {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FdPath) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovAgent(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *EnvVar) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *SystemdUnit) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Slice)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessSecurity) Size() (n int) {
+	var l int
+	_ = l
+	if m.CapEffective != 0 {
+		n += 1 + sovAgent(uint64(m.CapEffective))
+	}
+	if m.CapPermitted != 0 {
+		n += 1 + sovAgent(uint64(m.CapPermitted))
+	}
+	if m.CapBounding != 0 {
+		n += 1 + sovAgent(uint64(m.CapBounding))
+	}
+	if len(m.EffectiveCaps) > 0 {
+		for _, s := range m.EffectiveCaps {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.Seccomp != 0 {
+		n += 1 + sovAgent(uint64(m.Seccomp))
+	}
+	if m.NoNewPrivs {
+		n += 2
+	}
+	l = len(m.LsmLabel)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *Namespaces) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pid != 0 {
+		n += 1 + sovAgent(uint64(m.Pid))
+	}
+	if m.Net != 0 {
+		n += 1 + sovAgent(uint64(m.Net))
+	}
+	if m.Mnt != 0 {
+		n += 1 + sovAgent(uint64(m.Mnt))
+	}
+	if m.User != 0 {
+		n += 1 + sovAgent(uint64(m.User))
+	}
+	if m.Uts != 0 {
+		n += 1 + sovAgent(uint64(m.Uts))
+	}
+	if m.Ipc != 0 {
+		n += 1 + sovAgent(uint64(m.Ipc))
+	}
+	if m.Cgroup != 0 {
+		n += 1 + sovAgent(uint64(m.Cgroup))
+	}
+	return n
+}
+
+func (m *ResourceLimit) Size() (n int) {
+	var l int
+	_ = l
+	if m.Soft != 0 {
+		n += 1 + sovAgent(uint64(m.Soft))
+	}
+	if m.Hard != 0 {
+		n += 1 + sovAgent(uint64(m.Hard))
+	}
+	return n
+}
+
+func (m *ProcessLimits) Size() (n int) {
+	var l int
+	_ = l
+	if m.OpenFiles != nil {
+		l = m.OpenFiles.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Processes != nil {
+		l = m.Processes.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.LockedMemory != nil {
+		l = m.LockedMemory.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.CoreSize != nil {
+		l = m.CoreSize.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedStat) Size() (n int) {
+	var l int
+	_ = l
+	if m.RunTime != 0 {
+		n += 1 + sovAgent(uint64(m.RunTime))
+	}
+	if m.RunQueueWait != 0 {
+		n += 1 + sovAgent(uint64(m.RunQueueWait))
+	}
+	if m.Timeslices != 0 {
+		n += 1 + sovAgent(uint64(m.Timeslices))
+	}
+	if m.RunQueueWaitPct != 0 {
+		n += 5
+	}
+	if m.DelayAcct {
+		n += 2
+	}
+	if m.BlkioDelay != 0 {
+		n += 1 + sovAgent(uint64(m.BlkioDelay))
+	}
+	if m.SwapinDelay != 0 {
+		n += 1 + sovAgent(uint64(m.SwapinDelay))
+	}
+	if m.BlkioDelayPct != 0 {
+		n += 5
+	}
+	if m.SwapinDelayPct != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *CPUStat) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.LastCpu)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.TotalPct != 0 {
+		n += 5
+	}
+	if m.UserPct != 0 {
+		n += 5
+	}
+	if m.SystemPct != 0 {
+		n += 5
+	}
+	if m.NumThreads != 0 {
+		n += 1 + sovAgent(uint64(m.NumThreads))
+	}
+	if len(m.Cpus) > 0 {
+		for _, e := range m.Cpus {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.Nice != 0 {
+		n += 1 + sovAgent(uint64(m.Nice))
+	}
+	if m.UserTime != 0 {
+		n += 1 + sovAgent(uint64(m.UserTime))
+	}
+	if m.SystemTime != 0 {
+		n += 1 + sovAgent(uint64(m.SystemTime))
+	}
+	return n
+}
+
+func (m *SingleCPUStat) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.TotalPct != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *CPUInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Number != 0 {
+		n += 1 + sovAgent(uint64(m.Number))
+	}
+	l = len(m.Vendor)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Family)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.PhysicalId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.CoreId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Cores != 0 {
+		n += 1 + sovAgent(uint64(m.Cores))
+	}
+	if m.Mhz != 0 {
+		n += 1 + sovAgent(uint64(m.Mhz))
+	}
+	if m.CacheSize != 0 {
+		n += 1 + sovAgent(uint64(m.CacheSize))
+	}
+	return n
+}
+
+func (m *Host) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAgent(uint64(m.Id))
+	}
+	if m.OrgId != 0 {
+		n += 1 + sovAgent(uint64(m.OrgId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.AllTags) > 0 {
+		for _, s := range m.AllTags {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.NumCpus != 0 {
+		n += 1 + sovAgent(uint64(m.NumCpus))
+	}
+	if m.TotalMemory != 0 {
+		n += 1 + sovAgent(uint64(m.TotalMemory))
+	}
+	return n
+}
+
+func (m *HostTags) Size() (n int) {
+	var l int
+	_ = l
+	if m.SourceType != 0 {
+		n += 1 + sovAgent(uint64(m.SourceType))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAgent(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozAgent(x uint64) (n int) {
 	return sovAgent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *ResCollector) Unmarshal(data []byte) error {
+func (m *ResCollector) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResCollector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResCollector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResCollector_Header{}
+			}
+			if err := m.Header.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &CollectorStatus{}
+			}
+			if err := m.Status.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResCollector_Header) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Type |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorProc) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorProc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorProc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Processes = append(m.Processes, &Process{})
+			if err := m.Processes[len(m.Processes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Host == nil {
+				m.Host = &Host{}
+			}
+			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &SystemInfo{}
+			}
+			if err := m.Info.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+			}
+			m.GroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kubernetes == nil {
+				m.Kubernetes = &datadog_agentpayload.KubeMetadataPayload{}
+			}
+			if err := m.Kubernetes.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ecs == nil {
+				m.Ecs = &datadog_agentpayload.ECSMetadataPayload{}
+			}
+			if err := m.Ecs.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, &Container{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortLivedProcesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShortLivedProcesses = append(m.ShortLivedProcesses, &ShortLivedProcess{})
+			if err := m.ShortLivedProcesses[len(m.ShortLivedProcesses)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostCpus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostCpus = append(m.HostCpus, &SingleCPUStat{})
+			if err := m.HostCpus[len(m.HostCpus)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorConnections) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorConnections: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorConnections: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connections = append(m.Connections, &Connection{})
+			if err := m.Connections[len(m.Connections)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Host == nil {
+				m.Host = &Host{}
+			}
+			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+			}
+			m.GroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorListeners) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorListeners: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorListeners: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listeners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Listeners = append(m.Listeners, &Listener{})
+			if err := m.Listeners[len(m.Listeners)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+			}
+			m.GroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorRealTime) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorRealTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorRealTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, &ProcessStat{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
+			}
+			m.HostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.HostId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgId", wireType)
+			}
+			m.OrgId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OrgId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+			}
+			m.GroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumCpus", wireType)
+			}
+			m.NumCpus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NumCpus |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMemory", wireType)
+			}
+			m.TotalMemory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.TotalMemory |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerStats = append(m.ContainerStats, &ContainerStat{})
+			if err := m.ContainerStats[len(m.ContainerStats)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostCpus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostCpus = append(m.HostCpus, &SingleCPUStat{})
+			if err := m.HostCpus[len(m.HostCpus)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorContainer) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorContainer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &SystemInfo{}
+			}
+			if err := m.Info.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Containers = append(m.Containers, &Container{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+			}
+			m.GroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kubernetes == nil {
+				m.Kubernetes = &datadog_agentpayload.KubeMetadataPayload{}
+			}
+			if err := m.Kubernetes.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ecs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ecs == nil {
+				m.Ecs = &datadog_agentpayload.ECSMetadataPayload{}
+			}
+			if err := m.Ecs.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Host == nil {
+				m.Host = &Host{}
+			}
+			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorContainerRealTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorContainerRealTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, &ContainerStat{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumCpus", wireType)
+			}
+			m.NumCpus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NumCpus |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMemory", wireType)
+			}
+			m.TotalMemory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.TotalMemory |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
+			}
+			m.HostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.HostId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+			}
+			m.GroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorProcEvent) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorProcEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorProcEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &ProcessEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupId |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+			}
+			m.GroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.GroupSize |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorReqStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorReqStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorReqStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostName = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectorStatus) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectorStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveClients", wireType)
+			}
+			m.ActiveClients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ActiveClients |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Interval |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Process) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Process: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Key |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Pid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Host == nil {
+				m.Host = &Host{}
+			}
+			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Command == nil {
+				m.Command = &Command{}
+			}
+			if err := m.Command.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &ProcessUser{}
+			}
+			if err := m.User.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Memory == nil {
+				m.Memory = &MemoryStat{}
+			}
+			if err := m.Memory.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cpu == nil {
+				m.Cpu = &CPUStat{}
+			}
+			if err := m.Cpu.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			m.CreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.CreateTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Container == nil {
+				m.Container = &Container{}
+			}
+			if err := m.Container.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFdCount", wireType)
+			}
+			m.OpenFdCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OpenFdCount |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.State |= (ProcessState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoStat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IoStat == nil {
+				m.IoStat = &IOStat{}
+			}
+			if err := m.IoStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerKey", wireType)
+			}
+			m.ContainerKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ContainerKey |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryCtxSwitches", wireType)
+			}
+			m.VoluntaryCtxSwitches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.VoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvoluntaryCtxSwitches", wireType)
+			}
+			m.InvoluntaryCtxSwitches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.InvoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ByteKey == nil {
+				m.ByteKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerByteKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerByteKey = append(m.ContainerByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ContainerByteKey == nil {
+				m.ContainerByteKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedStat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchedStat == nil {
+				m.SchedStat = &SchedStat{}
+			}
+			if err := m.SchedStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &ProcessLimits{}
+			}
+			if err := m.Limits.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FdUtilizationPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.FdUtilizationPct = float32(math.Float32frombits(v))
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FdStat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FdStat == nil {
+				m.FdStat = &FdStat{}
+			}
+			if err := m.FdStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespaces == nil {
+				m.Namespaces = &Namespaces{}
+			}
+			if err := m.Namespaces.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cgroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cgroup = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Security", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Security == nil {
+				m.Security = &ProcessSecurity{}
+			}
+			if err := m.Security.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Systemd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Systemd == nil {
+				m.Systemd = &SystemdUnit{}
+			}
+			if err := m.Systemd.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reparented", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reparented = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, &EnvVar{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnvTruncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShortLivedProcess) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShortLivedProcess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShortLivedProcess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Pid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Command == nil {
+				m.Command = &Command{}
+			}
+			if err := m.Command.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &ProcessUser{}
+			}
+			if err := m.User.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			m.CreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.CreateTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitTime", wireType)
+			}
+			m.ExitTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ExitTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ExitCode |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitSignal", wireType)
+			}
+			m.ExitSignal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ExitSignal |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessEvent) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Type |= (ProcessEventType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Pid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			m.CreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.CreateTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Command == nil {
+				m.Command = &Command{}
+			}
+			if err := m.Command.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.User == nil {
+				m.User = &ProcessUser{}
+			}
+			if err := m.User.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousCommand", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousCommand == nil {
+				m.PreviousCommand = &Command{}
+			}
+			if err := m.PreviousCommand.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousUser", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousUser == nil {
+				m.PreviousUser = &ProcessUser{}
+			}
+			if err := m.PreviousUser.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitStatusKnown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExitStatusKnown = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
+			}
+			m.ExitCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ExitCode |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitSignal", wireType)
+			}
+			m.ExitSignal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.ExitSignal |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zombie", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Zombie = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OomKilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OomKilled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Command) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3723,17 +9561,17 @@ func (m *ResCollector) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResCollector: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResCollector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3743,28 +9581,53 @@ func (m *ResCollector) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &ResCollector_Header{}
+			m.Args = append(m.Args, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cwd", wireType)
 			}
-			if err := m.Header.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Cwd = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3789,11 +9652,98 @@ func (m *ResCollector) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(data[iNdEx:postIndex])
+			m.Root = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDisk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnDisk = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ppid", wireType)
+			}
+			m.Ppid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ppid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pgroup", wireType)
+			}
+			m.Pgroup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Pgroup |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exe", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Exe = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3817,10 +9767,10 @@ func (m *ResCollector) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &CollectorStatus{}
+			if m.Fingerprint == nil {
+				m.Fingerprint = &ExeFingerprint{}
 			}
-			if err := m.Status.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Fingerprint.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3845,7 +9795,7 @@ func (m *ResCollector) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ResCollector_Header) Unmarshal(data []byte) error {
+func (m *ExeFingerprint) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3855,30 +9805,146 @@ func (m *ResCollector_Header) Unmarshal(data []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowAgent
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExeFingerprint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExeFingerprint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PackageManager = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			m.Package = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageVersion", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3888,11 +9954,21 @@ func (m *ResCollector_Header) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Type |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PackageVersion = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -3914,7 +9990,7 @@ func (m *ResCollector_Header) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *CollectorProc) Unmarshal(data []byte) error {
+func (m *ProcessUser) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3937,15 +10013,15 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorProc: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessUser: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorProc: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessUser: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3970,13 +10046,13 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostName = string(data[iNdEx:postIndex])
+			m.Name = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Processes", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
 			}
-			var msglen int
+			m.Uid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3986,28 +10062,35 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Uid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
 			}
-			m.Processes = append(m.Processes, &Process{})
-			if err := m.Processes[len(m.Processes)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.Gid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Gid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Euid", wireType)
 			}
-			var msglen int
+			m.Euid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4017,30 +10100,16 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Euid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Host == nil {
-				m.Host = &Host{}
-			}
-			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egid", wireType)
 			}
-			var msglen int
+			m.Egid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4050,30 +10119,16 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Egid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Info == nil {
-				m.Info = &SystemInfo{}
-			}
-			if err := m.Info.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suid", wireType)
 			}
-			m.GroupId = 0
+			m.Suid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4083,16 +10138,16 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.GroupId |= (int32(b) & 0x7F) << shift
+				m.Suid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sgid", wireType)
 			}
-			m.GroupSize = 0
+			m.Sgid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4102,16 +10157,16 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.GroupSize |= (int32(b) & 0x7F) << shift
+				m.Sgid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fsuid", wireType)
 			}
-			var msglen int
+			m.Fsuid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4121,30 +10176,16 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Fsuid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Kubernetes == nil {
-				m.Kubernetes = &datadog_agentpayload.KubeMetadataPayload{}
-			}
-			if err := m.Kubernetes.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ecs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fsgid", wireType)
 			}
-			var msglen int
+			m.Fsgid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4154,30 +10195,16 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Fsgid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Ecs == nil {
-				m.Ecs = &datadog_agentpayload.ECSMetadataPayload{}
-			}
-			if err := m.Ecs.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4187,23 +10214,12 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Containers = append(m.Containers, &Container{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Groups = append(m.Groups, v)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -4225,7 +10241,7 @@ func (m *CollectorProc) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *CollectorConnections) Unmarshal(data []byte) error {
+func (m *Container) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4238,25 +10254,54 @@ func (m *CollectorConnections) Unmarshal(data []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorConnections: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorConnections: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4281,13 +10326,13 @@ func (m *CollectorConnections) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostName = string(data[iNdEx:postIndex])
+			m.Id = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connections", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4297,28 +10342,26 @@ func (m *CollectorConnections) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Connections = append(m.Connections, &Connection{})
-			if err := m.Connections[len(m.Connections)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4328,30 +10371,40 @@ func (m *CollectorConnections) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Host == nil {
-				m.Host = &Host{}
-			}
-			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Image = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuLimit", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.CpuLimit = float32(math.Float32frombits(v))
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
 			}
-			m.GroupId = 0
+			m.MemoryLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4361,16 +10414,16 @@ func (m *CollectorConnections) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.GroupId |= (int32(b) & 0x7F) << shift
+				m.MemoryLimit |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.GroupSize = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4380,66 +10433,35 @@ func (m *CollectorConnections) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.GroupSize |= (int32(b) & 0x7F) << shift
+				m.State |= (ContainerState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CollectorRealTime) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Health = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Health |= (ContainerHealth(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorRealTime: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorRealTime: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var stringLen uint64
+			m.Created = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4449,26 +10471,44 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Created |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rbps", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostName = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Rbps = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wbps", wireType)
 			}
-			var msglen int
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Wbps = float32(math.Float32frombits(v))
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4478,28 +10518,114 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Key |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+		case 14:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdPs", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetRcvdPs = float32(math.Float32frombits(v))
+		case 15:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSentPs", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetSentPs = float32(math.Float32frombits(v))
+		case 16:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetRcvdBps = float32(math.Float32frombits(v))
+		case 17:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSentBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetSentBps = float32(math.Float32frombits(v))
+		case 18:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.UserPct = float32(math.Float32frombits(v))
+		case 19:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stats = append(m.Stats, &ProcessStat{})
-			if err := m.Stats[len(m.Stats)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.SystemPct = float32(math.Float32frombits(v))
+		case 20:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPct", wireType)
 			}
-			iNdEx = postIndex
-		case 4:
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.TotalPct = float32(math.Float32frombits(v))
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemRss", wireType)
 			}
-			m.HostId = 0
+			m.MemRss = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4509,16 +10635,16 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.HostId |= (int32(b) & 0x7F) << shift
+				m.MemRss |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrgId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemCache", wireType)
 			}
-			m.OrgId = 0
+			m.MemCache = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4528,16 +10654,16 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.OrgId |= (int32(b) & 0x7F) << shift
+				m.MemCache |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
-			m.GroupId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4547,35 +10673,30 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.GroupId |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.GroupSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.GroupSize |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
+			if m.Host == nil {
+				m.Host = &Host{}
+			}
+			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumCpus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			m.NumCpus = 0
+			m.Started = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4585,16 +10706,16 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.NumCpus |= (int32(b) & 0x7F) << shift
+				m.Started |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMemory", wireType)
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
 			}
-			m.TotalMemory = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4604,16 +10725,28 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.TotalMemory |= (int64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ByteKey == nil {
+				m.ByteKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4623,22 +10756,20 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerStats = append(m.ContainerStats, &ContainerStat{})
-			if err := m.ContainerStats[len(m.ContainerStats)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tags = append(m.Tags, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4661,7 +10792,7 @@ func (m *CollectorRealTime) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *CollectorContainer) Unmarshal(data []byte) error {
+func (m *ProcessStat) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4684,17 +10815,17 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorContainer: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
 			}
-			var stringLen uint64
+			m.Pid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4704,24 +10835,33 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Pid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CreateTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.CreateTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.HostName = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4745,16 +10885,16 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Info == nil {
-				m.Info = &SystemInfo{}
+			if m.Memory == nil {
+				m.Memory = &MemoryStat{}
 			}
-			if err := m.Info.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Memory.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4778,16 +10918,18 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Containers = append(m.Containers, &Container{})
-			if err := m.Containers[len(m.Containers)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if m.Cpu == nil {
+				m.Cpu = &CPUStat{}
+			}
+			if err := m.Cpu.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nice", wireType)
 			}
-			m.GroupId = 0
+			m.Nice = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4797,16 +10939,16 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.GroupId |= (int32(b) & 0x7F) << shift
+				m.Nice |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
 			}
-			m.GroupSize = 0
+			m.Threads = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4816,16 +10958,16 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.GroupSize |= (int32(b) & 0x7F) << shift
+				m.Threads |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFdCount", wireType)
 			}
-			var msglen int
+			m.OpenFdCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4835,30 +10977,16 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.OpenFdCount |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Kubernetes == nil {
-				m.Kubernetes = &datadog_agentpayload.KubeMetadataPayload{}
-			}
-			if err := m.Kubernetes.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ecs", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			m.Key = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4868,30 +10996,16 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Key |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Ecs == nil {
-				m.Ecs = &datadog_agentpayload.ECSMetadataPayload{}
-			}
-			if err := m.Ecs.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4901,80 +11015,26 @@ func (m *CollectorContainer) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Host == nil {
-				m.Host = &Host{}
-			}
-			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContainerId = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorContainerRealTime: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorContainerRealTime: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerState", wireType)
 			}
-			var stringLen uint64
+			m.ContainerState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4984,26 +11044,16 @@ func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.ContainerState |= (ContainerState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HostName = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessState", wireType)
 			}
-			var msglen int
+			m.ProcessState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5013,28 +11063,16 @@ func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ProcessState |= (ProcessState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Stats = append(m.Stats, &ContainerStat{})
-			if err := m.Stats[len(m.Stats)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumCpus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHealth", wireType)
 			}
-			m.NumCpus = 0
+			m.ContainerHealth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5044,16 +11082,44 @@ func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.NumCpus |= (int32(b) & 0x7F) << shift
+				m.ContainerHealth |= (ContainerHealth(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 16:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerRbps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.ContainerRbps = float32(math.Float32frombits(v))
+		case 17:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerWbps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.ContainerWbps = float32(math.Float32frombits(v))
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMemory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerKey", wireType)
 			}
-			m.TotalMemory = 0
+			m.ContainerKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5063,16 +11129,16 @@ func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.TotalMemory |= (int64(b) & 0x7F) << shift
+				m.ContainerKey |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostId", wireType)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IoStat", wireType)
 			}
-			m.HostId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5082,16 +11148,86 @@ func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.HostId |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IoStat == nil {
+				m.IoStat = &IOStat{}
+			}
+			if err := m.IoStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetRcvdPs", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.ContainerNetRcvdPs = float32(math.Float32frombits(v))
+		case 21:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetSentPs", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.ContainerNetSentPs = float32(math.Float32frombits(v))
+		case 22:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetRcvdBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.ContainerNetRcvdBps = float32(math.Float32frombits(v))
+		case 23:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetSentBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.ContainerNetSentBps = float32(math.Float32frombits(v))
+		case 24:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryCtxSwitches", wireType)
 			}
-			m.GroupId = 0
+			m.VoluntaryCtxSwitches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5101,16 +11237,16 @@ func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.GroupId |= (int32(b) & 0x7F) << shift
+				m.VoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 25:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvoluntaryCtxSwitches", wireType)
 			}
-			m.GroupSize = 0
+			m.InvoluntaryCtxSwitches = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5120,66 +11256,16 @@ func (m *CollectorContainerRealTime) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.GroupSize |= (int32(b) & 0x7F) << shift
+				m.InvoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CollectorReqStatus) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorReqStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorReqStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5189,76 +11275,28 @@ func (m *CollectorReqStatus) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostName = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CollectorStatus) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ByteKey == nil {
+				m.ByteKey = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CollectorStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollectorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveClients", wireType)
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerByteKey", wireType)
 			}
-			m.ActiveClients = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5268,16 +11306,28 @@ func (m *CollectorStatus) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ActiveClients |= (int32(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.Interval = 0
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContainerByteKey = append(m.ContainerByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ContainerByteKey == nil {
+				m.ContainerByteKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedStat", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5287,11 +11337,25 @@ func (m *CollectorStatus) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Interval |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SchedStat == nil {
+				m.SchedStat = &SchedStat{}
+			}
+			if err := m.SchedStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -5313,7 +11377,7 @@ func (m *CollectorStatus) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Process) Unmarshal(data []byte) error {
+func (m *ContainerStat) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5333,91 +11397,20 @@ func (m *Process) Unmarshal(data []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Process: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Process: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			m.Key = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Key |= (uint32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
-			}
-			m.Pid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Pid |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Host == nil {
-				m.Host = &Host{}
-			}
-			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContainerStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContainerStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5427,30 +11420,82 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Command == nil {
-				m.Command = &Command{}
+			m.Id = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserPct", wireType)
 			}
-			if err := m.Command.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.UserPct = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.SystemPct = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.TotalPct = float32(math.Float32frombits(v))
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuLimit", wireType)
 			}
-			var msglen int
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.CpuLimit = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemRss", wireType)
+			}
+			m.MemRss = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5460,30 +11505,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MemRss |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.User == nil {
-				m.User = &ProcessUser{}
-			}
-			if err := m.User.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemCache", wireType)
 			}
-			var msglen int
+			m.MemCache = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5493,30 +11524,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MemCache |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Memory == nil {
-				m.Memory = &MemoryStat{}
-			}
-			if err := m.Memory.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemLimit", wireType)
 			}
-			var msglen int
+			m.MemLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5526,30 +11543,100 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.MemLimit |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rbps", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cpu == nil {
-				m.Cpu = &CPUStat{}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Rbps = float32(math.Float32frombits(v))
+		case 10:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wbps", wireType)
 			}
-			if err := m.Cpu.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.Wbps = float32(math.Float32frombits(v))
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdPs", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetRcvdPs = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSentPs", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetSentPs = float32(math.Float32frombits(v))
+		case 13:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetRcvdBps = float32(math.Float32frombits(v))
+		case 14:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetSentBps", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.NetSentBps = float32(math.Float32frombits(v))
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.CreateTime = 0
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5559,16 +11646,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.CreateTime |= (int64(b) & 0x7F) << shift
+				m.State |= (ContainerState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
-			var msglen int
+			m.Health = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5578,30 +11665,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Health |= (ContainerHealth(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Container == nil {
-				m.Container = &Container{}
-			}
-			if err := m.Container.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
+			}
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenFdCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.OpenFdCount = 0
+			m.Key = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5611,16 +11684,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.OpenFdCount |= (int32(b) & 0x7F) << shift
+				m.Key |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 18:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			m.State = 0
+			m.Started = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5630,16 +11703,16 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.State |= (ProcessState(b) & 0x7F) << shift
+				m.Started |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoStat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5649,28 +11722,76 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IoStat == nil {
-				m.IoStat = &IOStat{}
+			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
+			if m.ByteKey == nil {
+				m.ByteKey = []byte{}
 			}
-			if err := m.IoStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 14:
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SystemInfo) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5695,13 +11816,13 @@ func (m *Process) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerId = string(data[iNdEx:postIndex])
+			m.Uuid = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerKey", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Os", wireType)
 			}
-			m.ContainerKey = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5711,54 +11832,30 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ContainerKey |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryCtxSwitches", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.VoluntaryCtxSwitches = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.VoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvoluntaryCtxSwitches", wireType)
+			if m.Os == nil {
+				m.Os = &OSInfo{}
 			}
-			m.InvoluntaryCtxSwitches = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.InvoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Os.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 18:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpus", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5768,28 +11865,28 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ByteKey == nil {
-				m.ByteKey = []byte{}
+			m.Cpus = append(m.Cpus, &CPUInfo{})
+			if err := m.Cpus[len(m.Cpus)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerByteKey", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMemory", wireType)
 			}
-			var byteLen int
+			m.TotalMemory = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5799,23 +11896,11 @@ func (m *Process) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.TotalMemory |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerByteKey = append(m.ContainerByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ContainerByteKey == nil {
-				m.ContainerByteKey = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -5837,7 +11922,7 @@ func (m *Process) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(data []byte) error {
+func (m *OSInfo) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5860,15 +11945,15 @@ func (m *Command) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: OSInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OSInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5893,11 +11978,11 @@ func (m *Command) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(data[iNdEx:postIndex]))
+			m.Name = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cwd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5922,11 +12007,11 @@ func (m *Command) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cwd = string(data[iNdEx:postIndex])
+			m.Platform = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5951,33 +12036,13 @@ func (m *Command) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Root = string(data[iNdEx:postIndex])
+			m.Family = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnDisk", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.OnDisk = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ppid", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Ppid = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -5987,33 +12052,24 @@ func (m *Command) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Ppid |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pgroup", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			m.Pgroup = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Pgroup |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 8:
+			m.Version = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exe", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6038,7 +12094,7 @@ func (m *Command) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exe = string(data[iNdEx:postIndex])
+			m.KernelVersion = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6061,7 +12117,7 @@ func (m *Command) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ProcessUser) Unmarshal(data []byte) error {
+func (m *IOStat) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6084,155 +12140,68 @@ func (m *ProcessUser) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessUser: wiretype end group for non-group")
+			return fmt.Errorf("proto: IOStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessUser: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IOStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadRate", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.ReadRate = float32(math.Float32frombits(v))
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uid", wireType)
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteRate", wireType)
 			}
-			m.Uid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Uid |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.WriteRate = float32(math.Float32frombits(v))
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gid", wireType)
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytesRate", wireType)
 			}
-			m.Gid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Gid |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.ReadBytesRate = float32(math.Float32frombits(v))
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Euid", wireType)
-			}
-			m.Euid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Euid |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egid", wireType)
-			}
-			m.Egid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Egid |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suid", wireType)
-			}
-			m.Suid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Suid |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sgid", wireType)
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteBytesRate", wireType)
 			}
-			m.Sgid = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Sgid |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.WriteBytesRate = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -6254,7 +12223,7 @@ func (m *ProcessUser) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Container) Unmarshal(data []byte) error {
+func (m *Connection) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6277,17 +12246,17 @@ func (m *Container) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Container: wiretype end group for non-group")
+			return fmt.Errorf("proto: Connection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Container: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Connection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
 			}
-			var stringLen uint64
+			m.Pid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6297,26 +12266,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Pid |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Laddr", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6326,55 +12285,30 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Laddr == nil {
+				m.Laddr = &Addr{}
+			}
+			if err := m.Laddr.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Name = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Raddr", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6384,24 +12318,28 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(data[iNdEx:postIndex])
+			if m.Raddr == nil {
+				m.Raddr = &Addr{}
+			}
+			if err := m.Raddr.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesSent", wireType)
 			}
 			var v uint32
 			if (iNdEx + 4) > l {
@@ -6412,12 +12350,26 @@ func (m *Container) Unmarshal(data []byte) error {
 			v |= uint32(data[iNdEx-3]) << 8
 			v |= uint32(data[iNdEx-2]) << 16
 			v |= uint32(data[iNdEx-1]) << 24
-			m.CpuLimit = float32(math.Float32frombits(v))
-		case 6:
+			m.BytesSent = float32(math.Float32frombits(v))
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesRecieved", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.BytesRecieved = float32(math.Float32frombits(v))
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
 			}
-			m.MemoryLimit = 0
+			m.Family = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6427,16 +12379,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemoryLimit |= (uint64(b) & 0x7F) << shift
+				m.Family |= (ConnectionFamily(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.State = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6446,16 +12398,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.State |= (ContainerState(b) & 0x7F) << shift
+				m.Type |= (ConnectionType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PidCreateTime", wireType)
 			}
-			m.Health = 0
+			m.PidCreateTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6465,16 +12417,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Health |= (ContainerHealth(b) & 0x7F) << shift
+				m.PidCreateTime |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TcpState", wireType)
 			}
-			m.Created = 0
+			m.TcpState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6484,44 +12436,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Created |= (int64(b) & 0x7F) << shift
+				m.TcpState |= (TCPState(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rbps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.Rbps = float32(math.Float32frombits(v))
-		case 12:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wbps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.Wbps = float32(math.Float32frombits(v))
-		case 13:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromProcfs", wireType)
 			}
-			m.Key = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6531,114 +12455,100 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Key |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdPs", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetRcvdPs = float32(math.Float32frombits(v))
+			m.FromProcfs = bool(v != 0)
 		case 15:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSentPs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TcpStats", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetSentPs = float32(math.Float32frombits(v))
-		case 16:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdBps", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetRcvdBps = float32(math.Float32frombits(v))
-		case 17:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSentBps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			if m.TcpStats == nil {
+				m.TcpStats = &TCPStats{}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetSentBps = float32(math.Float32frombits(v))
-		case 18:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserPct", wireType)
+			if err := m.TcpStats.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.UserPct = float32(math.Float32frombits(v))
-		case 19:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemPct", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.SystemPct = float32(math.Float32frombits(v))
-		case 20:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPct", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TCPStats) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.TotalPct = float32(math.Float32frombits(v))
-		case 21:
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TCPStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TCPStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemRss", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rtt", wireType)
 			}
-			m.MemRss = 0
+			m.Rtt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6648,16 +12558,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemRss |= (uint64(b) & 0x7F) << shift
+				m.Rtt |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 22:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RttVar", wireType)
 			}
-			m.MemCache = 0
+			m.RttVar = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6667,16 +12577,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemCache |= (uint64(b) & 0x7F) << shift
+				m.RttVar |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 23:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retransmits", wireType)
 			}
-			var msglen int
+			m.Retransmits = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6686,30 +12596,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Retransmits |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Host == nil {
-				m.Host = &Host{}
-			}
-			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 24:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SndCwnd", wireType)
 			}
-			m.Started = 0
+			m.SndCwnd = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6719,16 +12615,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Started |= (int64(b) & 0x7F) << shift
+				m.SndCwnd |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvQueue", wireType)
 			}
-			var byteLen int
+			m.RecvQueue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6738,28 +12634,16 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.RecvQueue |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ByteKey == nil {
-				m.ByteKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendQueue", wireType)
 			}
-			var stringLen uint64
+			m.SendQueue = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6769,21 +12653,11 @@ func (m *Container) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.SendQueue |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tags = append(m.Tags, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -6805,7 +12679,7 @@ func (m *Container) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStat) Unmarshal(data []byte) error {
+func (m *Listener) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6828,10 +12702,10 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: Listener: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Listener: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6855,9 +12729,9 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PidCreateTime", wireType)
 			}
-			m.CreateTime = 0
+			m.PidCreateTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6867,14 +12741,52 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.CreateTime |= (int64(b) & 0x7F) << shift
+				m.PidCreateTime |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Protocol |= (ListenerProtocol(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
+			}
+			m.Family = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Family |= (ConnectionFamily(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6898,16 +12810,152 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Memory == nil {
-				m.Memory = &MemoryStat{}
+			if m.Addr == nil {
+				m.Addr = &Addr{}
 			}
-			if err := m.Memory.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Addr.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inode", wireType)
+			}
+			m.Inode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Inode |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetNamespace", wireType)
+			}
+			m.NetNamespace = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.NetNamespace |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedBy", wireType)
+			}
+			m.SharedBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.SharedBy |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Addr) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Addr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Addr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6931,18 +12979,18 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cpu == nil {
-				m.Cpu = &CPUStat{}
+			if m.Host == nil {
+				m.Host = &Host{}
 			}
-			if err := m.Cpu.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nice", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
 			}
-			m.Nice = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6952,16 +13000,26 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Nice |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			m.Threads = 0
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6971,16 +13029,66 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Threads |= (int32(b) & 0x7F) << shift
+				m.Port |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoryStat) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenFdCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rss", wireType)
 			}
-			m.OpenFdCount = 0
+			m.Rss = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -6990,16 +13098,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.OpenFdCount |= (int32(b) & 0x7F) << shift
+				m.Rss |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vms", wireType)
 			}
-			m.Key = 0
+			m.Vms = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7009,16 +13117,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Key |= (uint32(b) & 0x7F) << shift
+				m.Vms |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerId", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
 			}
-			var stringLen uint64
+			m.Swap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7028,26 +13136,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Swap |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContainerId = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
 			}
-			m.ContainerState = 0
+			m.Shared = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7057,16 +13155,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ContainerState |= (ContainerState(b) & 0x7F) << shift
+				m.Shared |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
-			m.ProcessState = 0
+			m.Text = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7076,16 +13174,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ProcessState |= (ProcessState(b) & 0x7F) << shift
+				m.Text |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerHealth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lib", wireType)
 			}
-			m.ContainerHealth = 0
+			m.Lib = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7095,44 +13193,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ContainerHealth |= (ContainerHealth(b) & 0x7F) << shift
+				m.Lib |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerRbps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerRbps = float32(math.Float32frombits(v))
-		case 17:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerWbps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerWbps = float32(math.Float32frombits(v))
-		case 18:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.ContainerKey = 0
+			m.Data = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7142,16 +13212,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.ContainerKey |= (uint32(b) & 0x7F) << shift
+				m.Data |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IoStat", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dirty", wireType)
 			}
-			var msglen int
+			m.Dirty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7161,86 +13231,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.IoStat == nil {
-				m.IoStat = &IOStat{}
-			}
-			if err := m.IoStat.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetRcvdPs", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerNetRcvdPs = float32(math.Float32frombits(v))
-		case 21:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetSentPs", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerNetSentPs = float32(math.Float32frombits(v))
-		case 22:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetRcvdBps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerNetRcvdBps = float32(math.Float32frombits(v))
-		case 23:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerNetSentBps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.ContainerNetSentBps = float32(math.Float32frombits(v))
-		case 24:
+				m.Dirty |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryCtxSwitches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pss", wireType)
 			}
-			m.VoluntaryCtxSwitches = 0
+			m.Pss = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7250,16 +13250,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.VoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
+				m.Pss |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvoluntaryCtxSwitches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uss", wireType)
 			}
-			m.InvoluntaryCtxSwitches = 0
+			m.Uss = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7269,16 +13269,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.InvoluntaryCtxSwitches |= (uint64(b) & 0x7F) << shift
+				m.Uss |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPss", wireType)
 			}
-			var byteLen int
+			m.SwapPss = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7288,28 +13288,16 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.SwapPss |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ByteKey == nil {
-				m.ByteKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 27:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerByteKey", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anonymous", wireType)
 			}
-			var byteLen int
+			m.Anonymous = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7319,23 +13307,30 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Anonymous |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileBacked", wireType)
 			}
-			m.ContainerByteKey = append(m.ContainerByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ContainerByteKey == nil {
-				m.ContainerByteKey = []byte{}
+			m.FileBacked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.FileBacked |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -7357,7 +13352,7 @@ func (m *ProcessStat) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *ContainerStat) Unmarshal(data []byte) error {
+func (m *FdStat) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7380,17 +13375,17 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContainerStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: FdStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContainerStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FdStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
-			var stringLen uint64
+			m.Files = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7400,82 +13395,54 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Files |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserPct", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sockets", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Sockets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Sockets |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.UserPct = float32(math.Float32frombits(v))
 		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemPct", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipes", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Pipes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Pipes |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.SystemPct = float32(math.Float32frombits(v))
 		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPct", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.TotalPct = float32(math.Float32frombits(v))
-		case 5:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuLimit", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.CpuLimit = float32(math.Float32frombits(v))
-		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemRss", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Eventfds", wireType)
 			}
-			m.MemRss = 0
+			m.Eventfds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7485,16 +13452,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemRss |= (uint64(b) & 0x7F) << shift
+				m.Eventfds |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epolls", wireType)
 			}
-			m.MemCache = 0
+			m.Epolls = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7504,16 +13471,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemCache |= (uint64(b) & 0x7F) << shift
+				m.Epolls |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnonInodes", wireType)
 			}
-			m.MemLimit = 0
+			m.AnonInodes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7523,100 +13490,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.MemLimit |= (uint64(b) & 0x7F) << shift
+				m.AnonInodes |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rbps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.Rbps = float32(math.Float32frombits(v))
-		case 10:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wbps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.Wbps = float32(math.Float32frombits(v))
-		case 11:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdPs", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetRcvdPs = float32(math.Float32frombits(v))
-		case 12:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSentPs", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetSentPs = float32(math.Float32frombits(v))
-		case 13:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetRcvdBps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetRcvdBps = float32(math.Float32frombits(v))
-		case 14:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetSentBps", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.NetSentBps = float32(math.Float32frombits(v))
-		case 15:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Devices", wireType)
 			}
-			m.State = 0
+			m.Devices = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7626,16 +13509,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.State |= (ContainerState(b) & 0x7F) << shift
+				m.Devices |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Other", wireType)
 			}
-			m.Health = 0
+			m.Other = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7645,16 +13528,16 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Health |= (ContainerHealth(b) & 0x7F) << shift
+				m.Other |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
 			}
-			m.Key = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7664,16 +13547,17 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Key |= (uint32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			m.Truncated = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopPaths", wireType)
 			}
-			m.Started = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7683,16 +13567,78 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Started |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 19:
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopPaths = append(m.TopPaths, &FdPath{})
+			if err := m.TopPaths[len(m.TopPaths)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FdPath) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FdPath: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FdPath: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ByteKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7702,23 +13648,40 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ByteKey = append(m.ByteKey[:0], data[iNdEx:postIndex]...)
-			if m.ByteKey == nil {
-				m.ByteKey = []byte{}
-			}
+			m.Path = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -7740,7 +13703,7 @@ func (m *ContainerStat) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *SystemInfo) Unmarshal(data []byte) error {
+func (m *EnvVar) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7763,15 +13726,15 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SystemInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnvVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SystemInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnvVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7796,13 +13759,13 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = string(data[iNdEx:postIndex])
+			m.Name = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Os", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7812,30 +13775,76 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Os == nil {
-				m.Os = &OSInfo{}
-			}
-			if err := m.Os.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			m.Value = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SystemdUnit) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemdUnit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemdUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7845,28 +13854,55 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cpus = append(m.Cpus, &CPUInfo{})
-			if err := m.Cpus[len(m.Cpus)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.Unit = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Slice = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMemory", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			m.TotalMemory = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7876,11 +13912,21 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.TotalMemory |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -7902,7 +13948,7 @@ func (m *SystemInfo) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *OSInfo) Unmarshal(data []byte) error {
+func (m *ProcessSecurity) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7925,17 +13971,36 @@ func (m *OSInfo) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OSInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessSecurity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OSInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessSecurity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapEffective", wireType)
+			}
+			m.CapEffective = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.CapEffective |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapPermitted", wireType)
+			}
+			m.CapPermitted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7945,26 +14010,16 @@ func (m *OSInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CapPermitted |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapBounding", wireType)
 			}
-			var stringLen uint64
+			m.CapBounding = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -7974,24 +14029,14 @@ func (m *OSInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CapBounding |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Platform = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveCaps", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8016,13 +14061,13 @@ func (m *OSInfo) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Family = string(data[iNdEx:postIndex])
+			m.EffectiveCaps = append(m.EffectiveCaps, string(data[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seccomp", wireType)
 			}
-			var stringLen uint64
+			m.Seccomp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8032,24 +14077,34 @@ func (m *OSInfo) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Seccomp |= (SeccompMode(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoNewPrivs", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Version = string(data[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.NoNewPrivs = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KernelVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LsmLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8074,7 +14129,7 @@ func (m *OSInfo) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KernelVersion = string(data[iNdEx:postIndex])
+			m.LsmLabel = string(data[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8097,7 +14152,7 @@ func (m *OSInfo) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *IOStat) Unmarshal(data []byte) error {
+func (m *Namespaces) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8120,123 +14175,74 @@ func (m *IOStat) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IOStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: Namespaces: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IOStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Namespaces: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Pid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Pid |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.ReadRate = float32(math.Float32frombits(v))
 		case 2:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Net", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Net = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Net |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.WriteRate = float32(math.Float32frombits(v))
 		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadBytesRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mnt", wireType)
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
+			m.Mnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Mnt |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.ReadBytesRate = float32(math.Float32frombits(v))
 		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteBytesRate", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.WriteBytesRate = float32(math.Float32frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAgent(data[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Connection) Unmarshal(data []byte) error {
-	l := len(data)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAgent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Connection: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Connection: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			m.Pid = 0
+			m.User = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8246,16 +14252,16 @@ func (m *Connection) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Pid |= (int32(b) & 0x7F) << shift
+				m.User |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Laddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uts", wireType)
 			}
-			var msglen int
+			m.Uts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8265,30 +14271,35 @@ func (m *Connection) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Uts |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Laddr == nil {
-				m.Laddr = &Addr{}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipc", wireType)
 			}
-			if err := m.Laddr.Unmarshal(data[iNdEx:postIndex]); err != nil {
-				return err
+			m.Ipc = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Ipc |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Raddr", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cgroup", wireType)
 			}
-			var msglen int
+			m.Cgroup = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8298,77 +14309,66 @@ func (m *Connection) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Cgroup |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Raddr == nil {
-				m.Raddr = &Addr{}
-			}
-			if err := m.Raddr.Unmarshal(data[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(data[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesSent", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthAgent
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.BytesSent = float32(math.Float32frombits(v))
-		case 9:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BytesRecieved", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceLimit) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += 4
-			v = uint32(data[iNdEx-4])
-			v |= uint32(data[iNdEx-3]) << 8
-			v |= uint32(data[iNdEx-2]) << 16
-			v |= uint32(data[iNdEx-1]) << 24
-			m.BytesRecieved = float32(math.Float32frombits(v))
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Family", wireType)
-			}
-			m.Family = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Family |= (ConnectionFamily(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Soft", wireType)
 			}
-			m.Type = 0
+			m.Soft = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8378,16 +14378,16 @@ func (m *Connection) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Type |= (ConnectionType(b) & 0x7F) << shift
+				m.Soft |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PidCreateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
 			}
-			m.PidCreateTime = 0
+			m.Hard = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8397,7 +14397,7 @@ func (m *Connection) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.PidCreateTime |= (int64(b) & 0x7F) << shift
+				m.Hard |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8423,7 +14423,7 @@ func (m *Connection) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *Addr) Unmarshal(data []byte) error {
+func (m *ProcessLimits) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8446,15 +14446,15 @@ func (m *Addr) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Addr: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Addr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8478,18 +14478,18 @@ func (m *Addr) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Host == nil {
-				m.Host = &Host{}
+			if m.OpenFiles == nil {
+				m.OpenFiles = &ResourceLimit{}
 			}
-			if err := m.Host.Unmarshal(data[iNdEx:postIndex]); err != nil {
+			if err := m.OpenFiles.Unmarshal(data[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Processes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8499,26 +14499,30 @@ func (m *Addr) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ip = string(data[iNdEx:postIndex])
+			if m.Processes == nil {
+				m.Processes = &ResourceLimit{}
+			}
+			if err := m.Processes.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedMemory", wireType)
 			}
-			m.Port = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8528,11 +14532,58 @@ func (m *Addr) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Port |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockedMemory == nil {
+				m.LockedMemory = &ResourceLimit{}
+			}
+			if err := m.LockedMemory.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CoreSize == nil {
+				m.CoreSize = &ResourceLimit{}
+			}
+			if err := m.CoreSize.Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -8554,7 +14605,7 @@ func (m *Addr) Unmarshal(data []byte) error {
 	}
 	return nil
 }
-func (m *MemoryStat) Unmarshal(data []byte) error {
+func (m *SchedStat) Unmarshal(data []byte) error {
 	l := len(data)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8577,17 +14628,17 @@ func (m *MemoryStat) Unmarshal(data []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MemoryStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: SchedStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MemoryStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SchedStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rss", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunTime", wireType)
 			}
-			m.Rss = 0
+			m.RunTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8597,16 +14648,16 @@ func (m *MemoryStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Rss |= (uint64(b) & 0x7F) << shift
+				m.RunTime |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunQueueWait", wireType)
 			}
-			m.Vms = 0
+			m.RunQueueWait = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8616,16 +14667,16 @@ func (m *MemoryStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Vms |= (uint64(b) & 0x7F) << shift
+				m.RunQueueWait |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeslices", wireType)
 			}
-			m.Swap = 0
+			m.Timeslices = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8635,35 +14686,30 @@ func (m *MemoryStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Swap |= (uint64(b) & 0x7F) << shift
+				m.Timeslices |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunQueueWaitPct", wireType)
 			}
-			m.Shared = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Shared |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.RunQueueWaitPct = float32(math.Float32frombits(v))
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayAcct", wireType)
 			}
-			m.Text = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8673,16 +14719,17 @@ func (m *MemoryStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Text |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.DelayAcct = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lib", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDelay", wireType)
 			}
-			m.Lib = 0
+			m.BlkioDelay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8692,16 +14739,16 @@ func (m *MemoryStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Lib |= (uint64(b) & 0x7F) << shift
+				m.BlkioDelay |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapinDelay", wireType)
 			}
-			m.Data = 0
+			m.SwapinDelay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -8711,30 +14758,39 @@ func (m *MemoryStat) Unmarshal(data []byte) error {
 				}
 				b := data[iNdEx]
 				iNdEx++
-				m.Data |= (uint64(b) & 0x7F) << shift
+				m.SwapinDelay |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dirty", wireType)
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkioDelayPct", wireType)
 			}
-			m.Dirty = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := data[iNdEx]
-				iNdEx++
-				m.Dirty |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.BlkioDelayPct = float32(math.Float32frombits(v))
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapinDelayPct", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
 			}
+			iNdEx += 4
+			v = uint32(data[iNdEx-4])
+			v |= uint32(data[iNdEx-3]) << 8
+			v |= uint32(data[iNdEx-2]) << 16
+			v |= uint32(data[iNdEx-1]) << 24
+			m.SwapinDelayPct = float32(math.Float32frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(data[iNdEx:])
@@ -9769,163 +15825,267 @@ var (
 func init() { proto.RegisterFile("agent.proto", fileDescriptorAgent) }
 
 var fileDescriptorAgent = []byte{
-	// 2515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x73, 0x1d, 0x47,
-	0x11, 0xd7, 0xee, 0xdb, 0xf7, 0xd5, 0xd2, 0x93, 0xd7, 0x63, 0xc5, 0xd9, 0x28, 0x41, 0x28, 0x4b,
-	0x08, 0x42, 0x55, 0x96, 0x8d, 0x13, 0x5c, 0x76, 0xa0, 0x9c, 0x60, 0x19, 0x63, 0x55, 0x62, 0x47,
-	0x35, 0x92, 0x09, 0x15, 0x0e, 0xa9, 0xd5, 0xee, 0xf8, 0x69, 0xcb, 0x6f, 0x3f, 0xd8, 0x0f, 0xc9,
-	0x2f, 0x27, 0xfe, 0x84, 0x5c, 0x38, 0xe4, 0xc8, 0x81, 0x03, 0x55, 0xdc, 0xf9, 0x0f, 0x28, 0x0a,
-	0x2e, 0x14, 0x37, 0x6e, 0x29, 0x53, 0xb9, 0xf0, 0x57, 0x50, 0xdd, 0x33, 0xfb, 0xf5, 0xbe, 0xf4,
-	0x01, 0xa7, 0xd7, 0xdd, 0xd3, 0x3d, 0x33, 0x3b, 0xdd, 0xbf, 0xee, 0x9e, 0x79, 0xb0, 0xec, 0x0c,
-	0x45, 0x98, 0xed, 0xc4, 0x49, 0x94, 0x45, 0xec, 0x35, 0xcf, 0xc9, 0x1c, 0x2f, 0x1a, 0x22, 0xeb,
-	0x8a, 0x34, 0xfd, 0x82, 0x06, 0xd7, 0xdf, 0x1f, 0xfa, 0xd9, 0x71, 0x7e, 0xb4, 0xe3, 0x46, 0xc1,
-	0xcd, 0x87, 0x4e, 0xe6, 0x3c, 0x8c, 0x86, 0x37, 0x69, 0xe4, 0x46, 0xec, 0x8c, 0x47, 0x91, 0xe3,
-	0x49, 0xee, 0x0b, 0xc5, 0xc9, 0xc9, 0xec, 0xbf, 0x69, 0xb0, 0xc2, 0x45, 0xba, 0x1b, 0x8d, 0x46,
-	0xc2, 0xcd, 0xa2, 0x84, 0x3d, 0x80, 0xce, 0xb1, 0x70, 0x3c, 0x91, 0x58, 0xda, 0xa6, 0xb6, 0xb5,
-	0x7c, 0x7b, 0x7b, 0x67, 0xe6, 0x72, 0x3b, 0x75, 0xa3, 0x9d, 0xc7, 0x64, 0xc1, 0x95, 0x25, 0xb3,
-	0xa0, 0x1b, 0x88, 0x34, 0x75, 0x86, 0xc2, 0xd2, 0x37, 0xb5, 0xad, 0x3e, 0x2f, 0x58, 0x76, 0x1f,
-	0x3a, 0x69, 0xe6, 0x64, 0x79, 0x6a, 0xb5, 0x68, 0xf6, 0x77, 0xe7, 0xcc, 0x5e, 0x4e, 0x7d, 0x40,
-	0xda, 0x5c, 0x59, 0xad, 0xbf, 0x05, 0x1d, 0xb9, 0x16, 0x63, 0x60, 0x64, 0xe3, 0x58, 0x58, 0xc6,
-	0xa6, 0xb6, 0xd5, 0xe6, 0x44, 0xdb, 0xff, 0x6c, 0xc1, 0xa0, 0xb4, 0xdc, 0x4f, 0x22, 0x97, 0xad,
-	0x43, 0xef, 0x38, 0x4a, 0xb3, 0xa7, 0x4e, 0x50, 0x6c, 0xa5, 0xe4, 0xd9, 0x4f, 0xa1, 0xaf, 0x16,
-	0x15, 0xb8, 0x9d, 0xd6, 0xd6, 0xf2, 0xed, 0x8d, 0x39, 0xdb, 0xd9, 0x97, 0x1c, 0xaf, 0x0c, 0xd8,
-	0x4d, 0x30, 0x70, 0x26, 0x5a, 0x7f, 0xf9, 0xf6, 0x9b, 0x73, 0x0c, 0x1f, 0x47, 0x69, 0xc6, 0x49,
-	0x91, 0xfd, 0x18, 0x0c, 0x3f, 0x7c, 0x1e, 0x59, 0x6d, 0x32, 0x78, 0x7b, 0x8e, 0xc1, 0xc1, 0x38,
-	0xcd, 0x44, 0xb0, 0x17, 0x3e, 0x8f, 0x38, 0xa9, 0xe3, 0x59, 0x0e, 0x93, 0x28, 0x8f, 0xf7, 0x3c,
-	0xab, 0x43, 0x9f, 0x5a, 0xb0, 0xec, 0x2d, 0xe8, 0x13, 0x79, 0xe0, 0x7f, 0x29, 0xac, 0x2e, 0x8d,
-	0x55, 0x02, 0xb6, 0x07, 0xf0, 0x22, 0x3f, 0x12, 0x49, 0x28, 0x32, 0x91, 0x5a, 0x3d, 0x5a, 0xf4,
-	0x87, 0xe5, 0xa2, 0xb4, 0x58, 0x11, 0x09, 0x1f, 0xe7, 0x47, 0xe2, 0x89, 0xc8, 0x1c, 0x1c, 0xdc,
-	0x97, 0x32, 0x5e, 0x33, 0x66, 0x1f, 0x40, 0x4b, 0xb8, 0xa9, 0xd5, 0xa7, 0x39, 0xb6, 0x66, 0xcf,
-	0xf1, 0xf3, 0xdd, 0x83, 0xc9, 0x29, 0xd0, 0x88, 0x7d, 0x04, 0xe0, 0x46, 0x61, 0xe6, 0xf8, 0xa1,
-	0x48, 0x52, 0x0b, 0xe8, 0x94, 0x37, 0xe7, 0x3a, 0x5d, 0x29, 0xf2, 0x9a, 0x8d, 0xfd, 0x8d, 0x06,
-	0x6b, 0xa5, 0x53, 0x77, 0xa3, 0x30, 0x14, 0x6e, 0xe6, 0x47, 0x61, 0xba, 0xd0, 0xb7, 0xbb, 0xb0,
-	0xec, 0x56, 0xaa, 0xca, 0xbb, 0x6f, 0xcf, 0x5f, 0x57, 0x69, 0xf2, 0xba, 0xd5, 0xc5, 0x5d, 0x5c,
-	0xf3, 0x55, 0x7b, 0x81, 0xaf, 0x3a, 0x13, 0xbe, 0xb2, 0xff, 0xa5, 0xc3, 0xd5, 0xf2, 0x13, 0xb9,
-	0x70, 0x46, 0x87, 0x7e, 0x20, 0x16, 0x7e, 0xdf, 0x5d, 0x68, 0x23, 0x22, 0x8a, 0x2f, 0xb3, 0x17,
-	0xc7, 0x2d, 0x82, 0x88, 0x4b, 0x03, 0x76, 0x1d, 0x3a, 0x38, 0xcb, 0x9e, 0xa7, 0x90, 0xa3, 0x38,
-	0xb6, 0x06, 0xed, 0x28, 0x19, 0x96, 0x3b, 0x97, 0xcc, 0xa5, 0xa3, 0xcf, 0x82, 0x6e, 0x98, 0x07,
-	0xbb, 0x71, 0x2e, 0x43, 0xaf, 0xcd, 0x0b, 0x96, 0x6d, 0xc2, 0x72, 0x16, 0x65, 0xce, 0xe8, 0x89,
-	0x08, 0xa2, 0x64, 0x4c, 0x41, 0xd5, 0xe2, 0x75, 0x11, 0xfb, 0x04, 0x56, 0x4b, 0xf7, 0x1f, 0xd0,
-	0x47, 0xca, 0xb0, 0x79, 0xe7, 0xac, 0xb0, 0xa1, 0xcf, 0x9c, 0xb0, 0xb5, 0xbf, 0x6e, 0x01, 0xab,
-	0x87, 0x8f, 0x1c, 0x6b, 0x1c, 0xae, 0x36, 0x71, 0xb8, 0x05, 0x52, 0xf5, 0x8b, 0x21, 0xb5, 0x19,
-	0xea, 0xad, 0x8b, 0x87, 0x7a, 0xfd, 0xb4, 0x8d, 0x05, 0xa7, 0xdd, 0x5e, 0x8c, 0xf5, 0xce, 0xff,
-	0x01, 0xeb, 0xdd, 0xcb, 0x60, 0xbd, 0xc0, 0x4b, 0xef, 0x9c, 0x78, 0xb1, 0x7f, 0xab, 0xc3, 0xfa,
-	0xb4, 0x6f, 0x66, 0x02, 0x60, 0xd2, 0x47, 0x1f, 0x14, 0x00, 0xd0, 0x2f, 0x10, 0x1b, 0x0a, 0x02,
-	0xb5, 0xe0, 0x6c, 0x2d, 0x0c, 0x4e, 0x63, 0x3a, 0x38, 0x2b, 0xf8, 0xb4, 0x1b, 0xf0, 0xb9, 0x24,
-	0x50, 0xec, 0x5b, 0xb5, 0xe8, 0xe4, 0xe2, 0x37, 0xb2, 0xdc, 0x2d, 0x82, 0xbe, 0x7d, 0x00, 0x57,
-	0x26, 0xaa, 0x23, 0x7b, 0x07, 0x06, 0x8e, 0x9b, 0xf9, 0x27, 0x62, 0x77, 0xe4, 0x8b, 0x30, 0x4b,
-	0xe9, 0xb4, 0xda, 0xbc, 0x29, 0xc4, 0x49, 0xfd, 0x30, 0x13, 0xc9, 0x89, 0x33, 0xa2, 0x49, 0xdb,
-	0xbc, 0xe4, 0xed, 0x3f, 0x76, 0xa0, 0xab, 0x92, 0x05, 0x33, 0xa1, 0xf5, 0x42, 0x8c, 0x69, 0x8e,
-	0x01, 0x47, 0x12, 0x25, 0xb1, 0xef, 0x29, 0x23, 0x24, 0x4b, 0x57, 0xb7, 0xce, 0x9b, 0x1a, 0xef,
-	0x42, 0xd7, 0x8d, 0x82, 0xc0, 0x09, 0x3d, 0x95, 0x4e, 0x37, 0xe6, 0x7a, 0x8c, 0xb4, 0x78, 0xa1,
-	0xce, 0xee, 0x80, 0x91, 0xa7, 0x22, 0x51, 0x75, 0xf3, 0x8c, 0x4c, 0xf7, 0x2c, 0x15, 0x09, 0x27,
-	0x7d, 0x76, 0x0f, 0x3a, 0x81, 0x74, 0x63, 0x77, 0x21, 0x8e, 0xa5, 0x63, 0x29, 0x3e, 0x94, 0x01,
-	0xbb, 0x05, 0x2d, 0x37, 0xce, 0x55, 0x1c, 0xcf, 0xdd, 0xe8, 0xfe, 0x33, 0x32, 0x42, 0x55, 0xb6,
-	0x01, 0xe0, 0x26, 0xc2, 0xc9, 0x04, 0x06, 0xae, 0x4a, 0x6a, 0x35, 0x09, 0xbb, 0x0f, 0xfd, 0x12,
-	0xe7, 0x16, 0xd0, 0xbc, 0x67, 0xa7, 0x86, 0xca, 0x04, 0x03, 0x33, 0x8a, 0x45, 0xf8, 0xc8, 0xdb,
-	0x8d, 0xf2, 0x30, 0xb3, 0x96, 0xc9, 0x13, 0x75, 0x11, 0xbb, 0x27, 0x01, 0x21, 0xac, 0x95, 0x4d,
-	0x6d, 0x6b, 0xf5, 0xf6, 0xf7, 0xce, 0xae, 0x08, 0x42, 0xe2, 0x01, 0xf3, 0x5d, 0xc7, 0x8f, 0x50,
-	0x62, 0x0d, 0x68, 0x67, 0xdf, 0x99, 0x63, 0xbb, 0xf7, 0xa9, 0x3c, 0x25, 0xa9, 0x8c, 0x7b, 0x2a,
-	0x37, 0xb8, 0xe7, 0x59, 0xab, 0x14, 0xa7, 0x75, 0x11, 0xb3, 0x61, 0xa5, 0x64, 0x3f, 0x16, 0x63,
-	0xeb, 0x0a, 0x85, 0x54, 0x43, 0xc6, 0x6e, 0xc3, 0xda, 0x49, 0x34, 0xca, 0xc3, 0xcc, 0x49, 0xc6,
-	0xbb, 0xd9, 0xcb, 0x83, 0x53, 0x3f, 0x73, 0x8f, 0x45, 0x6a, 0x99, 0x9b, 0xda, 0x96, 0xc1, 0x67,
-	0x8e, 0xb1, 0x3b, 0x70, 0xdd, 0x0f, 0x67, 0x5a, 0x5d, 0x25, 0xab, 0x39, 0xa3, 0x08, 0xd2, 0xa3,
-	0x71, 0x26, 0x70, 0x2b, 0x6c, 0x53, 0xdb, 0x5a, 0xe1, 0x05, 0xcb, 0xb6, 0xc1, 0x2c, 0x77, 0xf5,
-	0x40, 0xa9, 0x5c, 0x23, 0x95, 0x29, 0xb9, 0xfd, 0xb5, 0x06, 0x5d, 0x15, 0xa5, 0xd8, 0x85, 0x3a,
-	0xc9, 0x10, 0x01, 0xd7, 0xda, 0xea, 0x73, 0xa2, 0x11, 0x2d, 0xee, 0xa9, 0x47, 0xd0, 0xe8, 0x73,
-	0x24, 0x51, 0x2b, 0x89, 0x22, 0xd9, 0x48, 0xf4, 0x39, 0xd1, 0x98, 0x48, 0xa2, 0xf0, 0xa1, 0x9f,
-	0xbe, 0xa0, 0xc0, 0xee, 0x71, 0xc5, 0xa1, 0x6e, 0x8c, 0x60, 0x93, 0x59, 0x84, 0x68, 0xd4, 0x8d,
-	0x29, 0x65, 0xa8, 0xfc, 0xa1, 0x38, 0x5c, 0x49, 0xbc, 0x14, 0x14, 0xa7, 0x7d, 0x8e, 0xa4, 0xfd,
-	0x3b, 0x0d, 0x96, 0x6b, 0x50, 0xc0, 0xd9, 0xc2, 0x2a, 0x7d, 0x12, 0x8d, 0x56, 0x79, 0x85, 0xe6,
-	0xdc, 0xf7, 0x50, 0x32, 0xf4, 0x3d, 0x95, 0x0c, 0x91, 0x44, 0x3b, 0x81, 0x4a, 0xaa, 0xbb, 0x46,
-	0x9a, 0x64, 0xa8, 0xd6, 0x56, 0x32, 0xa5, 0x97, 0xe6, 0xd5, 0x6e, 0x53, 0xa5, 0x97, 0xa2, 0x5e,
-	0x57, 0xc9, 0x86, 0xbe, 0x67, 0x7f, 0xdb, 0x86, 0x7e, 0x55, 0x7c, 0x8b, 0xde, 0x5d, 0xed, 0x0a,
-	0x69, 0xb6, 0x0a, 0xba, 0xda, 0x54, 0x9f, 0xeb, 0x72, 0x16, 0xda, 0x79, 0xab, 0xb6, 0xf3, 0x35,
-	0x68, 0xfb, 0x01, 0xde, 0x2a, 0xe4, 0x41, 0x4a, 0x06, 0xf3, 0x9a, 0x1b, 0xe7, 0x9f, 0xf8, 0x81,
-	0x9f, 0xd1, 0xde, 0x74, 0x5e, 0xf2, 0x18, 0xa3, 0x12, 0xd3, 0x72, 0xb8, 0x43, 0xe1, 0x51, 0x17,
-	0xb1, 0x9f, 0x14, 0xb8, 0xe9, 0x11, 0x6e, 0xbe, 0x7f, 0x9e, 0x42, 0x52, 0x22, 0xe7, 0x3e, 0x5d,
-	0x96, 0x46, 0xd9, 0x31, 0x41, 0x7e, 0x75, 0xc1, 0x75, 0x46, 0x59, 0x3f, 0x26, 0x6d, 0xae, 0xac,
-	0x30, 0x20, 0x65, 0x92, 0xf0, 0x28, 0x29, 0xb4, 0x78, 0xc1, 0x52, 0xc8, 0x1c, 0xc5, 0x29, 0x21,
-	0x5d, 0xe7, 0x44, 0xa3, 0xec, 0x14, 0x65, 0x2b, 0x52, 0x86, 0x74, 0x91, 0xac, 0x07, 0x55, 0xb2,
-	0x7e, 0x0b, 0xfa, 0xa1, 0xc8, 0xb8, 0x7b, 0xe2, 0xed, 0xa7, 0x04, 0x4a, 0x9d, 0x57, 0x02, 0x35,
-	0x7a, 0x20, 0xc2, 0x6c, 0x3f, 0x25, 0x3c, 0xca, 0x51, 0x29, 0xc0, 0x34, 0xa6, 0x54, 0x1f, 0xc4,
-	0x12, 0x82, 0x3a, 0xaf, 0x49, 0xd4, 0x38, 0x2a, 0xe3, 0xf8, 0xd5, 0x72, 0x5c, 0x49, 0xf0, 0x7b,
-	0x30, 0xf7, 0xee, 0xbb, 0x19, 0x01, 0x4c, 0xe7, 0x05, 0x8b, 0xeb, 0xa6, 0xd4, 0x30, 0xe1, 0xd8,
-	0x35, 0xb9, 0x6e, 0x29, 0x40, 0x17, 0x52, 0x91, 0xc5, 0xc1, 0x35, 0xe9, 0xc2, 0x82, 0xc7, 0xe0,
-	0x0f, 0x44, 0xc0, 0xd3, 0xd4, 0x7a, 0x8d, 0xbc, 0xa7, 0x38, 0xb4, 0x09, 0x44, 0xb0, 0xeb, 0xb8,
-	0xc7, 0xc2, 0xba, 0x4e, 0x23, 0x25, 0x5f, 0x96, 0xa7, 0xd7, 0x2f, 0xd0, 0xb9, 0xa7, 0x99, 0x93,
-	0xa0, 0x23, 0x2c, 0xe9, 0x08, 0xc5, 0xd6, 0x73, 0xc6, 0x1b, 0xcd, 0x9c, 0x81, 0x51, 0xec, 0x0c,
-	0x53, 0x6b, 0x5d, 0x62, 0x1f, 0x69, 0xfb, 0xcf, 0xbd, 0x12, 0x7f, 0x94, 0x23, 0x55, 0xe5, 0xd4,
-	0xaa, 0xca, 0xd9, 0xac, 0x14, 0xfa, 0x54, 0xa5, 0xa8, 0xca, 0x56, 0xeb, 0x92, 0x65, 0xcb, 0x38,
-	0x7f, 0xd9, 0x42, 0x90, 0xf9, 0x6e, 0xd1, 0x51, 0x12, 0x8d, 0x1f, 0x9c, 0x1d, 0x27, 0xc2, 0xf1,
-	0x52, 0x85, 0xe0, 0x82, 0x9d, 0x2c, 0x42, 0xbd, 0xe9, 0x22, 0xa4, 0xa2, 0xb1, 0x5f, 0x45, 0xe3,
-	0x44, 0x91, 0x80, 0xe9, 0x22, 0xf1, 0x64, 0xa2, 0xdd, 0x17, 0x14, 0xf3, 0xe7, 0x46, 0xe2, 0x84,
-	0x31, 0xfb, 0x05, 0xac, 0xc4, 0xb5, 0x1a, 0x77, 0x91, 0x72, 0xd8, 0x30, 0x64, 0xfb, 0x70, 0xc5,
-	0x6d, 0xc2, 0x96, 0xf0, 0x72, 0x7e, 0x90, 0x4f, 0x9a, 0x63, 0x9b, 0x56, 0x8a, 0xf8, 0x51, 0x09,
-	0xb0, 0xa6, 0xb0, 0xa1, 0xf5, 0xd9, 0x51, 0x09, 0xb3, 0xa6, 0x70, 0xaa, 0xb4, 0xb2, 0x19, 0xa5,
-	0xb5, 0xaa, 0xeb, 0xd7, 0x2e, 0x52, 0xd7, 0x77, 0x80, 0x95, 0xd3, 0x3c, 0x2d, 0x33, 0x89, 0x84,
-	0xe5, 0x8c, 0x91, 0x49, 0x7d, 0x95, 0x5b, 0x5e, 0x9b, 0xd6, 0x57, 0x49, 0xe6, 0x16, 0x5c, 0x9b,
-	0x9c, 0x05, 0xb3, 0xc9, 0x75, 0x32, 0x98, 0x35, 0x34, 0x69, 0x51, 0xe4, 0x9f, 0xd7, 0xa7, 0x2d,
-	0x8a, 0x44, 0x34, 0xaf, 0xab, 0xb0, 0x2e, 0xd5, 0x55, 0xbc, 0x71, 0xde, 0xae, 0x62, 0xfd, 0xec,
-	0xae, 0xe2, 0xcd, 0x39, 0x5d, 0xc5, 0x5f, 0x0c, 0x18, 0x34, 0x42, 0x5b, 0x55, 0x44, 0xad, 0xac,
-	0x88, 0xb5, 0xe4, 0xaa, 0x2f, 0x48, 0xae, 0xad, 0x45, 0xc9, 0xd5, 0x98, 0x48, 0xae, 0x8b, 0x6a,
-	0x67, 0x95, 0x78, 0x3b, 0x73, 0x13, 0x6f, 0x77, 0x22, 0xf1, 0xca, 0x31, 0x39, 0x5f, 0xaf, 0x1c,
-	0x93, 0xf3, 0x15, 0x25, 0xad, 0x3f, 0xa3, 0xa4, 0x41, 0xad, 0xa4, 0x35, 0x0a, 0xd8, 0xf2, 0xc2,
-	0x02, 0xb6, 0xb2, 0xb8, 0x80, 0x0d, 0xce, 0x28, 0x60, 0xab, 0x53, 0x05, 0xac, 0xec, 0x06, 0xae,
-	0xfc, 0x4f, 0xdd, 0x80, 0x79, 0xa9, 0x6e, 0x40, 0x65, 0xcf, 0xab, 0x55, 0xf6, 0xac, 0x95, 0x25,
-	0x36, 0xb7, 0x2c, 0x5d, 0x6b, 0x04, 0x9d, 0xfd, 0x07, 0x0d, 0xa0, 0x7a, 0x9b, 0xc0, 0x13, 0xce,
-	0xf3, 0x32, 0x8e, 0x88, 0x66, 0x37, 0x40, 0x8f, 0x52, 0xf5, 0xbc, 0x31, 0x2f, 0x29, 0x7c, 0x7a,
-	0x40, 0x4f, 0x1b, 0x7a, 0x84, 0x60, 0x32, 0x5c, 0x79, 0x59, 0x6e, 0x2d, 0x2e, 0x2c, 0xf2, 0x31,
-	0xc4, 0x9d, 0x71, 0x93, 0x6e, 0x4f, 0xdd, 0xa4, 0xed, 0xaf, 0x34, 0xe8, 0xc8, 0x45, 0x66, 0x76,
-	0xa9, 0xeb, 0xd0, 0x8b, 0x47, 0x4e, 0xf6, 0x3c, 0x4a, 0x82, 0xe2, 0x0a, 0x5c, 0xf0, 0x18, 0x99,
-	0xcf, 0x9d, 0xc0, 0x1f, 0x8d, 0x55, 0x77, 0xa8, 0x38, 0x3c, 0x94, 0x13, 0x91, 0xa4, 0x7e, 0x14,
-	0xaa, 0x0e, 0xb1, 0x60, 0x31, 0xa9, 0xbe, 0x10, 0x49, 0x28, 0x46, 0xbf, 0x54, 0xe3, 0x6d, 0x1a,
-	0x6f, 0x0a, 0x69, 0x4b, 0x32, 0x19, 0xe2, 0xf2, 0x58, 0xf4, 0x38, 0xc6, 0x82, 0x26, 0x81, 0x51,
-	0xf0, 0x18, 0x82, 0xa7, 0x89, 0x9f, 0x09, 0x1a, 0x94, 0x50, 0xac, 0x04, 0xb8, 0x14, 0x6a, 0x22,
-	0xae, 0x53, 0xd2, 0x90, 0x80, 0x6c, 0x0a, 0xd9, 0xbb, 0xb0, 0x4a, 0x26, 0x95, 0x9a, 0x84, 0xe6,
-	0x84, 0xd4, 0xfe, 0x8f, 0x0e, 0x50, 0xbd, 0x4f, 0xce, 0xe8, 0x27, 0x7e, 0x04, 0xed, 0x91, 0xe3,
-	0x79, 0xc5, 0xfd, 0x78, 0x5e, 0xaf, 0xf3, 0x33, 0xcf, 0x4b, 0xb8, 0xd4, 0x44, 0x93, 0x84, 0x4c,
-	0x3a, 0xe7, 0x30, 0x21, 0x4d, 0xfc, 0x64, 0x8c, 0xaf, 0x14, 0x71, 0x42, 0xc0, 0xd6, 0x79, 0x25,
-	0xc0, 0x4f, 0x26, 0x86, 0x0b, 0xd7, 0x17, 0x27, 0xc2, 0x53, 0x10, 0x6f, 0x0a, 0xd9, 0x87, 0xa5,
-	0xd7, 0x80, 0xe0, 0xf1, 0x83, 0x33, 0x9f, 0x63, 0x1f, 0x91, 0x7a, 0xe9, 0xde, 0x7b, 0xea, 0xda,
-	0x70, 0x66, 0x7f, 0xa0, 0xcc, 0x0f, 0xc7, 0xb1, 0x50, 0xb7, 0x8b, 0x77, 0x60, 0x10, 0xfb, 0xde,
-	0x6e, 0xd5, 0x78, 0xad, 0x50, 0x40, 0x36, 0x85, 0xf6, 0xaf, 0xc1, 0xc0, 0x8f, 0x2e, 0xdb, 0x47,
-	0xed, 0xbc, 0xed, 0x23, 0xa6, 0xea, 0xb8, 0xbc, 0xbc, 0xc4, 0x74, 0x89, 0x8b, 0x92, 0x4c, 0xdd,
-	0xa8, 0x88, 0xb6, 0xff, 0xa4, 0x01, 0x54, 0x4d, 0x1b, 0x7a, 0x32, 0x49, 0xe5, 0x4b, 0x8d, 0xc1,
-	0x91, 0x44, 0xc9, 0x49, 0x20, 0x61, 0x69, 0x70, 0x24, 0xe9, 0x26, 0x75, 0xea, 0xc4, 0x34, 0x8d,
-	0xc1, 0x89, 0xc6, 0xd8, 0x4f, 0x8f, 0x9d, 0x44, 0xc8, 0xbb, 0x99, 0xc1, 0x15, 0x47, 0xdd, 0xa8,
-	0x78, 0x29, 0xb3, 0xb8, 0xc1, 0x89, 0xc6, 0x19, 0x47, 0xfe, 0x91, 0x4a, 0xdf, 0x48, 0xa2, 0x16,
-	0x7e, 0x8c, 0xca, 0xdb, 0x44, 0xe3, 0xad, 0xca, 0xf3, 0x93, 0x6c, 0xac, 0x12, 0xb6, 0x64, 0xec,
-	0xdf, 0xeb, 0xd0, 0x55, 0xbd, 0x22, 0xe2, 0x6a, 0xe4, 0xa4, 0xd9, 0x6e, 0x9c, 0x2b, 0x88, 0x16,
-	0x6c, 0xa3, 0xb6, 0xe8, 0x13, 0xb5, 0xa5, 0x56, 0xaf, 0x5a, 0x0b, 0xea, 0x95, 0x31, 0x59, 0xaf,
-	0x30, 0x47, 0xe7, 0xc1, 0xa1, 0xea, 0x41, 0x65, 0x6b, 0x5a, 0x93, 0xb0, 0xbb, 0x2a, 0x1d, 0x75,
-	0x16, 0xbe, 0xfc, 0x1d, 0xf8, 0xe1, 0x70, 0x24, 0x8a, 0x6e, 0x57, 0x26, 0xa5, 0xa2, 0xdd, 0xed,
-	0xd6, 0xda, 0xdd, 0x75, 0xe8, 0xe1, 0xb6, 0x28, 0x28, 0x7a, 0x14, 0x14, 0x25, 0x8f, 0x3b, 0x91,
-	0xdb, 0xaa, 0xbf, 0xea, 0x54, 0x12, 0xfb, 0x43, 0x18, 0x34, 0x96, 0x99, 0x97, 0xc8, 0xe6, 0x1d,
-	0x91, 0xfd, 0xad, 0x46, 0x87, 0x4c, 0x49, 0xf0, 0x3a, 0x74, 0xc2, 0x3c, 0x38, 0x52, 0x7f, 0xbc,
-	0xb5, 0xb9, 0xe2, 0x50, 0x7e, 0x22, 0x42, 0x2f, 0x4a, 0x54, 0x7c, 0x29, 0x6e, 0x6e, 0x12, 0x5c,
-	0x83, 0x76, 0x10, 0x79, 0x62, 0x54, 0x5c, 0x92, 0x89, 0xc1, 0x4f, 0x89, 0x8f, 0xc7, 0xa9, 0xef,
-	0x3a, 0x23, 0xf5, 0x76, 0xd9, 0xe7, 0x35, 0x09, 0xce, 0xe6, 0x46, 0x89, 0x50, 0xcf, 0x97, 0x7d,
-	0xae, 0x38, 0x9c, 0x0d, 0xa9, 0xe2, 0x2e, 0x20, 0x19, 0x0c, 0xac, 0xe0, 0xf8, 0x4b, 0x75, 0x5e,
-	0x48, 0xa2, 0x4b, 0x5d, 0xec, 0x00, 0xe8, 0x95, 0xb3, 0x2f, 0x5f, 0x39, 0x4b, 0x81, 0xfd, 0x77,
-	0x0d, 0x8c, 0xc7, 0x05, 0x50, 0x8a, 0xf4, 0x85, 0x3d, 0x4d, 0xf9, 0xaf, 0x83, 0x5e, 0xff, 0xd7,
-	0x61, 0xd6, 0xdd, 0xff, 0x3d, 0x75, 0xdb, 0x32, 0xc8, 0xeb, 0xdf, 0x5d, 0x80, 0xc9, 0x43, 0x67,
-	0x98, 0xca, 0xeb, 0x18, 0x86, 0xa0, 0x33, 0x1a, 0xa1, 0x80, 0xa2, 0xa5, 0xcf, 0x0b, 0xb6, 0xfe,
-	0x06, 0xdc, 0x5d, 0xf8, 0x06, 0xdc, 0x9b, 0xae, 0x5c, 0xf7, 0xa1, 0x57, 0xac, 0x43, 0x21, 0x12,
-	0xe5, 0x89, 0x2b, 0x0e, 0x8b, 0x07, 0x8d, 0x01, 0xaf, 0x49, 0xca, 0x4b, 0xa2, 0x5e, 0x5d, 0x12,
-	0xb7, 0x7d, 0x58, 0x6d, 0x36, 0x10, 0x6c, 0x19, 0xba, 0x79, 0xf8, 0x22, 0x8c, 0x4e, 0x43, 0x73,
-	0x09, 0x19, 0xf5, 0x0a, 0x60, 0x6a, 0x6c, 0x15, 0x20, 0x11, 0x54, 0xf4, 0xfd, 0x70, 0x68, 0xea,
-	0x38, 0x98, 0xe4, 0x61, 0x88, 0x4c, 0x8b, 0x01, 0x74, 0x62, 0x27, 0x4f, 0x85, 0x67, 0x1a, 0x48,
-	0x8b, 0x97, 0x3e, 0x1a, 0xb5, 0x59, 0x0f, 0x0c, 0x4f, 0x38, 0x9e, 0xd9, 0xd9, 0x7e, 0x0a, 0x57,
-	0x26, 0xba, 0x0d, 0x76, 0x15, 0x06, 0x6a, 0x2d, 0x29, 0x30, 0x97, 0xd8, 0x0a, 0xf4, 0xca, 0x25,
-	0x34, 0x5c, 0x42, 0x36, 0x24, 0x63, 0x53, 0x67, 0x03, 0xe8, 0xe7, 0x61, 0xc1, 0xb6, 0xb6, 0x1f,
-	0xc1, 0x4a, 0xfd, 0xca, 0xc4, 0xda, 0xa0, 0x3d, 0x33, 0x97, 0xf0, 0xe7, 0xa1, 0xa9, 0xe1, 0x0f,
-	0x37, 0x75, 0xfc, 0x39, 0x30, 0x5b, 0xf8, 0x73, 0x68, 0x1a, 0xf8, 0xf3, 0x99, 0xd9, 0xc6, 0x9f,
-	0x5f, 0x99, 0x1d, 0xfc, 0xf9, 0xdc, 0xec, 0x6e, 0xdb, 0x74, 0x04, 0xb5, 0x3c, 0xcd, 0xba, 0xd0,
-	0xca, 0xdc, 0xd8, 0x5c, 0x42, 0x22, 0xf7, 0x62, 0x53, 0xdb, 0xb6, 0xc1, 0x9c, 0x2c, 0x05, 0xac,
-	0x03, 0xfa, 0xc9, 0xfb, 0xe6, 0x12, 0xfd, 0xde, 0x31, 0xb5, 0x07, 0x1f, 0xfd, 0xf5, 0xd5, 0x86,
-	0xf6, 0x8f, 0x57, 0x1b, 0xda, 0x37, 0xaf, 0x36, 0xb4, 0xaf, 0xfe, 0xbd, 0xb1, 0xf4, 0xf9, 0xce,
-	0x8c, 0xbf, 0xc1, 0x55, 0xac, 0xdc, 0x50, 0xb1, 0x72, 0x83, 0x62, 0xe5, 0x26, 0x01, 0xe3, 0xa8,
-	0x43, 0xff, 0x83, 0xbf, 0xf7, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xbe, 0x31, 0xc8, 0x63,
-	0x1f, 0x00, 0x00,
+	// 4178 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3b, 0x4d, 0x8f, 0xdc, 0x56,
+	0x72, 0x22, 0x9b, 0xfd, 0xf5, 0x7a, 0x3e, 0x28, 0x4a, 0x96, 0xe9, 0xb1, 0x56, 0x3b, 0xcb, 0x78,
+	0x9d, 0xc9, 0x00, 0x96, 0xbc, 0xda, 0x5d, 0xc7, 0x5e, 0x3b, 0x5e, 0x7b, 0x46, 0xd6, 0x4a, 0xb0,
+	0x24, 0x4f, 0xde, 0x48, 0x76, 0xe0, 0x1c, 0x16, 0x1c, 0xf2, 0x4d, 0x37, 0x31, 0x6c, 0x92, 0xe1,
+	0x7b, 0xec, 0xd1, 0xf8, 0x94, 0x43, 0x7e, 0xc0, 0x22, 0xb7, 0x60, 0x4f, 0x41, 0x10, 0x24, 0x40,
+	0x72, 0xc9, 0x4f, 0x08, 0x10, 0x04, 0x41, 0x72, 0xc9, 0x35, 0x87, 0x00, 0x0b, 0x2f, 0xf6, 0xee,
+	0x5b, 0xae, 0x41, 0xd5, 0xfb, 0x20, 0xd9, 0x3d, 0xdd, 0x9a, 0x99, 0xe4, 0xd4, 0xaf, 0xea, 0x55,
+	0xbd, 0x8f, 0x7a, 0x55, 0xf5, 0xaa, 0xea, 0xb1, 0xc9, 0x28, 0x1c, 0xb3, 0x4c, 0xdc, 0x2d, 0xca,
+	0x5c, 0xe4, 0xde, 0x6b, 0x71, 0x28, 0xc2, 0x38, 0x1f, 0x03, 0x18, 0x31, 0xce, 0x7f, 0x89, 0x9d,
+	0x5b, 0x3f, 0x19, 0x27, 0x62, 0x52, 0x1d, 0xdd, 0x8d, 0xf2, 0xe9, 0xbd, 0x07, 0xa1, 0x08, 0x1f,
+	0xe4, 0xe3, 0x7b, 0xd8, 0xf3, 0x4e, 0x11, 0x9e, 0xa5, 0x79, 0x18, 0x4b, 0xe8, 0x97, 0x0a, 0x92,
+	0x83, 0x05, 0xff, 0x6e, 0x91, 0x35, 0xca, 0xf8, 0x7e, 0x9e, 0xa6, 0x2c, 0x12, 0x79, 0xe9, 0xed,
+	0x91, 0xde, 0x84, 0x85, 0x31, 0x2b, 0x7d, 0x6b, 0xdb, 0xda, 0x19, 0xdd, 0xdf, 0xbd, 0x7b, 0xee,
+	0x74, 0x77, 0x9b, 0x4c, 0x77, 0x1f, 0x21, 0x07, 0x55, 0x9c, 0x9e, 0x4f, 0xfa, 0x53, 0xc6, 0x79,
+	0x38, 0x66, 0xbe, 0xbd, 0x6d, 0xed, 0x0c, 0xa9, 0x06, 0xbd, 0x8f, 0x49, 0x8f, 0x8b, 0x50, 0x54,
+	0xdc, 0xef, 0xe0, 0xe8, 0x6f, 0x2f, 0x19, 0xdd, 0x0c, 0x7d, 0x88, 0xd4, 0x54, 0x71, 0x6d, 0xdd,
+	0x26, 0x3d, 0x39, 0x97, 0xe7, 0x11, 0x47, 0x9c, 0x15, 0xcc, 0x77, 0xb6, 0xad, 0x9d, 0x2e, 0xc5,
+	0x76, 0xf0, 0x9d, 0x43, 0xd6, 0x0d, 0xe7, 0x41, 0x99, 0x47, 0xde, 0x16, 0x19, 0x4c, 0x72, 0x2e,
+	0x9e, 0x85, 0x53, 0xbd, 0x14, 0x03, 0x7b, 0x1f, 0x91, 0xa1, 0x9a, 0x94, 0xc1, 0x72, 0x3a, 0x3b,
+	0xa3, 0xfb, 0x77, 0x96, 0x2c, 0xe7, 0x40, 0x42, 0xb4, 0x66, 0xf0, 0xee, 0x11, 0x07, 0x46, 0xc2,
+	0xf9, 0x47, 0xf7, 0xdf, 0x5c, 0xc2, 0xf8, 0x28, 0xe7, 0x82, 0x22, 0xa1, 0xf7, 0x53, 0xe2, 0x24,
+	0xd9, 0x71, 0xee, 0x77, 0x91, 0xe1, 0x07, 0x4b, 0x18, 0x0e, 0xcf, 0xb8, 0x60, 0xd3, 0xc7, 0xd9,
+	0x71, 0x4e, 0x91, 0x1c, 0x64, 0x39, 0x2e, 0xf3, 0xaa, 0x78, 0x1c, 0xfb, 0x3d, 0xdc, 0xaa, 0x06,
+	0xbd, 0xdb, 0x64, 0x88, 0xcd, 0xc3, 0xe4, 0x1b, 0xe6, 0xf7, 0xb1, 0xaf, 0x46, 0x78, 0x8f, 0x09,
+	0x39, 0xa9, 0x8e, 0x58, 0x99, 0x31, 0xc1, 0xb8, 0x3f, 0xc0, 0x49, 0xff, 0xc0, 0x4c, 0x8a, 0x93,
+	0x69, 0x4d, 0xf8, 0xbc, 0x3a, 0x62, 0x4f, 0x99, 0x08, 0xa1, 0xf3, 0x40, 0xe2, 0x68, 0x83, 0xd9,
+	0xfb, 0x19, 0xe9, 0xb0, 0x88, 0xfb, 0x43, 0x1c, 0x63, 0xe7, 0xfc, 0x31, 0x3e, 0xdb, 0x3f, 0x9c,
+	0x1f, 0x02, 0x98, 0xbc, 0x4f, 0x08, 0x89, 0xf2, 0x4c, 0x84, 0x49, 0xc6, 0x4a, 0xee, 0x13, 0x94,
+	0xf2, 0xf6, 0xd2, 0x43, 0x57, 0x84, 0xb4, 0xc1, 0xe3, 0x7d, 0x4d, 0x6e, 0xf0, 0x49, 0x5e, 0x8a,
+	0x27, 0xc9, 0x8c, 0xc5, 0x07, 0xe6, 0xc0, 0x46, 0xdb, 0x9d, 0xd6, 0x6a, 0xe6, 0xc4, 0x38, 0xcf,
+	0x41, 0xcf, 0x1b, 0xc4, 0xfb, 0x44, 0xaa, 0xc7, 0x7e, 0x51, 0x71, 0x7f, 0x0d, 0x07, 0x7c, 0x6b,
+	0xd9, 0x80, 0x49, 0x36, 0x4e, 0xd9, 0xfe, 0xc1, 0x0b, 0x50, 0x48, 0x6a, 0xb8, 0x82, 0xdf, 0x58,
+	0xe4, 0xa6, 0x51, 0xb9, 0xfd, 0x3c, 0xcb, 0x58, 0x24, 0x92, 0x3c, 0xe3, 0x2b, 0x35, 0x6f, 0x9f,
+	0x8c, 0xa2, 0x9a, 0x54, 0xe9, 0xde, 0x0f, 0x96, 0x4b, 0x45, 0x51, 0xd2, 0x26, 0xd7, 0xe5, 0x15,
+	0xb0, 0xa1, 0x49, 0xdd, 0x15, 0x9a, 0xd4, 0x9b, 0xd3, 0xa4, 0xe0, 0xef, 0x2c, 0xe2, 0x99, 0x2d,
+	0x3e, 0x49, 0xb8, 0x60, 0x78, 0x2e, 0xcd, 0x0d, 0x5a, 0x73, 0x1b, 0xfc, 0x23, 0x32, 0x4c, 0x35,
+	0xa1, 0x6f, 0xe3, 0xf6, 0xbe, 0xbf, 0x64, 0x81, 0x7a, 0x40, 0x5a, 0x73, 0x34, 0x57, 0xda, 0x59,
+	0xb1, 0x52, 0x67, 0x7e, 0xa5, 0xbf, 0xee, 0x90, 0xeb, 0x66, 0xa5, 0x94, 0x85, 0xe9, 0xf3, 0x64,
+	0xca, 0x56, 0x9e, 0xc4, 0xfb, 0xa4, 0xcb, 0x45, 0x28, 0xf4, 0x19, 0x04, 0xab, 0xed, 0x1f, 0xcf,
+	0x5e, 0x32, 0x78, 0xb7, 0x48, 0x0f, 0x46, 0x79, 0x1c, 0xab, 0x65, 0x28, 0xc8, 0xbb, 0x49, 0xba,
+	0x79, 0x39, 0x36, 0x32, 0x96, 0xc0, 0x95, 0xad, 0xd8, 0x27, 0xfd, 0xac, 0x9a, 0xa2, 0x7e, 0x0e,
+	0x24, 0x9f, 0x02, 0xbd, 0x6d, 0x32, 0x12, 0xb9, 0x08, 0xd3, 0xa7, 0x6c, 0x9a, 0x97, 0x67, 0x68,
+	0x9c, 0x1d, 0xda, 0x44, 0x79, 0x4f, 0xc8, 0x86, 0x31, 0xa3, 0x43, 0xdc, 0x24, 0x59, 0xa9, 0xe2,
+	0xfb, 0x4d, 0x62, 0x3a, 0xc7, 0xdb, 0x32, 0x95, 0xd1, 0x95, 0x4c, 0xe5, 0xaf, 0x3a, 0x0d, 0x3d,
+	0x32, 0x93, 0xad, 0xd4, 0x23, 0xed, 0x33, 0xed, 0xcb, 0xf9, 0xcc, 0xb6, 0xd3, 0xe9, 0x5c, 0xc1,
+	0xe9, 0x34, 0xce, 0xcb, 0x59, 0x71, 0x5e, 0xdd, 0xd5, 0x5e, 0xb7, 0xf7, 0xff, 0xe0, 0x75, 0xfb,
+	0x57, 0xf1, 0xba, 0xda, 0x37, 0x0c, 0x2e, 0xe8, 0x1b, 0x82, 0x3f, 0xb7, 0xc9, 0xd6, 0xe2, 0xd9,
+	0x9c, 0x6b, 0x42, 0xf3, 0x67, 0xf4, 0x33, 0x6d, 0x42, 0xf6, 0x25, 0xb4, 0x4b, 0x19, 0x51, 0x43,
+	0xbd, 0x3b, 0x2b, 0xd5, 0xdb, 0x59, 0x54, 0xef, 0xda, 0x00, 0xbb, 0x2d, 0x03, 0xbc, 0xa2, 0xa9,
+	0x05, 0x7f, 0xdb, 0x74, 0x73, 0x60, 0xf0, 0x9f, 0xcd, 0x58, 0x26, 0x56, 0x6e, 0xfd, 0x43, 0xd2,
+	0x63, 0x40, 0xa4, 0xf7, 0xfe, 0x7b, 0xab, 0xdd, 0x07, 0x0e, 0x48, 0x15, 0xcb, 0x95, 0x9d, 0xdc,
+	0xbb, 0x8d, 0x65, 0x52, 0xf6, 0x67, 0x32, 0x40, 0x5a, 0xe5, 0xe4, 0x82, 0x43, 0xb2, 0x39, 0x17,
+	0x4f, 0x79, 0x6f, 0x91, 0xf5, 0x30, 0x12, 0xc9, 0x8c, 0xed, 0xa7, 0x09, 0x6e, 0xc0, 0xc2, 0x69,
+	0xda, 0x48, 0x18, 0x34, 0xc9, 0x04, 0x2b, 0x67, 0x61, 0x8a, 0x83, 0x76, 0xa9, 0x81, 0x83, 0xef,
+	0x08, 0xe9, 0xab, 0x7d, 0x79, 0x2e, 0xe9, 0x9c, 0xb0, 0x33, 0x1c, 0x63, 0x9d, 0x42, 0x13, 0x30,
+	0x45, 0x12, 0x2b, 0x26, 0x68, 0x1a, 0x95, 0xec, 0x5c, 0xf4, 0xba, 0x7a, 0x9f, 0xf4, 0xa3, 0x7c,
+	0x3a, 0x0d, 0xb3, 0x58, 0x5d, 0x71, 0x77, 0x96, 0x6a, 0x16, 0x52, 0x51, 0x4d, 0xee, 0xbd, 0x47,
+	0x9c, 0x8a, 0xb3, 0x52, 0x45, 0x5a, 0xaf, 0xf0, 0xe9, 0x2f, 0x38, 0x2b, 0x29, 0xd2, 0x7b, 0x1f,
+	0x90, 0xde, 0x54, 0xaa, 0x5b, 0x7f, 0xa5, 0xbf, 0x91, 0x0a, 0x88, 0x7a, 0xac, 0x18, 0xbc, 0x77,
+	0x49, 0x27, 0x2a, 0x2a, 0x7f, 0xb0, 0x7a, 0xa1, 0xca, 0x25, 0x02, 0xa9, 0x77, 0x87, 0x90, 0xa8,
+	0x64, 0xa1, 0x60, 0x60, 0x60, 0xca, 0x7d, 0x37, 0x30, 0xde, 0xc7, 0x64, 0x68, 0xfc, 0x91, 0x4f,
+	0xb6, 0xad, 0x0b, 0xb9, 0xb0, 0x9a, 0x05, 0x0c, 0x28, 0x2f, 0x58, 0xf6, 0x30, 0xde, 0xcf, 0xab,
+	0x4c, 0xf8, 0x23, 0x3c, 0x89, 0x26, 0xca, 0xfb, 0x40, 0x1a, 0x2e, 0xf3, 0xd7, 0xb6, 0xad, 0x9d,
+	0x8d, 0x57, 0x29, 0x2f, 0xac, 0x9c, 0x49, 0xbb, 0x05, 0xbf, 0xdc, 0x4b, 0x72, 0xc0, 0xf8, 0xeb,
+	0xb8, 0xb2, 0xef, 0x2d, 0xe1, 0x7d, 0xfc, 0x85, 0x94, 0x92, 0x24, 0x86, 0x35, 0x99, 0x05, 0x3e,
+	0x8e, 0xfd, 0x0d, 0xd4, 0xd3, 0x26, 0xca, 0x0b, 0xc8, 0x9a, 0x01, 0x3f, 0x67, 0x67, 0xfe, 0x26,
+	0xaa, 0x54, 0x0b, 0xe7, 0xdd, 0x27, 0x37, 0x67, 0x79, 0x5a, 0x65, 0x22, 0x2c, 0xcf, 0xf6, 0xc5,
+	0xcb, 0xc3, 0xd3, 0x44, 0x44, 0x13, 0xc6, 0x7d, 0x77, 0xdb, 0xda, 0x71, 0xe8, 0xb9, 0x7d, 0xde,
+	0x7b, 0xe4, 0x56, 0x92, 0x9d, 0xcb, 0x75, 0x1d, 0xb9, 0x96, 0xf4, 0x82, 0x91, 0x1e, 0x9d, 0x09,
+	0x06, 0x4b, 0xf1, 0xb6, 0xad, 0x9d, 0x35, 0xaa, 0x41, 0x6f, 0x97, 0xb8, 0x66, 0x55, 0x7b, 0x8a,
+	0xe4, 0x06, 0x92, 0x2c, 0xe0, 0xe1, 0x2c, 0x79, 0x34, 0x61, 0x31, 0x4a, 0xec, 0xe6, 0xca, 0xb3,
+	0x3c, 0xd4, 0x74, 0xb4, 0x66, 0xf1, 0x3e, 0x22, 0xbd, 0x34, 0x99, 0x26, 0x82, 0xfb, 0xaf, 0x6d,
+	0x5b, 0x2b, 0x7c, 0xac, 0x3a, 0xaa, 0x27, 0x48, 0x4b, 0x15, 0x0f, 0xac, 0xf4, 0x38, 0x7e, 0x21,
+	0x92, 0x34, 0xf9, 0x26, 0x84, 0xd0, 0xf1, 0x20, 0x12, 0xfe, 0xad, 0x6d, 0x6b, 0xc7, 0xa6, 0x0b,
+	0x78, 0x38, 0xd8, 0x63, 0xb9, 0xcc, 0xd7, 0x57, 0x1e, 0xec, 0x43, 0xb9, 0x46, 0x45, 0xec, 0x7d,
+	0x4a, 0x48, 0x16, 0x4e, 0x19, 0x2f, 0xc2, 0x88, 0x71, 0xdf, 0x5f, 0x69, 0x3d, 0xcf, 0x0c, 0x21,
+	0x6d, 0x30, 0x81, 0x3b, 0x8f, 0xd0, 0xc9, 0xf9, 0x6f, 0xa0, 0x5a, 0x28, 0xc8, 0xdb, 0x23, 0x03,
+	0xce, 0xa2, 0xaa, 0x4c, 0xc4, 0x99, 0xbf, 0xb5, 0x32, 0x67, 0xd4, 0x8a, 0xaa, 0xa8, 0xa9, 0xe1,
+	0xf3, 0x3e, 0x22, 0x7d, 0x8e, 0x31, 0x42, 0xec, 0xbf, 0xb9, 0xd2, 0x27, 0xc8, 0x48, 0x22, 0x7e,
+	0x91, 0x25, 0x82, 0x6a, 0x16, 0xb0, 0xd4, 0x92, 0x15, 0x61, 0xc9, 0x32, 0xc1, 0x62, 0xff, 0xf6,
+	0xb6, 0xb5, 0x33, 0xa0, 0x0d, 0x0c, 0x66, 0xa2, 0xe1, 0x98, 0xfb, 0xdf, 0xdb, 0xee, 0xec, 0x0c,
+	0x29, 0xb6, 0xbd, 0x7b, 0xa4, 0xc3, 0xb2, 0x99, 0x7f, 0x67, 0xbb, 0xb3, 0x42, 0x88, 0x9f, 0x65,
+	0xb3, 0x2f, 0xc3, 0x92, 0x02, 0x25, 0x28, 0x3e, 0xcb, 0x66, 0xcf, 0xcb, 0x2a, 0x8b, 0x42, 0x98,
+	0xe6, 0xfb, 0x38, 0x4d, 0x0b, 0x17, 0xfc, 0x93, 0x4d, 0xae, 0x2f, 0x24, 0x36, 0xda, 0xd5, 0x5a,
+	0xb5, 0xab, 0x6d, 0x78, 0x4e, 0xfb, 0x6a, 0x9e, 0xb3, 0x73, 0x49, 0xcf, 0xd9, 0x76, 0x66, 0xce,
+	0x82, 0x33, 0xdb, 0x22, 0x03, 0xf6, 0x32, 0x11, 0xd8, 0xdb, 0xc5, 0x5e, 0x03, 0xeb, 0xbe, 0xfd,
+	0x3c, 0xd6, 0xb9, 0x87, 0x81, 0x61, 0x5c, 0x68, 0x1f, 0x26, 0xe3, 0x2c, 0x4c, 0xd5, 0x95, 0xdd,
+	0xc0, 0xcc, 0x3b, 0x94, 0xc1, 0x82, 0x43, 0x09, 0xfe, 0xdb, 0x21, 0x6b, 0xcd, 0xeb, 0xd7, 0xfb,
+	0x50, 0xd5, 0x0d, 0x2c, 0x74, 0x7a, 0xbf, 0x7f, 0x81, 0x1b, 0xfb, 0xf9, 0x59, 0xc1, 0x64, 0x81,
+	0x01, 0x6e, 0x66, 0x91, 0x4c, 0x19, 0x17, 0xe1, 0xb4, 0x40, 0xd9, 0x76, 0x68, 0x8d, 0xd0, 0x27,
+	0xd1, 0xa9, 0x4f, 0xe2, 0x55, 0x72, 0x69, 0x9c, 0x54, 0xf7, 0x6a, 0x27, 0xd5, 0xbb, 0xe4, 0x49,
+	0xcd, 0x49, 0xac, 0xbf, 0xe8, 0x82, 0x1f, 0x91, 0xcd, 0xa2, 0x64, 0xb3, 0x24, 0xaf, 0xb8, 0x9a,
+	0xf5, 0x55, 0xd7, 0x9a, 0x5a, 0xdb, 0x3c, 0x9b, 0xf7, 0x90, 0xac, 0x69, 0x14, 0xac, 0xc0, 0x1f,
+	0x5e, 0x78, 0xad, 0x2d, 0x3e, 0x6f, 0x87, 0x6c, 0xe2, 0x99, 0x63, 0xe8, 0xf2, 0x79, 0x96, 0x9f,
+	0x66, 0x78, 0x21, 0x0e, 0xe8, 0x3c, 0xba, 0xa5, 0x4b, 0xa3, 0x95, 0xba, 0xb4, 0xb6, 0xa0, 0x4b,
+	0xb7, 0x48, 0xef, 0x9b, 0x7c, 0x7a, 0x94, 0x30, 0xbc, 0xd3, 0x06, 0x54, 0x41, 0x70, 0xe6, 0x79,
+	0x3e, 0xfd, 0x3c, 0x49, 0x53, 0x26, 0xaf, 0xac, 0x01, 0xad, 0x11, 0xc1, 0xb7, 0x16, 0xe9, 0xeb,
+	0xfd, 0x7a, 0xc4, 0x09, 0xcb, 0x31, 0xc4, 0x52, 0xe8, 0x08, 0xa0, 0x0d, 0x3a, 0x11, 0x9d, 0x4a,
+	0x9d, 0x18, 0x52, 0x68, 0x02, 0x55, 0x99, 0xe7, 0x32, 0x6f, 0x1f, 0x52, 0x6c, 0xc3, 0xdc, 0x79,
+	0xf6, 0x20, 0xe1, 0x27, 0xa8, 0x06, 0x03, 0xaa, 0x20, 0xa0, 0x2d, 0x40, 0xa5, 0xa4, 0x5d, 0x60,
+	0x1b, 0x68, 0x0b, 0xe9, 0x28, 0xa5, 0x3d, 0x28, 0x08, 0x66, 0x62, 0x2f, 0x99, 0xb2, 0x01, 0x68,
+	0x7a, 0xbf, 0x20, 0xa3, 0xe3, 0x24, 0x1b, 0xb3, 0xb2, 0x28, 0x93, 0x4c, 0x28, 0xf1, 0xff, 0x70,
+	0x99, 0x33, 0x7a, 0xc9, 0x1e, 0xd6, 0xc4, 0xb4, 0xc9, 0x19, 0xfc, 0xa3, 0x45, 0x36, 0xda, 0xfd,
+	0xb0, 0x0a, 0x3e, 0x09, 0xef, 0xff, 0xf4, 0x3d, 0x15, 0x14, 0x2b, 0x08, 0x2f, 0xcc, 0x2a, 0x49,
+	0xe3, 0xc7, 0xb1, 0x2e, 0xfd, 0x29, 0xd0, 0x7b, 0x9b, 0x6c, 0x14, 0x61, 0x74, 0x12, 0x8e, 0xd9,
+	0xd3, 0x30, 0x0b, 0xc7, 0xca, 0xcb, 0x0c, 0xe9, 0x1c, 0x16, 0x46, 0x50, 0x18, 0x25, 0x22, 0x0d,
+	0x36, 0x46, 0xf8, 0x92, 0x95, 0x3c, 0xc9, 0x33, 0xbf, 0xdb, 0x1a, 0x41, 0x61, 0x83, 0xff, 0xb2,
+	0xc8, 0xa8, 0xa1, 0x4d, 0x20, 0xc5, 0xac, 0x0e, 0xdf, 0xb1, 0x0d, 0xd2, 0xaa, 0xea, 0x00, 0xb5,
+	0x4a, 0x62, 0xc0, 0x8c, 0x6b, 0xeb, 0x1d, 0x27, 0x78, 0x52, 0x0c, 0x88, 0x54, 0x89, 0x91, 0x55,
+	0x0a, 0x07, 0x64, 0x5d, 0x85, 0x53, 0x74, 0xbc, 0xaa, 0x4f, 0x89, 0x2b, 0x3a, 0x0e, 0x74, 0x7d,
+	0x85, 0x03, 0xba, 0x9b, 0xa4, 0x7b, 0x8c, 0x84, 0x32, 0x95, 0x97, 0x80, 0xc4, 0x02, 0xe9, 0x50,
+	0x63, 0xc7, 0xf2, 0x94, 0xf1, 0x58, 0x65, 0xd2, 0xde, 0xa5, 0x0a, 0x0a, 0x7e, 0xd7, 0x25, 0xc3,
+	0x3a, 0x77, 0xf6, 0x1a, 0xce, 0x6c, 0xa8, 0x7c, 0xd4, 0x06, 0xb1, 0x13, 0x2d, 0x7c, 0x5b, 0xae,
+	0x04, 0x77, 0xdf, 0x69, 0xec, 0xfe, 0x26, 0xe9, 0x26, 0xd3, 0x5a, 0xc2, 0x12, 0x00, 0xeb, 0x89,
+	0x8a, 0x0a, 0xa3, 0x07, 0xdc, 0x9f, 0x4d, 0x0d, 0x0c, 0x7e, 0x43, 0x86, 0xba, 0xb2, 0xbb, 0x87,
+	0x51, 0x53, 0x13, 0xe5, 0x7d, 0xa8, 0xc3, 0xc9, 0x01, 0x7a, 0xd6, 0x1f, 0x5e, 0x24, 0x0f, 0x34,
+	0x01, 0xe5, 0xc7, 0x58, 0x75, 0x4e, 0xc5, 0x04, 0xa5, 0xb0, 0x71, 0xff, 0xed, 0x57, 0x71, 0x3f,
+	0x42, 0x6a, 0xaa, 0xb8, 0x40, 0x69, 0xa4, 0x5b, 0x8d, 0xd1, 0x35, 0x74, 0xa8, 0x06, 0xd1, 0xdc,
+	0x8e, 0x0a, 0x8e, 0xee, 0xc0, 0xa6, 0xd8, 0x06, 0xdc, 0x29, 0xe0, 0xd6, 0x24, 0x0e, 0xda, 0x3a,
+	0x87, 0x59, 0xaf, 0x73, 0x98, 0xdb, 0x64, 0x98, 0x31, 0x41, 0xa3, 0x59, 0x7c, 0xc0, 0xd1, 0xf0,
+	0x6d, 0x5a, 0x23, 0x54, 0xef, 0x21, 0xcb, 0xc4, 0x01, 0xf7, 0x37, 0x4d, 0xaf, 0x44, 0x80, 0xb3,
+	0x51, 0xa4, 0x7b, 0x85, 0x8c, 0x4c, 0x6d, 0xda, 0xc0, 0xa8, 0x7e, 0x20, 0xde, 0x2b, 0x64, 0x0c,
+	0x6a, 0xd3, 0x06, 0x06, 0xf6, 0x03, 0xee, 0x1a, 0x42, 0x35, 0x0f, 0x3b, 0x35, 0x08, 0xf3, 0xca,
+	0xc0, 0x04, 0xfa, 0x6e, 0xc8, 0x79, 0x0d, 0x02, 0x8e, 0x10, 0x73, 0xe4, 0x83, 0x48, 0x06, 0x9a,
+	0x36, 0x35, 0x30, 0xa8, 0xd4, 0x94, 0x4d, 0x29, 0x97, 0x51, 0xa4, 0x43, 0x15, 0x04, 0x3c, 0x53,
+	0x36, 0xdd, 0x0f, 0xa3, 0x09, 0xc3, 0xb8, 0xd0, 0xa1, 0x06, 0x36, 0x59, 0xdb, 0xeb, 0x97, 0x28,
+	0x32, 0x72, 0x11, 0x96, 0x70, 0x10, 0xbe, 0x3c, 0x08, 0x05, 0x36, 0x43, 0xe9, 0x37, 0xda, 0xa1,
+	0xb4, 0x0e, 0xa0, 0xb6, 0xea, 0x00, 0x2a, 0xf8, 0x9f, 0x81, 0xb1, 0x61, 0x8c, 0x30, 0x17, 0xa3,
+	0x9c, 0xf6, 0xdd, 0x6a, 0x2f, 0xdc, 0xad, 0x75, 0x36, 0xd7, 0xb9, 0x62, 0x36, 0xe7, 0x5c, 0x3c,
+	0x9b, 0x03, 0x23, 0x4b, 0x22, 0x5d, 0x10, 0xc2, 0x36, 0x6c, 0x58, 0x4c, 0x4a, 0x16, 0xc6, 0x5c,
+	0x79, 0x01, 0x0d, 0xce, 0xe7, 0x66, 0x83, 0xc5, 0xdc, 0x4c, 0x69, 0xe3, 0xb0, 0xd6, 0xc6, 0xb9,
+	0x8b, 0x9b, 0x2c, 0x5e, 0xdc, 0x4f, 0xe7, 0xea, 0x7d, 0xf2, 0x0a, 0xbc, 0xb0, 0x25, 0xce, 0x31,
+	0x7b, 0xbf, 0x20, 0x6b, 0x8a, 0xfe, 0xf0, 0xb2, 0x59, 0x62, 0x8b, 0xd1, 0x3b, 0x20, 0x9b, 0x51,
+	0xdb, 0x6c, 0xfd, 0xcd, 0x4b, 0x19, 0xf9, 0x3c, 0x3b, 0x54, 0x2f, 0x0c, 0x8a, 0x1e, 0x19, 0x03,
+	0x6b, 0x23, 0x5b, 0x54, 0x5f, 0x1d, 0x19, 0x33, 0x6b, 0x23, 0x17, 0x32, 0x4e, 0xef, 0x9c, 0x8c,
+	0xb3, 0x4e, 0x77, 0x6f, 0x5c, 0x26, 0xdd, 0xbd, 0x4b, 0x3c, 0x33, 0xcc, 0x33, 0xe3, 0x49, 0xa4,
+	0x59, 0x9e, 0xd3, 0x33, 0x4f, 0xaf, 0x7c, 0xcb, 0x6b, 0x8b, 0xf4, 0xb2, 0xc7, 0x7b, 0x97, 0xdc,
+	0x98, 0x1f, 0x05, 0xbc, 0x89, 0xcc, 0xed, 0xce, 0xeb, 0x9a, 0xe7, 0xd0, 0xfe, 0xe7, 0xf5, 0x45,
+	0x0e, 0xd5, 0xb5, 0x34, 0xd9, 0xf6, 0xaf, 0x94, 0x6c, 0xbf, 0x71, 0xd1, 0x64, 0x7b, 0xeb, 0xd5,
+	0xc9, 0xf6, 0x9b, 0x17, 0x49, 0xb6, 0x6f, 0x5f, 0x3a, 0xd9, 0x0e, 0xfe, 0x15, 0x1f, 0x11, 0x1b,
+	0xa6, 0xa0, 0x6e, 0x54, 0xcb, 0xdc, 0xa8, 0x0d, 0xe7, 0x6c, 0xaf, 0x70, 0xce, 0x9d, 0x55, 0xce,
+	0xd9, 0x99, 0x73, 0xce, 0xab, 0xee, 0xde, 0xda, 0x71, 0xf7, 0x96, 0x3a, 0xee, 0xfe, 0x9c, 0xe3,
+	0x96, 0x7d, 0x72, 0xbc, 0x81, 0xe9, 0x93, 0xe3, 0xe9, 0x2b, 0x71, 0x78, 0xce, 0x95, 0x48, 0x1a,
+	0x57, 0x62, 0xeb, 0x02, 0x1c, 0xad, 0xbc, 0x00, 0xd7, 0x56, 0x5f, 0x80, 0xeb, 0xaf, 0xb8, 0x00,
+	0x37, 0x16, 0x2e, 0x40, 0x13, 0x4d, 0x6c, 0xfe, 0x9f, 0xa2, 0x09, 0xf7, 0x4a, 0xd1, 0x84, 0xf2,
+	0xbe, 0xd7, 0x6b, 0xef, 0xdb, 0xb8, 0xd6, 0xbc, 0xa5, 0xd7, 0xda, 0x8d, 0x96, 0xd2, 0x42, 0x41,
+	0x99, 0xd4, 0x4f, 0x13, 0x20, 0xe1, 0xaa, 0x32, 0x7a, 0x84, 0x6d, 0xef, 0x1d, 0x62, 0xe7, 0xdc,
+	0xb7, 0x57, 0x3a, 0x95, 0x2f, 0x0e, 0x81, 0x9d, 0xda, 0x39, 0x18, 0xa3, 0x13, 0xc9, 0x5a, 0x79,
+	0x67, 0xf5, 0xc5, 0x84, 0x1c, 0x48, 0x3b, 0x5f, 0x48, 0xef, 0x2e, 0x14, 0xd2, 0x83, 0x5f, 0x59,
+	0xa4, 0xf7, 0xc5, 0xa1, 0x5e, 0xe3, 0x42, 0xa4, 0xbc, 0x45, 0x06, 0x45, 0x1a, 0x8a, 0xe3, 0xbc,
+	0x9c, 0xea, 0xca, 0xb2, 0x86, 0x41, 0x33, 0x8f, 0xc3, 0x69, 0x92, 0x9e, 0xa9, 0xe8, 0x52, 0x41,
+	0x20, 0x94, 0x99, 0x0a, 0xd1, 0x55, 0x0c, 0xaf, 0x40, 0x70, 0xca, 0x27, 0xac, 0xcc, 0x58, 0xda,
+	0x0e, 0xe1, 0xdb, 0x48, 0x5c, 0x92, 0x74, 0xa6, 0x30, 0x3d, 0x5c, 0x9a, 0x34, 0x14, 0x72, 0x59,
+	0x36, 0x35, 0x30, 0xa8, 0xe0, 0x69, 0x99, 0x08, 0x86, 0x9d, 0xd2, 0x14, 0x6b, 0x04, 0x4c, 0x05,
+	0x94, 0xe0, 0x17, 0x38, 0x52, 0x48, 0x83, 0x6c, 0x23, 0x21, 0xa9, 0x40, 0x96, 0x9a, 0x4c, 0x9a,
+	0xe6, 0x1c, 0x36, 0xf8, 0x0b, 0x87, 0x90, 0xfa, 0x29, 0xf6, 0x9c, 0x78, 0xe4, 0x47, 0xa4, 0x9b,
+	0x86, 0x71, 0xac, 0xcb, 0xce, 0xcb, 0x62, 0xa5, 0x4f, 0xe3, 0xb8, 0xa4, 0x92, 0x12, 0x58, 0x4a,
+	0x64, 0xe9, 0x5d, 0x80, 0x05, 0x29, 0x61, 0xcb, 0xa0, 0x5f, 0x1c, 0xec, 0x04, 0x0d, 0xdb, 0xa6,
+	0x35, 0x02, 0xb6, 0x8c, 0x00, 0x65, 0x51, 0xc2, 0x66, 0x2c, 0x56, 0x26, 0xde, 0x46, 0x7a, 0x3f,
+	0x37, 0xa7, 0x46, 0x56, 0x16, 0x41, 0xea, 0xed, 0x3e, 0x44, 0x72, 0x73, 0xbc, 0x1f, 0xa8, 0xb4,
+	0xe3, 0x95, 0xf1, 0x85, 0x62, 0x6f, 0x54, 0x50, 0xde, 0x22, 0xeb, 0x45, 0x12, 0xef, 0xd7, 0x81,
+	0xdb, 0x1a, 0x2a, 0x64, 0x1b, 0xe9, 0x7d, 0x48, 0x06, 0x22, 0x2a, 0x64, 0xdc, 0xb1, 0x8e, 0x93,
+	0x2c, 0x7b, 0x3e, 0x7e, 0xbe, 0x7f, 0x20, 0x4d, 0xdf, 0x30, 0x80, 0x6b, 0x39, 0x2e, 0xf3, 0x29,
+	0x44, 0x24, 0xc7, 0x5c, 0x65, 0xec, 0x0d, 0x4c, 0x63, 0x70, 0x19, 0xb8, 0x8f, 0x5e, 0x35, 0x38,
+	0x37, 0x83, 0x73, 0x48, 0x85, 0x07, 0x1a, 0x0d, 0x4a, 0x50, 0x0a, 0xa1, 0xdf, 0x3d, 0x4a, 0x81,
+	0xae, 0xba, 0x14, 0xe2, 0xcb, 0xb0, 0x44, 0x75, 0x5c, 0xa7, 0x0a, 0x02, 0x2b, 0x2c, 0x99, 0x28,
+	0xc3, 0x8c, 0x63, 0x19, 0xb7, 0x83, 0x9d, 0x4d, 0x14, 0x7a, 0x98, 0x2c, 0xde, 0x3f, 0x55, 0xcf,
+	0x1d, 0xeb, 0x54, 0x83, 0x70, 0xe4, 0x25, 0x8b, 0x66, 0x7f, 0x5c, 0xb1, 0x4a, 0x06, 0x98, 0xeb,
+	0xb4, 0x46, 0x40, 0x2f, 0x67, 0x59, 0x2c, 0x7b, 0x7b, 0xb2, 0xd7, 0x20, 0x82, 0xdf, 0xda, 0x64,
+	0xa0, 0x5f, 0xd8, 0xcf, 0xd1, 0xd9, 0x85, 0xd3, 0xb0, 0xcf, 0x3b, 0x8d, 0x7d, 0x32, 0xc0, 0x8f,
+	0x85, 0xa2, 0x3c, 0xf5, 0x3b, 0x2b, 0x35, 0x46, 0x4f, 0x75, 0xa0, 0xc8, 0xa9, 0x61, 0x6c, 0x28,
+	0x9d, 0x73, 0x35, 0xa5, 0xbb, 0x47, 0x9c, 0x8b, 0x9a, 0x17, 0x12, 0x62, 0xf1, 0x24, 0x14, 0x13,
+	0x14, 0xca, 0x90, 0x62, 0x1b, 0x13, 0xdf, 0x2c, 0x8f, 0xf5, 0x7d, 0x29, 0x01, 0x88, 0x01, 0x33,
+	0x26, 0x4c, 0x61, 0x5a, 0x5d, 0x98, 0x2d, 0x1c, 0xf8, 0x21, 0x3e, 0x09, 0x4b, 0x16, 0xef, 0x9d,
+	0xa9, 0x4c, 0xdd, 0xc0, 0xc1, 0x9f, 0x12, 0x07, 0xe6, 0x35, 0xd9, 0x92, 0x75, 0xd1, 0x6c, 0x09,
+	0x22, 0x8b, 0xc2, 0xe4, 0xea, 0x05, 0x2e, 0x39, 0x2f, 0x85, 0x2a, 0x42, 0x60, 0x3b, 0xf8, 0x7b,
+	0x9b, 0x90, 0x3a, 0x47, 0x41, 0x9d, 0xe3, 0xf2, 0xbd, 0xce, 0xa1, 0xd0, 0x04, 0xcc, 0x6c, 0x2a,
+	0x6f, 0x11, 0x87, 0x42, 0x13, 0x86, 0xe1, 0xa7, 0x61, 0x81, 0xc3, 0x38, 0x14, 0xdb, 0xaa, 0x60,
+	0x53, 0x32, 0xa9, 0x5e, 0x0e, 0x55, 0x10, 0xd0, 0x0a, 0xf6, 0x52, 0x06, 0x1d, 0x0e, 0xc5, 0x36,
+	0x8c, 0x98, 0x26, 0x47, 0x2a, 0xda, 0x80, 0x26, 0x50, 0xc1, 0x66, 0x94, 0xd8, 0xb0, 0x0d, 0xb2,
+	0x8c, 0x93, 0x52, 0x9c, 0x29, 0x71, 0x49, 0x00, 0x95, 0x8c, 0xcb, 0xd8, 0xc2, 0xa1, 0xd0, 0x04,
+	0x4c, 0xc5, 0x65, 0x64, 0xe1, 0x50, 0x68, 0xa2, 0xae, 0x9f, 0x86, 0xc5, 0x01, 0x97, 0x61, 0x85,
+	0x43, 0x35, 0x08, 0xda, 0x1c, 0x66, 0x79, 0x76, 0x36, 0xcd, 0x2b, 0x19, 0x54, 0x38, 0xb4, 0x46,
+	0xa0, 0x65, 0x27, 0x29, 0xdb, 0x0b, 0xa3, 0x13, 0x16, 0xa3, 0x63, 0x70, 0x68, 0x03, 0x13, 0xfc,
+	0x83, 0x4d, 0x7a, 0xf2, 0x65, 0x02, 0xab, 0x2a, 0x49, 0xca, 0xf4, 0xc3, 0xa6, 0x04, 0x70, 0xe2,
+	0x3c, 0x3a, 0x61, 0x82, 0xab, 0xca, 0x8f, 0x06, 0x81, 0xbe, 0x48, 0x0a, 0xa6, 0xdf, 0xa1, 0x25,
+	0x00, 0x87, 0x8e, 0xaf, 0xb5, 0xc7, 0x31, 0x57, 0x55, 0x20, 0x03, 0x83, 0x40, 0x59, 0x91, 0xa7,
+	0x29, 0xd7, 0xef, 0xcf, 0x12, 0x82, 0x45, 0xc2, 0x8a, 0x1f, 0x83, 0x66, 0x71, 0x55, 0x13, 0x6a,
+	0x60, 0x60, 0x0d, 0x31, 0x9b, 0x25, 0x11, 0x33, 0x69, 0xa1, 0x02, 0x61, 0x0d, 0xb9, 0x98, 0xb0,
+	0x52, 0xd7, 0x87, 0x10, 0x00, 0x91, 0x08, 0xf3, 0x2c, 0x30, 0x94, 0xf5, 0x47, 0x83, 0xf0, 0x3e,
+	0x80, 0x98, 0xb2, 0x38, 0x08, 0xc5, 0x44, 0x7f, 0xde, 0xb1, 0xfc, 0xc9, 0x06, 0xa8, 0xa8, 0x21,
+	0x0f, 0xee, 0x83, 0xb0, 0xa0, 0x69, 0x2c, 0xc5, 0x6a, 0x5b, 0x4a, 0x84, 0xd9, 0xa9, 0x14, 0x94,
+	0x04, 0x80, 0x47, 0xbe, 0x5a, 0x9c, 0x1b, 0x2a, 0xdc, 0x24, 0xdd, 0x59, 0x98, 0x56, 0xfa, 0x05,
+	0x5a, 0x02, 0xc1, 0x53, 0x32, 0x6a, 0xbc, 0xab, 0x00, 0x63, 0x95, 0x25, 0x42, 0x33, 0x42, 0x1b,
+	0x18, 0x79, 0x9a, 0x44, 0x86, 0x11, 0x01, 0xc4, 0x46, 0x79, 0xa1, 0x4b, 0x57, 0x12, 0x08, 0x7e,
+	0x6d, 0x93, 0xcd, 0xb9, 0xa7, 0x1e, 0x4c, 0xe2, 0xc2, 0xe2, 0xb3, 0xe3, 0x63, 0x86, 0xef, 0xd7,
+	0xca, 0x3a, 0x5a, 0x38, 0x45, 0x73, 0xc0, 0xca, 0x69, 0x22, 0x40, 0x94, 0xb6, 0xa1, 0x31, 0x38,
+	0x4c, 0xb2, 0xc3, 0x62, 0x2f, 0xaf, 0xb2, 0x38, 0xc9, 0xc6, 0xca, 0x7e, 0x9a, 0x28, 0xf0, 0x98,
+	0x4c, 0x0f, 0xb9, 0x1f, 0x16, 0xa0, 0x16, 0x50, 0xb4, 0x68, 0x23, 0xf1, 0xc1, 0x89, 0x45, 0x51,
+	0x3e, 0x2d, 0x50, 0x39, 0x36, 0x96, 0x3f, 0x38, 0x49, 0xaa, 0xa7, 0x79, 0xcc, 0xa8, 0x66, 0xc1,
+	0xd8, 0x38, 0x7f, 0xc6, 0x4e, 0x0f, 0xca, 0x64, 0x26, 0x35, 0x68, 0x40, 0x1b, 0x18, 0xd0, 0xca,
+	0x94, 0x4f, 0x9f, 0x84, 0x47, 0x2c, 0x55, 0x05, 0x7c, 0x03, 0x07, 0x7f, 0x69, 0x11, 0x52, 0xbf,
+	0xb0, 0x35, 0x5d, 0xbe, 0x23, 0x5d, 0xbe, 0x4b, 0x3a, 0x19, 0x13, 0xda, 0x5b, 0x64, 0x0c, 0xad,
+	0x7d, 0x9a, 0x09, 0xb5, 0x59, 0x68, 0xe2, 0x11, 0x71, 0x56, 0x2a, 0x4f, 0x81, 0x6d, 0xb4, 0x62,
+	0xc1, 0x95, 0x9b, 0x80, 0x26, 0x60, 0x92, 0x22, 0xd2, 0x5e, 0x22, 0x29, 0xa2, 0xc6, 0x1b, 0x9e,
+	0xf4, 0x13, 0x0a, 0x0a, 0xfe, 0x90, 0xac, 0x53, 0xc6, 0xf3, 0xaa, 0x8c, 0x98, 0xc9, 0x40, 0x78,
+	0x7e, 0x2c, 0xd4, 0xba, 0xb0, 0x0d, 0xb8, 0x49, 0x58, 0xea, 0x73, 0xc1, 0x76, 0xf0, 0x37, 0x36,
+	0x59, 0x6f, 0x3d, 0x6a, 0x7a, 0x7b, 0x64, 0x88, 0x75, 0x12, 0x63, 0xdb, 0xcb, 0x5f, 0x43, 0x5b,
+	0x53, 0xd2, 0x9a, 0x0d, 0xc6, 0xa8, 0x3f, 0xfc, 0xb4, 0x2f, 0x33, 0x86, 0x61, 0xf3, 0x1e, 0x91,
+	0xb5, 0x14, 0x5c, 0x47, 0xfc, 0xb4, 0x59, 0x63, 0xba, 0xd8, 0x30, 0x2d, 0x4e, 0xf8, 0xb0, 0x2a,
+	0xca, 0x4b, 0x66, 0x3e, 0xf6, 0xb8, 0xe8, 0x28, 0x86, 0x2b, 0xf8, 0x67, 0x9b, 0x0c, 0x4d, 0x2a,
+	0x0b, 0xfe, 0xa5, 0xac, 0x32, 0xbc, 0xcd, 0xa5, 0x78, 0x35, 0x08, 0x16, 0x50, 0x56, 0x19, 0x06,
+	0x06, 0x5f, 0x85, 0x89, 0xd6, 0x81, 0x16, 0x0e, 0x74, 0x0f, 0x1f, 0xb4, 0x52, 0x74, 0x50, 0x52,
+	0x27, 0x1a, 0x18, 0x78, 0x8b, 0x69, 0xd2, 0xd7, 0xa9, 0xec, 0x3c, 0x1a, 0xfc, 0x56, 0xcc, 0xd2,
+	0xf0, 0xec, 0xd3, 0x28, 0x12, 0xea, 0x59, 0xa3, 0x46, 0xc0, 0x3c, 0x47, 0xe9, 0x49, 0x92, 0x3f,
+	0x00, 0x8c, 0xd2, 0xa1, 0x06, 0x06, 0x2c, 0x11, 0xee, 0x84, 0x24, 0x93, 0x04, 0x52, 0x9f, 0x9a,
+	0x28, 0x8c, 0x75, 0x0d, 0x3d, 0xac, 0x63, 0xa0, 0x62, 0xdd, 0x26, 0x12, 0xc2, 0xfb, 0x06, 0x13,
+	0x90, 0xc9, 0x90, 0x78, 0x0e, 0x1b, 0xfc, 0xb5, 0x4d, 0xfa, 0xaa, 0xa2, 0x07, 0x12, 0x4c, 0x43,
+	0xfc, 0x68, 0x4d, 0x39, 0x29, 0x0d, 0xb6, 0x32, 0x78, 0x7b, 0x2e, 0x83, 0x6f, 0x54, 0x05, 0x3a,
+	0x2b, 0xaa, 0x02, 0xce, 0x7c, 0x55, 0x00, 0xac, 0xbd, 0x9a, 0x3e, 0x57, 0x95, 0x42, 0x79, 0x97,
+	0x34, 0x30, 0xde, 0xfb, 0x2a, 0xe9, 0xeb, 0x5d, 0xe2, 0xa3, 0x3b, 0xe4, 0x30, 0x45, 0xc9, 0x7e,
+	0xa3, 0x28, 0xb9, 0x45, 0x06, 0xb0, 0x2c, 0x54, 0x8f, 0x81, 0x7c, 0x89, 0xd5, 0x30, 0xac, 0x44,
+	0x2e, 0xab, 0xf9, 0x49, 0x4a, 0x8d, 0x09, 0x7e, 0x4e, 0xd6, 0x5b, 0xd3, 0x2c, 0x4b, 0x17, 0x97,
+	0x89, 0x28, 0xf8, 0x9d, 0x85, 0x42, 0xc6, 0x54, 0xf3, 0x16, 0xe9, 0x65, 0xd5, 0xf4, 0x48, 0x7d,
+	0x67, 0xde, 0xa5, 0x0a, 0x02, 0xfc, 0x8c, 0x65, 0x71, 0x5e, 0xaa, 0xbb, 0x40, 0x41, 0x4b, 0x53,
+	0xcd, 0x9b, 0xa4, 0x3b, 0xcd, 0x63, 0x96, 0xea, 0xa7, 0x0c, 0x04, 0x60, 0x2b, 0xc5, 0xe4, 0x8c,
+	0x27, 0x51, 0x98, 0xaa, 0x0f, 0xc4, 0x86, 0xb4, 0x81, 0x41, 0x4f, 0x95, 0x97, 0x4c, 0x7d, 0x23,
+	0x36, 0xa4, 0x0a, 0x92, 0xb7, 0x5e, 0x69, 0xae, 0x66, 0x09, 0xa0, 0x87, 0x9c, 0x7c, 0xa3, 0xe4,
+	0x05, 0x4d, 0x38, 0xd2, 0x08, 0xea, 0x2c, 0x68, 0xb5, 0x32, 0x1c, 0xac, 0x11, 0xc1, 0x7f, 0x58,
+	0xc4, 0x79, 0xa4, 0xe3, 0x3b, 0x1d, 0x70, 0xdb, 0x49, 0xe3, 0xe3, 0x50, 0xbb, 0xf9, 0x71, 0xe8,
+	0x79, 0x2f, 0x34, 0x3f, 0x56, 0x35, 0x71, 0x67, 0xe5, 0xc7, 0xb3, 0x30, 0xc9, 0xf3, 0x70, 0xcc,
+	0xd5, 0x57, 0x07, 0x3e, 0xe9, 0x87, 0x69, 0x0a, 0x08, 0xd4, 0x96, 0x21, 0xd5, 0x60, 0xf3, 0x43,
+	0xbb, 0xfe, 0xca, 0x0f, 0xed, 0x06, 0x8b, 0xf5, 0x81, 0x8f, 0xc9, 0x40, 0xcf, 0x83, 0x2a, 0x82,
+	0x3e, 0xe8, 0xb9, 0x7e, 0x76, 0x5a, 0xa7, 0x0d, 0x8c, 0x29, 0xe5, 0xdb, 0x75, 0x29, 0x7f, 0xf7,
+	0x90, 0xb8, 0xf3, 0xcf, 0xe9, 0x9e, 0x4b, 0xd6, 0xaa, 0xec, 0x04, 0xde, 0x6c, 0x11, 0xe7, 0x5e,
+	0xf3, 0x86, 0x58, 0xf0, 0x29, 0x85, 0x6b, 0x79, 0x03, 0xe2, 0xc0, 0xbb, 0xac, 0x6b, 0xcb, 0x16,
+	0x8b, 0xdc, 0x8e, 0xb7, 0x41, 0x08, 0xe8, 0xe9, 0xfe, 0x24, 0xcc, 0xc6, 0xcc, 0x75, 0x76, 0x13,
+	0xb2, 0xd1, 0xae, 0xfd, 0x78, 0x23, 0xd2, 0x57, 0x43, 0xba, 0xd7, 0x00, 0x50, 0x0f, 0x40, 0xae,
+	0x05, 0xbc, 0x25, 0xc3, 0xc1, 0x93, 0x6c, 0xec, 0xda, 0xd0, 0x59, 0x56, 0x59, 0x06, 0x40, 0xc7,
+	0x23, 0xa4, 0x57, 0x84, 0x15, 0x67, 0xb1, 0xeb, 0x40, 0x1b, 0x26, 0x66, 0xb1, 0xdb, 0x85, 0xa9,
+	0x63, 0x16, 0xc6, 0x6e, 0x6f, 0xf7, 0x19, 0xd9, 0x34, 0x53, 0xa9, 0x02, 0xf4, 0x75, 0xb2, 0xae,
+	0xe6, 0x92, 0x08, 0xf7, 0x9a, 0xb7, 0x46, 0x06, 0x66, 0x0a, 0x0b, 0xa6, 0x90, 0xb5, 0xa4, 0x33,
+	0xd7, 0xf6, 0xd6, 0xc9, 0xb0, 0xca, 0x34, 0xd8, 0xd9, 0x7d, 0x68, 0xbe, 0x48, 0x90, 0x0b, 0xef,
+	0x12, 0xeb, 0x85, 0x7b, 0x0d, 0x7e, 0x1e, 0xb8, 0x16, 0xfc, 0x50, 0xd7, 0x86, 0x9f, 0x43, 0xb7,
+	0x03, 0x3f, 0xcf, 0x5d, 0x07, 0x7e, 0xbe, 0x72, 0xbb, 0xf0, 0xf3, 0x27, 0x6e, 0x0f, 0x7e, 0xbe,
+	0x76, 0xfb, 0xbb, 0x01, 0xd9, 0xa8, 0x93, 0x25, 0x94, 0x6a, 0x9f, 0x74, 0x44, 0x54, 0xb8, 0xd7,
+	0xa0, 0x51, 0xc5, 0x85, 0x6b, 0xed, 0x06, 0xc4, 0x9d, 0x4f, 0xa8, 0xbc, 0x1e, 0xb1, 0x67, 0x3f,
+	0x71, 0xaf, 0xe1, 0xef, 0x7b, 0xae, 0xb5, 0xfb, 0x2f, 0x75, 0x4a, 0xcb, 0xbc, 0x1b, 0x64, 0x53,
+	0x27, 0xd2, 0x2f, 0x8c, 0x34, 0x37, 0xc9, 0x08, 0xe4, 0x77, 0x94, 0x26, 0x7c, 0x82, 0x12, 0x1d,
+	0xc1, 0x07, 0x35, 0x19, 0x94, 0x1c, 0xa4, 0x38, 0xf9, 0x59, 0x46, 0x59, 0x34, 0x73, 0x3b, 0x20,
+	0x86, 0xe3, 0x24, 0x83, 0x3b, 0xe0, 0x47, 0xae, 0xd3, 0x80, 0xee, 0xbb, 0x5d, 0x80, 0xe0, 0x26,
+	0x01, 0xd0, 0xed, 0xc1, 0x81, 0x47, 0x69, 0xce, 0x99, 0xdb, 0x07, 0x01, 0x61, 0x13, 0x7b, 0x06,
+	0x30, 0x20, 0x38, 0xdc, 0x4f, 0xa3, 0x13, 0x77, 0x08, 0x67, 0x22, 0x3f, 0x0c, 0x77, 0x09, 0x9e,
+	0x6a, 0x9a, 0x73, 0x10, 0xf1, 0x08, 0x4e, 0x35, 0x63, 0xa7, 0x87, 0x6a, 0xe6, 0xb5, 0xdd, 0x4f,
+	0x88, 0x3b, 0x9f, 0x7e, 0xc2, 0xc0, 0x92, 0xf9, 0x39, 0x8a, 0xc5, 0x80, 0x2f, 0x40, 0x38, 0x30,
+	0x82, 0x02, 0xb3, 0xe4, 0xa5, 0x6b, 0xef, 0x3e, 0x22, 0xa3, 0x46, 0x3c, 0x06, 0xa2, 0x50, 0x11,
+	0xd9, 0x83, 0x84, 0x87, 0x47, 0x29, 0x8b, 0xdd, 0x6b, 0x70, 0xf2, 0x0a, 0x79, 0x28, 0xca, 0x24,
+	0x02, 0x75, 0xad, 0x51, 0x0f, 0x93, 0x54, 0xb0, 0xd2, 0xb5, 0xf7, 0x3e, 0xf9, 0xb7, 0x6f, 0xef,
+	0x58, 0xff, 0xf9, 0xed, 0x1d, 0xeb, 0x37, 0xdf, 0xde, 0xb1, 0x7e, 0xf5, 0xdb, 0x3b, 0xd7, 0xbe,
+	0xbe, 0x7b, 0xce, 0xbf, 0x73, 0x94, 0x4d, 0xbf, 0xa3, 0x6c, 0xfa, 0x1d, 0xb4, 0xe9, 0x7b, 0xe8,
+	0xc0, 0x8e, 0x7a, 0x98, 0x38, 0xff, 0xf8, 0x7f, 0x07, 0x00, 0x03, 0x27, 0x74, 0x45, 0xfa, 0x33,
+	0x00, 0x00,
 }
@@ -81,6 +81,8 @@ const (
 	TypeCollectorRealTime          = 27
 	TypeCollectorContainer         = 39
 	TypeCollectorContainerRealTime = 40
+	TypeCollectorProcEvent         = 41
+	TypeCollectorListeners         = 42
 )
 
 // Message is a generic type for all messages with a Header and Body.
@@ -119,6 +121,10 @@ func DecodeMessage(data []byte) (Message, error) {
 		m = &CollectorContainer{}
 	case TypeCollectorContainerRealTime:
 		m = &CollectorContainerRealTime{}
+	case TypeCollectorProcEvent:
+		m = &CollectorProcEvent{}
+	case TypeCollectorListeners:
+		m = &CollectorListeners{}
 	default:
 		return Message{}, fmt.Errorf("unhandled message type: %d", header.Type)
 	}
@@ -144,6 +150,10 @@ func DetectMessageType(b MessageBody) (MessageType, error) {
 		t = TypeCollectorContainer
 	case *CollectorContainerRealTime:
 		t = TypeCollectorContainerRealTime
+	case *CollectorProcEvent:
+		t = TypeCollectorProcEvent
+	case *CollectorListeners:
+		t = TypeCollectorListeners
 	default:
 		return 0, fmt.Errorf("unknown message body type: %s", reflect.TypeOf(b))
 	}
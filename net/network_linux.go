@@ -107,6 +107,12 @@ func ShouldLogTracerUtilError() bool {
 	return status == retry.OK || !logged
 }
 
+// IsRemoteNetworkTracerPermaFail returns whether the remote network tracer
+// could not be reached after all the retries, and won't be retried.
+func IsRemoteNetworkTracerPermaFail() bool {
+	return globalUtil != nil && globalUtil.initRetry.RetryStatus() == retry.PermaFail
+}
+
 func newNetworkTracer() *RemoteNetTracerUtil {
 	return &RemoteNetTracerUtil{
 		socketPath: globalSocketPath,
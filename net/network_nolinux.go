@@ -24,6 +24,11 @@ func (r *RemoteNetTracerUtil) GetConnections() ([]tracer.ConnectionStats, error)
 	return nil, tracer.ErrNotImplemented
 }
 
+// IsRemoteNetworkTracerPermaFail is only implemented on linux
+func IsRemoteNetworkTracerPermaFail() bool {
+	return false
+}
+
 // ShouldLogTracerUtilError is only implemented on linux
 func ShouldLogTracerUtilError() bool {
 	return false
@@ -0,0 +1,147 @@
+package sink
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const (
+	defaultFileMaxSize  = 100 * 1024 * 1024
+	defaultFileMaxFiles = 5
+)
+
+// FileSink writes messages as newline-delimited JSON to a file. Once the file
+// grows past maxSize it is rotated to <path>.1, <path>.1 to <path>.2 and so on,
+// keeping at most maxFiles rotated files.
+type FileSink struct {
+	sync.Mutex
+
+	path     string
+	maxSize  int64
+	maxFiles int
+	hostname string
+
+	f    *os.File
+	size int64
+}
+
+// NewFileSink opens (or creates) the file at path and returns a sink appending to it.
+func NewFileSink(path string, maxSize int64, maxFiles int, hostname string) (*FileSink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("file sink requires a path")
+	}
+	if maxSize <= 0 {
+		maxSize = defaultFileMaxSize
+	}
+	if maxFiles <= 0 {
+		maxFiles = defaultFileMaxFiles
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	s := &FileSink{path: path, maxSize: maxSize, maxFiles: maxFiles, hostname: hostname}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Name returns the name of the sink.
+func (s *FileSink) Name() string {
+	return "file:" + s.path
+}
+
+// Send appends the messages to the file, rotating it first if it is full.
+func (s *FileSink) Send(msgs []model.MessageBody) error {
+	buf, err := marshalLines(msgs, s.hostname, time.Now())
+	if err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if s.f == nil {
+		// A previous rotation failed to reopen the file.
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+	if s.size > 0 && s.size+int64(len(buf)) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := s.f.Write(buf)
+	s.size += int64(n)
+	return err
+}
+
+// Close closes the underlying file.
+func (s *FileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
+
+// open opens the file, readable by its owner only since command lines and
+// environment variables end up in it.
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.f, s.size = f, size
+	return nil
+}
+
+// rotate shifts the rotated files by one, dropping the oldest, and starts a
+// new file. If the files can't be shifted the current file is reopened so
+// that the sink keeps working, and rotation is tried again on the next Send.
+// The caller must hold the lock.
+func (s *FileSink) rotate() error {
+	err := s.f.Close()
+	s.f = nil
+	if err != nil {
+		return err
+	}
+	if err := s.shift(); err != nil {
+		if err := s.open(); err != nil {
+			return err
+		}
+		return err
+	}
+	return s.open()
+}
+
+func (s *FileSink) shift() error {
+	os.Remove(s.rotatedPath(s.maxFiles))
+	for i := s.maxFiles - 1; i >= 1; i-- {
+		if err := os.Rename(s.rotatedPath(i), s.rotatedPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(s.path, s.rotatedPath(1)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *FileSink) rotatedPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
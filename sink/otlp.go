@@ -0,0 +1,469 @@
+package sink
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const (
+	defaultOTLPURL  = "http://localhost:4318/v1/metrics"
+	otlpScopeName   = "datadog-process-agent"
+	otlpCumulative  = 2 // AGGREGATION_TEMPORALITY_CUMULATIVE
+	otlpContentType = "application/json"
+)
+
+// The types below are the OTLP/HTTP JSON encoding of the metrics protocol
+// (opentelemetry/proto/collector/metrics/v1), limited to what we export.
+
+type otlpRequest struct {
+	ResourceMetrics []otlpResourceMetrics `json:"resourceMetrics"`
+}
+
+type otlpResourceMetrics struct {
+	Resource     otlpResource       `json:"resource"`
+	ScopeMetrics []otlpScopeMetrics `json:"scopeMetrics"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeMetrics struct {
+	Scope   otlpScope    `json:"scope"`
+	Metrics []otlpMetric `json:"metrics"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpMetric struct {
+	Name  string     `json:"name"`
+	Unit  string     `json:"unit,omitempty"`
+	Gauge *otlpGauge `json:"gauge,omitempty"`
+	Sum   *otlpSum   `json:"sum,omitempty"`
+}
+
+type otlpGauge struct {
+	DataPoints []otlpDataPoint `json:"dataPoints"`
+}
+
+type otlpSum struct {
+	DataPoints             []otlpDataPoint `json:"dataPoints"`
+	AggregationTemporality int             `json:"aggregationTemporality"`
+	IsMonotonic            bool            `json:"isMonotonic"`
+}
+
+type otlpDataPoint struct {
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string         `json:"timeUnixNano"`
+	AsDouble          *float64       `json:"asDouble,omitempty"`
+	AsInt             *string        `json:"asInt,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+func otlpString(k, v string) otlpKeyValue {
+	return otlpKeyValue{Key: k, Value: otlpAnyValue{StringValue: &v}}
+}
+
+func otlpInt(k string, v int64) otlpKeyValue {
+	s := strconv.FormatInt(v, 10)
+	return otlpKeyValue{Key: k, Value: otlpAnyValue{IntValue: &s}}
+}
+
+func otlpStrings(k string, vs []string) otlpKeyValue {
+	values := make([]otlpAnyValue, 0, len(vs))
+	for i := range vs {
+		values = append(values, otlpAnyValue{StringValue: &vs[i]})
+	}
+	return otlpKeyValue{Key: k, Value: otlpAnyValue{ArrayValue: &otlpArrayValue{Values: values}}}
+}
+
+func otlpNanos(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// otlpMetricsBuilder collects the data points of a single resource, grouped
+// in one metric per name.
+type otlpMetricsBuilder struct {
+	now     string
+	metrics []otlpMetric
+	byName  map[string]int
+}
+
+func newOTLPMetricsBuilder(now time.Time) *otlpMetricsBuilder {
+	return &otlpMetricsBuilder{now: otlpNanos(now), byName: make(map[string]int)}
+}
+
+// metric returns the metric with the given name, adding it if it's not there yet.
+func (b *otlpMetricsBuilder) metric(name, unit string) *otlpMetric {
+	i, ok := b.byName[name]
+	if !ok {
+		i = len(b.metrics)
+		b.byName[name] = i
+		b.metrics = append(b.metrics, otlpMetric{Name: name, Unit: unit})
+	}
+	return &b.metrics[i]
+}
+
+func (b *otlpMetricsBuilder) gauge(name, unit string, v float64, attrs ...otlpKeyValue) {
+	m := b.metric(name, unit)
+	if m.Gauge == nil {
+		m.Gauge = &otlpGauge{}
+	}
+	m.Gauge.DataPoints = append(m.Gauge.DataPoints, otlpDataPoint{Attributes: attrs, TimeUnixNano: b.now, AsDouble: &v})
+}
+
+func (b *otlpMetricsBuilder) sum(name, unit string, monotonic bool, start string, points ...otlpDataPoint) {
+	for i := range points {
+		points[i].StartTimeUnixNano = start
+		points[i].TimeUnixNano = b.now
+	}
+	m := b.metric(name, unit)
+	if m.Sum == nil {
+		m.Sum = &otlpSum{AggregationTemporality: otlpCumulative, IsMonotonic: monotonic}
+	}
+	m.Sum.DataPoints = append(m.Sum.DataPoints, points...)
+}
+
+func otlpIntPoint(v int64, attrs ...otlpKeyValue) otlpDataPoint {
+	s := strconv.FormatInt(v, 10)
+	return otlpDataPoint{Attributes: attrs, AsInt: &s}
+}
+
+func otlpDoublePoint(v float64, attrs ...otlpKeyValue) otlpDataPoint {
+	return otlpDataPoint{Attributes: attrs, AsDouble: &v}
+}
+
+// OTLPSink converts process and container data into OTLP metrics following
+// the process.* and container.* semantic conventions, and pushes them to an
+// OpenTelemetry collector over OTLP/HTTP with the JSON encoding.
+//
+// Each process and container is its own resource. Real-time results only
+// carry a pid or container id, so the other resource attributes are taken
+// from the last full check results.
+type OTLPSink struct {
+	sync.Mutex
+
+	url      string
+	headers  map[string]string
+	hostname string
+	client   http.Client
+
+	processes  map[int32][]otlpKeyValue
+	containers map[string][]otlpKeyValue
+	// Number of CPUs of the host, from the last message that carried it.
+	numCPUs int
+}
+
+// NewOTLPSink returns a sink pushing to the OTLP/HTTP metrics endpoint at url.
+func NewOTLPSink(url string, headers map[string]string, timeout time.Duration, hostname string, transport *http.Transport) *OTLPSink {
+	if url == "" {
+		url = defaultOTLPURL
+	}
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return &OTLPSink{
+		url:        url,
+		headers:    headers,
+		hostname:   hostname,
+		client:     newHTTPClient(timeout, transport),
+		processes:  make(map[int32][]otlpKeyValue),
+		containers: make(map[string][]otlpKeyValue),
+		numCPUs:    runtime.NumCPU(),
+	}
+}
+
+// Name returns the name of the sink.
+func (s *OTLPSink) Name() string {
+	return "otlp:" + redactURL(s.url)
+}
+
+// Send converts the messages and exports them in a single request.
+func (s *OTLPSink) Send(msgs []model.MessageBody) error {
+	req := s.convert(msgs, time.Now())
+	if len(req.ResourceMetrics) == 0 {
+		return nil
+	}
+	return s.export(req)
+}
+
+// Close is a no-op.
+func (s *OTLPSink) Close() error {
+	return nil
+}
+
+func (s *OTLPSink) convert(msgs []model.MessageBody, now time.Time) otlpRequest {
+	s.Lock()
+	defer s.Unlock()
+
+	var rms []otlpResourceMetrics
+	procsReset, containersReset := false, false
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *model.CollectorProc:
+			if msg.Info != nil && len(msg.Info.Cpus) > 0 {
+				s.numCPUs = len(msg.Info.Cpus)
+			}
+			if !procsReset {
+				s.processes = make(map[int32][]otlpKeyValue, len(msg.Processes))
+				procsReset = true
+			}
+			for _, p := range msg.Processes {
+				s.processes[p.Pid] = s.processResource(p)
+				rms = append(rms, s.processMetrics(s.processes[p.Pid], p.CreateTime, p.Cpu, p.Memory, p.IoStat,
+					p.OpenFdCount, p.VoluntaryCtxSwitches, p.InvoluntaryCtxSwitches, now))
+			}
+			if len(msg.Containers) > 0 && !containersReset {
+				s.containers = make(map[string][]otlpKeyValue, len(msg.Containers))
+				containersReset = true
+			}
+			rms = append(rms, s.convertContainers(msg.Containers, now)...)
+		case *model.CollectorContainer:
+			if !containersReset {
+				s.containers = make(map[string][]otlpKeyValue, len(msg.Containers))
+				containersReset = true
+			}
+			rms = append(rms, s.convertContainers(msg.Containers, now)...)
+		case *model.CollectorRealTime:
+			if msg.NumCpus > 0 {
+				s.numCPUs = int(msg.NumCpus)
+			}
+			for _, st := range msg.Stats {
+				res, ok := s.processes[st.Pid]
+				if !ok {
+					res = []otlpKeyValue{otlpString("host.name", s.hostname), otlpInt("process.pid", int64(st.Pid))}
+				}
+				rms = append(rms, s.processMetrics(res, st.CreateTime, st.Cpu, st.Memory, st.IoStat,
+					st.OpenFdCount, st.VoluntaryCtxSwitches, st.InvoluntaryCtxSwitches, now))
+			}
+			rms = append(rms, s.convertContainerStats(msg.ContainerStats, now)...)
+		case *model.CollectorContainerRealTime:
+			rms = append(rms, s.convertContainerStats(msg.Stats, now)...)
+		}
+	}
+	return otlpRequest{ResourceMetrics: rms}
+}
+
+func (s *OTLPSink) processResource(p *model.Process) []otlpKeyValue {
+	attrs := []otlpKeyValue{
+		otlpString("host.name", s.hostname),
+		otlpInt("process.pid", int64(p.Pid)),
+	}
+	if c := p.Command; c != nil {
+		if c.Ppid > 0 {
+			attrs = append(attrs, otlpInt("process.parent_pid", int64(c.Ppid)))
+		}
+		if c.Exe != "" {
+			attrs = append(attrs, otlpString("process.executable.path", c.Exe))
+		}
+		if len(c.Args) > 0 {
+			name := c.Args[0]
+			if i := strings.LastIndexByte(name, '/'); i >= 0 {
+				name = name[i+1:]
+			}
+			attrs = append(attrs, otlpString("process.executable.name", name))
+			attrs = append(attrs, otlpStrings("process.command_args", c.Args))
+		}
+	}
+	if p.User != nil && p.User.Name != "" {
+		attrs = append(attrs, otlpString("process.owner", p.User.Name))
+	}
+	if p.ContainerId != "" {
+		attrs = append(attrs, otlpString("container.id", p.ContainerId))
+	}
+	return attrs
+}
+
+func (s *OTLPSink) processMetrics(res []otlpKeyValue, createTime int64, cpu *model.CPUStat, mem *model.MemoryStat,
+	ioStat *model.IOStat, fds int32, voluntary, involuntary uint64, now time.Time) otlpResourceMetrics {
+	b := newOTLPMetricsBuilder(now)
+	// The create time is in milliseconds.
+	start := otlpNanos(time.Unix(0, createTime*int64(time.Millisecond)))
+
+	if cpu != nil {
+		// Percentages are of a single core, utilization is of all the CPUs of the host.
+		cpus := float64(s.numCPUs) * 100
+		b.gauge("process.cpu.utilization", "1", float64(cpu.UserPct)/cpus, otlpString("cpu.mode", "user"))
+		b.gauge("process.cpu.utilization", "1", float64(cpu.SystemPct)/cpus, otlpString("cpu.mode", "system"))
+		if cpu.UserTime > 0 || cpu.SystemTime > 0 {
+			b.sum("process.cpu.time", "s", true, start,
+				otlpDoublePoint(float64(cpu.UserTime), otlpString("cpu.mode", "user")),
+				otlpDoublePoint(float64(cpu.SystemTime), otlpString("cpu.mode", "system")))
+		}
+		if cpu.NumThreads > 0 {
+			b.sum("process.thread.count", "{thread}", false, "", otlpIntPoint(int64(cpu.NumThreads)))
+		}
+	}
+	if mem != nil {
+		b.sum("process.memory.usage", "By", false, "", otlpIntPoint(int64(mem.Rss)))
+		b.sum("process.memory.virtual", "By", false, "", otlpIntPoint(int64(mem.Vms)))
+	}
+	if ioStat != nil {
+		// The semantic conventions only define cumulative I/O counters, we only have rates.
+		b.gauge("process.disk.io.rate", "By/s", float64(ioStat.ReadBytesRate), otlpString("disk.io.direction", "read"))
+		b.gauge("process.disk.io.rate", "By/s", float64(ioStat.WriteBytesRate), otlpString("disk.io.direction", "write"))
+		b.gauge("process.disk.operations.rate", "{operation}/s", float64(ioStat.ReadRate), otlpString("disk.io.direction", "read"))
+		b.gauge("process.disk.operations.rate", "{operation}/s", float64(ioStat.WriteRate), otlpString("disk.io.direction", "write"))
+	}
+	if fds > 0 {
+		b.sum("process.open_file_descriptor.count", "{file_descriptor}", false, "", otlpIntPoint(int64(fds)))
+	}
+	if voluntary > 0 || involuntary > 0 {
+		b.sum("process.context_switches", "{context_switch}", true, start,
+			otlpIntPoint(int64(voluntary), otlpString("process.context_switch_type", "voluntary")),
+			otlpIntPoint(int64(involuntary), otlpString("process.context_switch_type", "involuntary")))
+	}
+	return newOTLPResourceMetrics(res, b.metrics)
+}
+
+func (s *OTLPSink) convertContainers(containers []*model.Container, now time.Time) []otlpResourceMetrics {
+	rms := make([]otlpResourceMetrics, 0, len(containers))
+	for _, c := range containers {
+		attrs := []otlpKeyValue{
+			otlpString("host.name", s.hostname),
+			otlpString("container.id", c.Id),
+		}
+		if c.Name != "" {
+			attrs = append(attrs, otlpString("container.name", c.Name))
+		}
+		if c.Type != "" {
+			attrs = append(attrs, otlpString("container.runtime", c.Type))
+		}
+		if c.Image != "" {
+			name, tag := splitImage(c.Image)
+			attrs = append(attrs, otlpString("container.image.name", name))
+			if tag != "" {
+				attrs = append(attrs, otlpStrings("container.image.tags", []string{tag}))
+			}
+		}
+		s.containers[c.Id] = attrs
+		rms = append(rms, s.containerMetrics(attrs, &model.ContainerStat{
+			TotalPct:   c.TotalPct,
+			UserPct:    c.UserPct,
+			SystemPct:  c.SystemPct,
+			CpuLimit:   c.CpuLimit,
+			MemRss:     c.MemRss,
+			MemCache:   c.MemCache,
+			MemLimit:   c.MemoryLimit,
+			Rbps:       c.Rbps,
+			Wbps:       c.Wbps,
+			NetRcvdPs:  c.NetRcvdPs,
+			NetSentPs:  c.NetSentPs,
+			NetRcvdBps: c.NetRcvdBps,
+			NetSentBps: c.NetSentBps,
+		}, now))
+	}
+	return rms
+}
+
+func (s *OTLPSink) convertContainerStats(stats []*model.ContainerStat, now time.Time) []otlpResourceMetrics {
+	rms := make([]otlpResourceMetrics, 0, len(stats))
+	for _, st := range stats {
+		res, ok := s.containers[st.Id]
+		if !ok {
+			res = []otlpKeyValue{otlpString("host.name", s.hostname), otlpString("container.id", st.Id)}
+		}
+		rms = append(rms, s.containerMetrics(res, st, now))
+	}
+	return rms
+}
+
+func (s *OTLPSink) containerMetrics(res []otlpKeyValue, st *model.ContainerStat, now time.Time) otlpResourceMetrics {
+	b := newOTLPMetricsBuilder(now)
+	// Percentages are of a single core, container.cpu.usage is in cores.
+	b.gauge("container.cpu.usage", "{cpu}", float64(st.UserPct)/100, otlpString("cpu.mode", "user"))
+	b.gauge("container.cpu.usage", "{cpu}", float64(st.SystemPct)/100, otlpString("cpu.mode", "system"))
+	if st.CpuLimit > 0 {
+		b.gauge("container.cpu.limit", "{cpu}", float64(st.CpuLimit)/100)
+	}
+	b.sum("container.memory.usage", "By", false, "", otlpIntPoint(int64(st.MemRss)))
+	b.sum("container.memory.cache", "By", false, "", otlpIntPoint(int64(st.MemCache)))
+	if st.MemLimit > 0 {
+		b.sum("container.memory.limit", "By", false, "", otlpIntPoint(int64(st.MemLimit)))
+	}
+	// The semantic conventions only define cumulative I/O counters, we only have rates.
+	b.gauge("container.disk.io.rate", "By/s", float64(st.Rbps), otlpString("disk.io.direction", "read"))
+	b.gauge("container.disk.io.rate", "By/s", float64(st.Wbps), otlpString("disk.io.direction", "write"))
+	b.gauge("container.network.io.rate", "By/s", float64(st.NetRcvdBps), otlpString("network.io.direction", "receive"))
+	b.gauge("container.network.io.rate", "By/s", float64(st.NetSentBps), otlpString("network.io.direction", "transmit"))
+	b.gauge("container.network.packets.rate", "{packet}/s", float64(st.NetRcvdPs), otlpString("network.io.direction", "receive"))
+	b.gauge("container.network.packets.rate", "{packet}/s", float64(st.NetSentPs), otlpString("network.io.direction", "transmit"))
+	return newOTLPResourceMetrics(res, b.metrics)
+}
+
+func newOTLPResourceMetrics(res []otlpKeyValue, metrics []otlpMetric) otlpResourceMetrics {
+	return otlpResourceMetrics{
+		Resource: otlpResource{Attributes: res},
+		ScopeMetrics: []otlpScopeMetrics{{
+			Scope:   otlpScope{Name: otlpScopeName},
+			Metrics: metrics,
+		}},
+	}
+}
+
+// splitImage splits an image reference into its name and tag, ignoring the
+// port of a registry host.
+func splitImage(image string) (string, string) {
+	if i := strings.IndexByte(image, '@'); i >= 0 {
+		image = image[:i]
+	}
+	i := strings.LastIndexByte(image, ':')
+	if i < 0 || strings.ContainsRune(image[i:], '/') {
+		return image, ""
+	}
+	return image[:i], image[i+1:]
+}
+
+func (s *OTLPSink) export(r otlpRequest) error {
+	body, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request to %s: %s", redactURL(s.url), err)
+	}
+	req.Header.Set("Content-Type", otlpContentType)
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error exporting metrics to %s: %s", redactURL(s.url), err)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response from %s. Status: %s", redactURL(s.url), resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package sink
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+// findMetric returns the metrics with the given name in a resource.
+func findMetric(rm otlpResourceMetrics, name string) []otlpMetric {
+	var found []otlpMetric
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		if m.Name == name {
+			found = append(found, m)
+		}
+	}
+	return found
+}
+
+func attr(attrs []otlpKeyValue, key string) *otlpAnyValue {
+	for _, a := range attrs {
+		if a.Key == key {
+			return &a.Value
+		}
+	}
+	return nil
+}
+
+func TestOTLPConvertProcesses(t *testing.T) {
+	assert := assert.New(t)
+	s := NewOTLPSink("", nil, 0, "myhost", nil)
+	now := time.Unix(100, 0)
+
+	req := s.convert([]model.MessageBody{
+		&model.CollectorProc{
+			Info: &model.SystemInfo{Cpus: []*model.CPUInfo{{Number: 0}, {Number: 1}}},
+			Processes: []*model.Process{{
+				Pid:                  42,
+				CreateTime:           5000,
+				Command:              &model.Command{Args: []string{"/usr/bin/python", "app.py"}, Ppid: 1, Exe: "/usr/bin/python3.6"},
+				User:                 &model.ProcessUser{Name: "www"},
+				Cpu:                  &model.CPUStat{UserPct: 50, SystemPct: 10, UserTime: 12, NumThreads: 4},
+				Memory:               &model.MemoryStat{Rss: 1024, Vms: 4096},
+				OpenFdCount:          8,
+				ContainerId:          "abc",
+				VoluntaryCtxSwitches: 3,
+			}},
+		},
+	}, now)
+
+	assert.Len(req.ResourceMetrics, 1)
+	rm := req.ResourceMetrics[0]
+	res := rm.Resource.Attributes
+	assert.Equal("myhost", *attr(res, "host.name").StringValue)
+	assert.Equal("42", *attr(res, "process.pid").IntValue)
+	assert.Equal("1", *attr(res, "process.parent_pid").IntValue)
+	assert.Equal("/usr/bin/python3.6", *attr(res, "process.executable.path").StringValue)
+	assert.Equal("python", *attr(res, "process.executable.name").StringValue)
+	assert.Len(attr(res, "process.command_args").ArrayValue.Values, 2)
+	assert.Equal("www", *attr(res, "process.owner").StringValue)
+	assert.Equal("abc", *attr(res, "container.id").StringValue)
+	assert.Equal(otlpScopeName, rm.ScopeMetrics[0].Scope.Name)
+
+	// One metric per name, with a data point per attribute set.
+	cpu := findMetric(rm, "process.cpu.utilization")
+	assert.Len(cpu, 1)
+	points := cpu[0].Gauge.DataPoints
+	assert.Len(points, 2)
+	// 50% of a core is 25% of the 2 CPUs of the host.
+	assert.Equal(0.25, *points[0].AsDouble)
+	assert.Equal("user", *attr(points[0].Attributes, "cpu.mode").StringValue)
+	assert.Equal(0.05, *points[1].AsDouble)
+	assert.Equal("system", *attr(points[1].Attributes, "cpu.mode").StringValue)
+	assert.Equal("100000000000", points[0].TimeUnixNano)
+
+	cpuTime := findMetric(rm, "process.cpu.time")[0].Sum
+	assert.True(cpuTime.IsMonotonic)
+	assert.Equal(otlpCumulative, cpuTime.AggregationTemporality)
+	assert.Equal("5000000000", cpuTime.DataPoints[0].StartTimeUnixNano)
+	assert.Equal(12.0, *cpuTime.DataPoints[0].AsDouble)
+
+	mem := findMetric(rm, "process.memory.usage")[0]
+	assert.Equal("By", mem.Unit)
+	assert.False(mem.Sum.IsMonotonic)
+	assert.Equal("1024", *mem.Sum.DataPoints[0].AsInt)
+	assert.Equal("8", *findMetric(rm, "process.open_file_descriptor.count")[0].Sum.DataPoints[0].AsInt)
+	assert.Equal("4", *findMetric(rm, "process.thread.count")[0].Sum.DataPoints[0].AsInt)
+	assert.Len(findMetric(rm, "process.context_switches")[0].Sum.DataPoints, 2)
+
+	// Real-time stats reuse the resource of the last full run.
+	req = s.convert([]model.MessageBody{
+		&model.CollectorRealTime{NumCpus: 4, Stats: []*model.ProcessStat{
+			{Pid: 42, Cpu: &model.CPUStat{UserPct: 20}},
+			{Pid: 7, Cpu: &model.CPUStat{UserPct: 20}},
+		}},
+	}, now)
+	assert.Len(req.ResourceMetrics, 2)
+	assert.Equal(0.05, *findMetric(req.ResourceMetrics[0], "process.cpu.utilization")[0].Gauge.DataPoints[0].AsDouble)
+	assert.Equal("www", *attr(req.ResourceMetrics[0].Resource.Attributes, "process.owner").StringValue)
+	assert.Nil(attr(req.ResourceMetrics[1].Resource.Attributes, "process.owner"))
+	assert.Equal("7", *attr(req.ResourceMetrics[1].Resource.Attributes, "process.pid").IntValue)
+}
+
+func TestOTLPConvertContainers(t *testing.T) {
+	assert := assert.New(t)
+	s := NewOTLPSink("", nil, 0, "myhost", nil)
+
+	req := s.convert([]model.MessageBody{
+		&model.CollectorContainer{Containers: []*model.Container{{
+			Id:          "abc",
+			Name:        "web",
+			Type:        "docker",
+			Image:       "registry:5000/nginx:1.13",
+			UserPct:     150,
+			MemRss:      2048,
+			MemoryLimit: 4096,
+			NetRcvdBps:  10,
+		}}},
+	}, time.Now())
+
+	assert.Len(req.ResourceMetrics, 1)
+	rm := req.ResourceMetrics[0]
+	res := rm.Resource.Attributes
+	assert.Equal("abc", *attr(res, "container.id").StringValue)
+	assert.Equal("web", *attr(res, "container.name").StringValue)
+	assert.Equal("docker", *attr(res, "container.runtime").StringValue)
+	assert.Equal("registry:5000/nginx", *attr(res, "container.image.name").StringValue)
+	assert.Equal("1.13", *attr(res, "container.image.tags").ArrayValue.Values[0].StringValue)
+
+	assert.Equal(1.5, *findMetric(rm, "container.cpu.usage")[0].Gauge.DataPoints[0].AsDouble)
+	assert.Equal("2048", *findMetric(rm, "container.memory.usage")[0].Sum.DataPoints[0].AsInt)
+	assert.Equal("4096", *findMetric(rm, "container.memory.limit")[0].Sum.DataPoints[0].AsInt)
+	net := findMetric(rm, "container.network.io.rate")
+	assert.Len(net, 1)
+	assert.Len(net[0].Gauge.DataPoints, 2)
+	assert.Equal(10.0, *net[0].Gauge.DataPoints[0].AsDouble)
+	assert.Equal("receive", *attr(net[0].Gauge.DataPoints[0].Attributes, "network.io.direction").StringValue)
+
+	req = s.convert([]model.MessageBody{
+		&model.CollectorContainerRealTime{Stats: []*model.ContainerStat{{Id: "abc", MemRss: 1}}},
+	}, time.Now())
+	assert.Equal("web", *attr(req.ResourceMetrics[0].Resource.Attributes, "container.name").StringValue)
+}
+
+func TestOTLPSinkExport(t *testing.T) {
+	assert := assert.New(t)
+
+	var received otlpRequest
+	var contentType, auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		auth = r.Header.Get("Authorization")
+		assert.Equal("/v1/metrics", r.URL.Path)
+		assert.NoError(json.NewDecoder(r.Body).Decode(&received))
+	}))
+	defer srv.Close()
+
+	s := NewOTLPSink(srv.URL+"/v1/metrics", map[string]string{"Authorization": "Bearer token"}, 0, "myhost", nil)
+	assert.NoError(s.Send([]model.MessageBody{
+		&model.CollectorProc{Processes: []*model.Process{{Pid: 1}}},
+	}))
+	assert.Equal("application/json", contentType)
+	assert.Equal("Bearer token", auth)
+	assert.Len(received.ResourceMetrics, 1)
+
+	// Nothing to export, nothing sent.
+	received = otlpRequest{}
+	assert.NoError(s.Send([]model.MessageBody{&model.CollectorConnections{}}))
+	assert.Len(received.ResourceMetrics, 0)
+}
+
+func TestSplitImage(t *testing.T) {
+	for image, expected := range map[string][2]string{
+		"nginx":                      {"nginx", ""},
+		"nginx:1.13":                 {"nginx", "1.13"},
+		"registry:5000/nginx":        {"registry:5000/nginx", ""},
+		"registry:5000/nginx:latest": {"registry:5000/nginx", "latest"},
+		"nginx@sha256:abcd":          {"nginx", ""},
+	} {
+		name, tag := splitImage(image)
+		assert.Equal(t, expected[0], name, image)
+		assert.Equal(t, expected[1], tag, image)
+	}
+}
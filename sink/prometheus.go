@@ -0,0 +1,380 @@
+package sink
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/cihub/seelog"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const (
+	defaultPrometheusAddress = "localhost:9109"
+
+	openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+	textContentType        = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// Labels that can be attached to the exported series, see PrometheusLabels.
+const (
+	LabelPid           = "pid"
+	LabelExe           = "exe"
+	LabelUser          = "user"
+	LabelContainerID   = "container_id"
+	LabelContainerName = "container_name"
+	LabelImage         = "image"
+)
+
+// PrometheusLabels is the list of all the labels the Prometheus sink knows about.
+var PrometheusLabels = []string{LabelPid, LabelExe, LabelUser, LabelContainerID, LabelContainerName, LabelImage}
+
+var (
+	processLabels   = []string{LabelPid, LabelExe, LabelUser, LabelContainerID}
+	containerLabels = []string{LabelContainerID, LabelContainerName, LabelImage}
+)
+
+type metricFamily struct {
+	name string
+	help string
+}
+
+// The values of a process series, in this order.
+var processFamilies = []metricFamily{
+	{"datadog_process_cpu_percent", "Total CPU usage of the process, in percent of a single core."},
+	{"datadog_process_cpu_user_percent", "User CPU usage of the process, in percent of a single core."},
+	{"datadog_process_cpu_system_percent", "System CPU usage of the process, in percent of a single core."},
+	{"datadog_process_memory_rss_bytes", "Resident set size of the process."},
+	{"datadog_process_memory_vms_bytes", "Virtual memory size of the process."},
+	{"datadog_process_io_read_bytes_per_second", "Bytes read per second by the process."},
+	{"datadog_process_io_write_bytes_per_second", "Bytes written per second by the process."},
+	{"datadog_process_io_read_ops_per_second", "Read operations per second by the process."},
+	{"datadog_process_io_write_ops_per_second", "Write operations per second by the process."},
+	{"datadog_process_open_fds", "Number of file descriptors opened by the process."},
+}
+
+// The values of a container series, in this order.
+var containerFamilies = []metricFamily{
+	{"datadog_container_cpu_percent", "Total CPU usage of the container, in percent of a single core."},
+	{"datadog_container_cpu_user_percent", "User CPU usage of the container, in percent of a single core."},
+	{"datadog_container_cpu_system_percent", "System CPU usage of the container, in percent of a single core."},
+	{"datadog_container_memory_rss_bytes", "Resident set size of the container."},
+	{"datadog_container_memory_cache_bytes", "Page cache used by the container."},
+	{"datadog_container_memory_limit_bytes", "Memory limit of the container, 0 if unlimited."},
+	{"datadog_container_net_rx_bytes_per_second", "Bytes received per second by the container."},
+	{"datadog_container_net_tx_bytes_per_second", "Bytes sent per second by the container."},
+	{"datadog_container_net_rx_packets_per_second", "Packets received per second by the container."},
+	{"datadog_container_net_tx_packets_per_second", "Packets sent per second by the container."},
+	{"datadog_container_io_read_bytes_per_second", "Bytes read per second by the container."},
+	{"datadog_container_io_write_bytes_per_second", "Bytes written per second by the container."},
+}
+
+// series is a single process or container: its label values, in the order of
+// processLabels or containerLabels, and its values, in the order of
+// processFamilies or containerFamilies. A series is never modified once in
+// the snapshot, updates replace it, so that it can be read without the lock.
+type series struct {
+	labels []string
+	values []float64
+}
+
+// PrometheusSink keeps the latest process and container snapshot in memory
+// and serves it over HTTP in the OpenMetrics (or Prometheus text) format.
+// Cardinality is kept under control by only exporting the top N processes and
+// containers by CPU and memory usage, and by only keeping the allowed labels:
+// series that end up with the same labels are summed.
+type PrometheusSink struct {
+	sync.RWMutex
+
+	address  string
+	labels   map[string]bool
+	topN     int
+	listener net.Listener
+	server   *http.Server
+
+	processes  map[int32]*series
+	containers map[string]*series
+}
+
+// NewPrometheusSink starts serving metrics on address. An empty labels list
+// allows all of them and a topN of 0 exports every process and container.
+func NewPrometheusSink(address string, labels []string, topN int) (*PrometheusSink, error) {
+	if address == "" {
+		address = defaultPrometheusAddress
+	}
+	allowed := make(map[string]bool, len(PrometheusLabels))
+	if len(labels) == 0 {
+		labels = PrometheusLabels
+	}
+	for _, l := range labels {
+		allowed[l] = true
+	}
+
+	s := &PrometheusSink{
+		address:    address,
+		labels:     allowed,
+		topN:       topN,
+		processes:  make(map[int32]*series),
+		containers: make(map[string]*series),
+	}
+
+	ln, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on %s: %s", address, err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", s)
+	s.listener = ln
+	s.server = &http.Server{Handler: mux}
+	go func() {
+		if err := s.server.Serve(ln); err != nil && err != http.ErrServerClosed {
+			log.Errorf("Prometheus endpoint on %s stopped: %s", address, err)
+		}
+	}()
+	return s, nil
+}
+
+// Name returns the name of the sink.
+func (s *PrometheusSink) Name() string {
+	return "prometheus:" + s.listener.Addr().String()
+}
+
+// Send updates the snapshot. Full process and container check results replace
+// it while real-time results only update the values of known series.
+func (s *PrometheusSink) Send(msgs []model.MessageBody) error {
+	s.Lock()
+	defer s.Unlock()
+
+	var procsReset, containersReset bool
+	resetProcesses := func() {
+		if !procsReset {
+			s.processes = make(map[int32]*series)
+			procsReset = true
+		}
+	}
+	resetContainers := func() {
+		if !containersReset {
+			s.containers = make(map[string]*series)
+			containersReset = true
+		}
+	}
+
+	for _, m := range msgs {
+		switch msg := m.(type) {
+		case *model.CollectorProc:
+			resetProcesses()
+			for _, p := range msg.Processes {
+				s.processes[p.Pid] = newProcessSeries(p)
+			}
+			if len(msg.Containers) > 0 {
+				resetContainers()
+				s.addContainers(msg.Containers)
+			}
+		case *model.CollectorContainer:
+			resetContainers()
+			s.addContainers(msg.Containers)
+		case *model.CollectorRealTime:
+			for _, st := range msg.Stats {
+				if ps, ok := s.processes[st.Pid]; ok {
+					s.processes[st.Pid] = &series{
+						labels: ps.labels,
+						values: processValues(st.Cpu, st.Memory, st.IoStat, st.OpenFdCount),
+					}
+				}
+			}
+			s.updateContainers(msg.ContainerStats)
+		case *model.CollectorContainerRealTime:
+			s.updateContainers(msg.Stats)
+		}
+	}
+	return nil
+}
+
+// Close stops the HTTP server.
+func (s *PrometheusSink) Close() error {
+	return s.server.Close()
+}
+
+func (s *PrometheusSink) addContainers(containers []*model.Container) {
+	for _, c := range containers {
+		s.containers[c.Id] = &series{
+			labels: []string{c.Id, c.Name, c.Image},
+			values: []float64{
+				float64(c.TotalPct), float64(c.UserPct), float64(c.SystemPct),
+				float64(c.MemRss), float64(c.MemCache), float64(c.MemoryLimit),
+				float64(c.NetRcvdBps), float64(c.NetSentBps), float64(c.NetRcvdPs), float64(c.NetSentPs),
+				float64(c.Rbps), float64(c.Wbps),
+			},
+		}
+	}
+}
+
+func (s *PrometheusSink) updateContainers(stats []*model.ContainerStat) {
+	for _, st := range stats {
+		if cs, ok := s.containers[st.Id]; ok {
+			s.containers[st.Id] = &series{labels: cs.labels, values: []float64{
+				float64(st.TotalPct), float64(st.UserPct), float64(st.SystemPct),
+				float64(st.MemRss), float64(st.MemCache), float64(st.MemLimit),
+				float64(st.NetRcvdBps), float64(st.NetSentBps), float64(st.NetRcvdPs), float64(st.NetSentPs),
+				float64(st.Rbps), float64(st.Wbps),
+			}}
+		}
+	}
+}
+
+func newProcessSeries(p *model.Process) *series {
+	var exe, user string
+	if p.Command != nil {
+		exe = p.Command.Exe
+		if exe == "" && len(p.Command.Args) > 0 {
+			exe = p.Command.Args[0]
+		}
+	}
+	if p.User != nil {
+		user = p.User.Name
+	}
+	return &series{
+		labels: []string{strconv.Itoa(int(p.Pid)), exe, user, p.ContainerId},
+		values: processValues(p.Cpu, p.Memory, p.IoStat, p.OpenFdCount),
+	}
+}
+
+func processValues(cpu *model.CPUStat, mem *model.MemoryStat, io *model.IOStat, fds int32) []float64 {
+	values := make([]float64, len(processFamilies))
+	if cpu != nil {
+		values[0], values[1], values[2] = float64(cpu.TotalPct), float64(cpu.UserPct), float64(cpu.SystemPct)
+	}
+	if mem != nil {
+		values[3], values[4] = float64(mem.Rss), float64(mem.Vms)
+	}
+	if io != nil {
+		values[5], values[6] = float64(io.ReadBytesRate), float64(io.WriteBytesRate)
+		values[7], values[8] = float64(io.ReadRate), float64(io.WriteRate)
+	}
+	values[9] = float64(fds)
+	return values
+}
+
+// ServeHTTP writes the current snapshot, in the OpenMetrics format if the
+// client asks for it and in the Prometheus text format otherwise.
+func (s *PrometheusSink) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	openMetrics := strings.Contains(r.Header.Get("Accept"), "application/openmetrics-text")
+
+	s.RLock()
+	procs := make([]*series, 0, len(s.processes))
+	for _, p := range s.processes {
+		procs = append(procs, p)
+	}
+	containers := make([]*series, 0, len(s.containers))
+	for _, c := range s.containers {
+		containers = append(containers, c)
+	}
+	s.RUnlock()
+
+	var buf bytes.Buffer
+	writeFamilies(&buf, processFamilies, processLabels, s.aggregate(procs, processLabels))
+	writeFamilies(&buf, containerFamilies, containerLabels, s.aggregate(containers, containerLabels))
+	if openMetrics {
+		buf.WriteString("# EOF\n")
+		w.Header().Set("Content-Type", openMetricsContentType)
+	} else {
+		w.Header().Set("Content-Type", textContentType)
+	}
+	w.Write(buf.Bytes())
+}
+
+// aggregate keeps the top N series by CPU then memory usage, drops the labels
+// that aren't allowed and sums the series left with the same labels. The
+// result is sorted by labels.
+func (s *PrometheusSink) aggregate(all []*series, labelNames []string) []*series {
+	// CPU is always the first value and RSS the fourth, for both processes and containers.
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].values[0] != all[j].values[0] {
+			return all[i].values[0] > all[j].values[0]
+		}
+		if all[i].values[3] != all[j].values[3] {
+			return all[i].values[3] > all[j].values[3]
+		}
+		return strings.Join(all[i].labels, "\x00") < strings.Join(all[j].labels, "\x00")
+	})
+	if s.topN > 0 && len(all) > s.topN {
+		all = all[:s.topN]
+	}
+
+	byKey := make(map[string]*series, len(all))
+	keys := make([]string, 0, len(all))
+	for _, in := range all {
+		labels := make([]string, len(labelNames))
+		for i, name := range labelNames {
+			if s.labels[name] {
+				labels[i] = in.labels[i]
+			}
+		}
+		key := strings.Join(labels, "\x00")
+		out, ok := byKey[key]
+		if !ok {
+			out = &series{labels: labels, values: make([]float64, len(in.values))}
+			byKey[key] = out
+			keys = append(keys, key)
+		}
+		for i, v := range in.values {
+			out.values[i] += v
+		}
+	}
+
+	sort.Strings(keys)
+	result := make([]*series, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, byKey[k])
+	}
+	return result
+}
+
+func writeFamilies(buf *bytes.Buffer, families []metricFamily, labelNames []string, all []*series) {
+	for i, f := range families {
+		fmt.Fprintf(buf, "# HELP %s %s\n", f.name, f.help)
+		fmt.Fprintf(buf, "# TYPE %s gauge\n", f.name)
+		for _, s := range all {
+			buf.WriteString(f.name)
+			writeLabels(buf, labelNames, s.labels)
+			buf.WriteByte(' ')
+			buf.WriteString(strconv.FormatFloat(s.values[i], 'g', -1, 64))
+			buf.WriteByte('\n')
+		}
+	}
+}
+
+// writeLabels writes the non-empty labels in the exposition format.
+func writeLabels(buf *bytes.Buffer, names, values []string) {
+	first := true
+	for i, v := range values {
+		if v == "" {
+			continue
+		}
+		if first {
+			buf.WriteByte('{')
+			first = false
+		} else {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(names[i])
+		buf.WriteString(`="`)
+		buf.WriteString(escapeLabelValue(v))
+		buf.WriteByte('"')
+	}
+	if !first {
+		buf.WriteByte('}')
+	}
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(v string) string {
+	return labelValueEscaper.Replace(v)
+}
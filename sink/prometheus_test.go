@@ -0,0 +1,174 @@
+package sink
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+func newTestPrometheusSink(t *testing.T, labels []string, topN int) *PrometheusSink {
+	s, err := NewPrometheusSink("127.0.0.1:0", labels, topN)
+	assert.NoError(t, err)
+	return s
+}
+
+func scrape(t *testing.T, s *PrometheusSink, accept string) (string, string) {
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("Accept", accept)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	body, err := ioutil.ReadAll(rec.Body)
+	assert.NoError(t, err)
+	return string(body), rec.Header().Get("Content-Type")
+}
+
+func testProcess(pid int32, exe, user, containerID string, cpu float32, rss uint64) *model.Process {
+	return &model.Process{
+		Pid:         pid,
+		Command:     &model.Command{Args: []string{exe, "--flag"}},
+		User:        &model.ProcessUser{Name: user},
+		Cpu:         &model.CPUStat{TotalPct: cpu},
+		Memory:      &model.MemoryStat{Rss: rss},
+		IoStat:      &model.IOStat{ReadBytesRate: 10},
+		OpenFdCount: 4,
+		ContainerId: containerID,
+	}
+}
+
+func TestPrometheusSink(t *testing.T) {
+	assert := assert.New(t)
+	s := newTestPrometheusSink(t, nil, 0)
+	defer s.Close()
+
+	assert.NoError(s.Send([]model.MessageBody{
+		&model.CollectorProc{
+			Processes: []*model.Process{
+				testProcess(1, "/sbin/init", "root", "", 1.5, 1024),
+				testProcess(42, `/usr/bin/"quoted"`, "www", "abc", 20, 2048),
+			},
+			Containers: []*model.Container{
+				{Id: "abc", Name: "web", Image: "nginx:latest", TotalPct: 20, MemRss: 4096, NetRcvdBps: 100},
+			},
+		},
+	}))
+
+	body, contentType := scrape(t, s, "")
+	assert.Equal(textContentType, contentType)
+	assert.Contains(body, "# TYPE datadog_process_cpu_percent gauge\n")
+	assert.Contains(body, `datadog_process_cpu_percent{pid="1",exe="/sbin/init",user="root"} 1.5`+"\n")
+	assert.Contains(body, `datadog_process_memory_rss_bytes{pid="42",exe="/usr/bin/\"quoted\"",user="www",container_id="abc"} 2048`+"\n")
+	assert.Contains(body, `datadog_process_open_fds{pid="1",exe="/sbin/init",user="root"} 4`+"\n")
+	assert.Contains(body, `datadog_container_net_rx_bytes_per_second{container_id="abc",container_name="web",image="nginx:latest"} 100`+"\n")
+	assert.False(strings.Contains(body, "# EOF"))
+
+	// Real-time results update the values of known processes and containers.
+	assert.NoError(s.Send([]model.MessageBody{
+		&model.CollectorRealTime{
+			Stats: []*model.ProcessStat{
+				{Pid: 1, Cpu: &model.CPUStat{TotalPct: 3}, Memory: &model.MemoryStat{Rss: 1024}},
+				{Pid: 2, Cpu: &model.CPUStat{TotalPct: 3}},
+			},
+			ContainerStats: []*model.ContainerStat{{Id: "abc", TotalPct: 30}},
+		},
+	}))
+	body, contentType = scrape(t, s, "application/openmetrics-text; version=1.0.0")
+	assert.Equal(openMetricsContentType, contentType)
+	assert.Contains(body, `datadog_process_cpu_percent{pid="1",exe="/sbin/init",user="root"} 3`+"\n")
+	assert.False(strings.Contains(body, `pid="2"`))
+	assert.Contains(body, `datadog_container_cpu_percent{container_id="abc",container_name="web",image="nginx:latest"} 30`+"\n")
+	assert.True(strings.HasSuffix(body, "# EOF\n"))
+
+	// A new full snapshot replaces the old one.
+	assert.NoError(s.Send([]model.MessageBody{
+		&model.CollectorProc{Processes: []*model.Process{testProcess(7, "bash", "root", "", 0, 0)}},
+	}))
+	body, _ = scrape(t, s, "")
+	assert.False(strings.Contains(body, `pid="1"`))
+	assert.Contains(body, `pid="7"`)
+}
+
+func TestPrometheusSinkCardinality(t *testing.T) {
+	assert := assert.New(t)
+	s := newTestPrometheusSink(t, []string{LabelExe}, 2)
+	defer s.Close()
+
+	assert.NoError(s.Send([]model.MessageBody{
+		&model.CollectorProc{
+			Processes: []*model.Process{
+				testProcess(1, "worker", "root", "", 10, 100),
+				testProcess(2, "worker", "root", "", 5, 100),
+				testProcess(3, "idle", "root", "", 0, 100),
+				testProcess(4, "db", "root", "", 20, 100),
+			},
+		},
+	}))
+
+	body, _ := scrape(t, s, "")
+	// Only the 2 busiest processes are kept and, without the pid label, they're summed up.
+	assert.Contains(body, `datadog_process_cpu_percent{exe="db"} 20`+"\n")
+	assert.Contains(body, `datadog_process_cpu_percent{exe="worker"} 10`+"\n")
+	assert.False(strings.Contains(body, `exe="idle"`))
+	assert.False(strings.Contains(body, `pid=`))
+
+	s2 := newTestPrometheusSink(t, []string{LabelExe}, 0)
+	defer s2.Close()
+	assert.NoError(s2.Send([]model.MessageBody{
+		&model.CollectorProc{
+			Processes: []*model.Process{
+				testProcess(1, "worker", "root", "", 10, 100),
+				testProcess(2, "worker", "root", "", 5, 100),
+			},
+		},
+	}))
+	body, _ = scrape(t, s2, "")
+	assert.Contains(body, `datadog_process_cpu_percent{exe="worker"} 15`+"\n")
+	assert.Contains(body, `datadog_process_memory_rss_bytes{exe="worker"} 200`+"\n")
+}
+
+func TestPrometheusSinkServes(t *testing.T) {
+	assert := assert.New(t)
+	s := newTestPrometheusSink(t, nil, 0)
+	defer s.Close()
+
+	resp, err := http.Get("http://" + s.listener.Addr().String() + "/metrics")
+	assert.NoError(err)
+	defer resp.Body.Close()
+	assert.Equal(http.StatusOK, resp.StatusCode)
+}
+
+// TestPrometheusSinkConcurrentScrape is meant to be run with -race.
+func TestPrometheusSinkConcurrentScrape(t *testing.T) {
+	s := newTestPrometheusSink(t, nil, 1)
+	defer s.Close()
+
+	assert.NoError(t, s.Send([]model.MessageBody{
+		&model.CollectorProc{
+			Processes:  []*model.Process{testProcess(1, "/sbin/init", "root", "", 1, 1024), testProcess(2, "/bin/sh", "root", "", 2, 1024)},
+			Containers: []*model.Container{{Id: "abc", TotalPct: 1}, {Id: "def", TotalPct: 2}},
+		},
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			s.Send([]model.MessageBody{
+				&model.CollectorRealTime{
+					Stats:          []*model.ProcessStat{{Pid: 1, Cpu: &model.CPUStat{TotalPct: float32(i)}}, {Pid: 2, Cpu: &model.CPUStat{TotalPct: float32(200 - i)}}},
+					ContainerStats: []*model.ContainerStat{{Id: "abc", TotalPct: float32(i)}, {Id: "def", TotalPct: float32(200 - i)}},
+				},
+			})
+		}
+	}()
+	for i := 0; i < 200; i++ {
+		body, _ := scrape(t, s, "")
+		assert.Contains(t, body, "datadog_process_cpu_percent{")
+	}
+	<-done
+}
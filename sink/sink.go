@@ -0,0 +1,142 @@
+// Package sink provides outputs, other than the Datadog intake, that the
+// results of the checks can be written to.
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/config"
+	"github.com/DataDog/datadog-process-agent/model"
+	"github.com/DataDog/datadog-process-agent/util"
+)
+
+// Sink is an output the messages produced by a check run are written to.
+type Sink interface {
+	// Name identifies the sink in logs.
+	Name() string
+	// Send writes the messages produced by a single check run.
+	Send(msgs []model.MessageBody) error
+	// Close flushes and releases any resource held by the sink.
+	Close() error
+}
+
+// New creates the sink described by the given configuration.
+// The HTTP sinks go through transport, as the intake does, to honor the proxy
+// and TLS settings.
+func New(cfg config.SinkConfig, hostname string, transport *http.Transport) (Sink, error) {
+	switch cfg.Type {
+	case config.SinkTypeFile:
+		return NewFileSink(cfg.Path, cfg.MaxSize, cfg.MaxFiles, hostname)
+	case config.SinkTypeStdout:
+		return NewStdoutSink(hostname), nil
+	case config.SinkTypeWebhook:
+		if cfg.URL == nil {
+			return nil, fmt.Errorf("webhook sink requires a url")
+		}
+		return NewWebhookSink(cfg.URL.String(), cfg.Headers, cfg.Timeout, hostname, transport), nil
+	case config.SinkTypePrometheus:
+		for _, l := range cfg.Labels {
+			if !util.StringInSlice(PrometheusLabels, l) {
+				return nil, fmt.Errorf("unknown prometheus label: %s", l)
+			}
+		}
+		return NewPrometheusSink(cfg.Address, cfg.Labels, cfg.TopN)
+	case config.SinkTypeOTLP:
+		var url string
+		if cfg.URL != nil {
+			url = cfg.URL.String()
+		}
+		return NewOTLPSink(url, cfg.Headers, cfg.Timeout, hostname, transport), nil
+	}
+	return nil, fmt.Errorf("unknown sink type: %s", cfg.Type)
+}
+
+func newHTTPClient(timeout time.Duration, transport *http.Transport) http.Client {
+	c := http.Client{Timeout: timeout}
+	if transport != nil {
+		c.Transport = transport
+	}
+	return c
+}
+
+// redactURL strips the credentials from a URL so that it can be logged.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "<invalid url>"
+	}
+	u.User = nil
+	return u.String()
+}
+
+// Envelope is the JSON representation of a single message, along with what's
+// needed to make sense of it outside of the agent.
+type Envelope struct {
+	Timestamp int64             `json:"timestamp"`
+	Hostname  string            `json:"hostname"`
+	Type      string            `json:"type"`
+	Payload   model.MessageBody `json:"payload"`
+}
+
+var messageTypeNames = map[model.MessageType]string{
+	model.TypeCollectorProc:              "process",
+	model.TypeCollectorConnections:       "connections",
+	model.TypeCollectorRealTime:          "realtime_process",
+	model.TypeCollectorContainer:         "container",
+	model.TypeCollectorContainerRealTime: "realtime_container",
+	model.TypeCollectorProcEvent:         "process_event",
+	model.TypeCollectorListeners:         "listeners",
+}
+
+// MessageTypeName returns the name used for a message in the JSON outputs.
+func MessageTypeName(m model.MessageBody) (string, error) {
+	t, err := model.DetectMessageType(m)
+	if err != nil {
+		return "", err
+	}
+	name, ok := messageTypeNames[t]
+	if !ok {
+		return "", fmt.Errorf("unsupported message type: %d", t)
+	}
+	return name, nil
+}
+
+// newEnvelopes wraps the messages of a check run, all with the same timestamp.
+func newEnvelopes(msgs []model.MessageBody, hostname string, now time.Time) ([]Envelope, error) {
+	envs := make([]Envelope, 0, len(msgs))
+	for _, m := range msgs {
+		name, err := MessageTypeName(m)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, Envelope{
+			Timestamp: now.UnixNano() / int64(time.Millisecond),
+			Hostname:  hostname,
+			Type:      name,
+			Payload:   m,
+		})
+	}
+	return envs, nil
+}
+
+// marshalLines encodes the messages as newline-delimited JSON.
+func marshalLines(msgs []model.MessageBody, hostname string, now time.Time) ([]byte, error) {
+	envs, err := newEnvelopes(msgs, hostname, now)
+	if err != nil {
+		return nil, err
+	}
+	var buf []byte
+	for _, e := range envs {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, b...)
+		buf = append(buf, '\n')
+	}
+	return buf, nil
+}
@@ -0,0 +1,78 @@
+package sink
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/DataDog/datadog-process-agent/model"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookSink POSTs the messages of each check run as a JSON array to a URL.
+type WebhookSink struct {
+	url      string
+	headers  map[string]string
+	hostname string
+	client   http.Client
+}
+
+// NewWebhookSink returns a sink posting to url with the given extra headers.
+func NewWebhookSink(url string, headers map[string]string, timeout time.Duration, hostname string, transport *http.Transport) *WebhookSink {
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return &WebhookSink{
+		url:      url,
+		headers:  headers,
+		hostname: hostname,
+		client:   newHTTPClient(timeout, transport),
+	}
+}
+
+// Name returns the name of the sink.
+func (s *WebhookSink) Name() string {
+	return "webhook:" + redactURL(s.url)
+}
+
+// Send posts the messages in a single request.
+func (s *WebhookSink) Send(msgs []model.MessageBody) error {
+	envs, err := newEnvelopes(msgs, s.hostname, time.Now())
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(envs)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("could not create request to %s: %s", redactURL(s.url), err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error submitting payload to %s: %s", redactURL(s.url), err)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response from %s. Status: %s", redactURL(s.url), resp.Status)
+	}
+	return nil
+}
+
+// Close is a no-op.
+func (s *WebhookSink) Close() error {
+	return nil
+}